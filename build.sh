@@ -1,12 +1,14 @@
 #!/usr/bin/env bash
-RUN_NAME="coupon_service"
+# 用法：./build.sh [coupon|order|verify]，默认构建优惠券服务
+SERVICE=${1:-coupon}
+RUN_NAME="${SERVICE}_service"
 
 mkdir -p output/bin
 cp script/* output/
 chmod +x output/bootstrap.sh
 
 if [ "$IS_SYSTEM_TEST_ENV" != "1" ]; then
-    go build -o output/bin/${RUN_NAME}
+    go build -o output/bin/${RUN_NAME} ./rpc/${SERVICE}/main
 else
-    go test -c -covermode=set -o output/bin/${RUN_NAME} -coverpkg=./...
+    go test -c -covermode=set -o output/bin/${RUN_NAME} -coverpkg=./... ./rpc/${SERVICE}/main
 fi
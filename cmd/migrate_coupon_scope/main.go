@@ -0,0 +1,16 @@
+// 一次性迁移：将优惠券 apply_spot_ids 逗号串迁移为 coupon_scope 景点范围
+// 用法：go run ./cmd/migrate_coupon_scope，可重复执行，已迁移的记录会跳过
+package main
+
+import (
+	"example_shop/common/db"
+	_ "example_shop/common/init"
+	"log"
+)
+
+func main() {
+	if err := db.MigrateCouponScopes(db.MysqlDB); err != nil {
+		log.Fatalf("迁移优惠券适用景点失败: %v", err)
+	}
+	log.Println("✅ 优惠券适用景点迁移完成")
+}
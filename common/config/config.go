@@ -6,6 +6,9 @@ type Config struct {
 	MysqlInit
 	RedisInit
 	Coupon
+	Notify
+	Order
+	Verify
 }
 
 type MysqlInit struct {
@@ -27,4 +30,23 @@ type Coupon struct {
 	AntiBrushLimit  int
 	AntiBrushExpire int
 	AesKey          string
+	Holidays        []string // 法定节假日日期，格式 2006-01-02，供优惠券节假日规则使用
+}
+
+type Notify struct {
+	Channels   []string // 启用的通知渠道：SMS、IN_APP、WEBHOOK
+	WebhookURL string
+}
+
+type Order struct {
+	Addr              string // 订单服务监听地址，如 :8889
+	PayTimeoutMinutes int    // 待支付订单超时自动取消的分钟数，<=0 使用默认15分钟
+}
+
+type Verify struct {
+	Addr         string // 核销服务监听地址，如 :8890
+	QRSecret     string // 核销二维码 HMAC 签名密钥
+	QRTTLSeconds int    // 核销二维码有效秒数，<=0 使用默认60秒
+	WhitelistKey string // 离线白名单 Ed25519 签名私钥种子，闸机预置对应公钥
+	DeviceKey    string // 闸机设备密钥派生主密钥，更换后全部闸机需重置密钥
 }
@@ -1 +1,98 @@
 package constant
+
+// 优惠券类型
+const (
+	CouponTypeFixed    = "FIXED"    // 满减券，Denomination为减免金额
+	CouponTypeDiscount = "DISCOUNT" // 折扣券，Denomination为折扣率，取值(0,1)，如0.85表示85折
+)
+
+// 优惠券状态
+const (
+	CouponStatusValid   = "VALID"   // 有效
+	CouponStatusInvalid = "INVALID" // 失效
+)
+
+// 发券方
+const (
+	IssuerTypePlatform = "PLATFORM" // 平台券，平台承担优惠
+	IssuerTypeMerchant = "MERCHANT" // 商家券，仅限发券商家的订单使用
+)
+
+// 优惠券适用范围类型
+const (
+	ScopeTypeSpot       = "SPOT"        // 指定景点
+	ScopeTypeTicketType = "TICKET_TYPE" // 指定门票类型，如仅限成人票
+	ScopeTypeCity       = "CITY"        // 指定城市的景点
+	ScopeTypeProvince   = "PROVINCE"    // 指定省份的景点
+)
+
+// MaxStackCoupons 单个订单最多同时使用的优惠券数量：平台券、商家券各一张
+const MaxStackCoupons = 2
+
+// 用户优惠券使用状态
+const (
+	UseStatusUnused      = "UNUSED"      // 未使用
+	UseStatusLocked      = "LOCKED"      // 已锁定，订单待支付
+	UseStatusUsed        = "USED"        // 已使用
+	UseStatusExpired     = "EXPIRED"     // 已过期
+	UseStatusTransferred = "TRANSFERRED" // 已转赠，由受赠人名下的新记录接续
+)
+
+// 优惠券转赠状态
+const (
+	TransferStatusPending   = "PENDING"   // 待领取
+	TransferStatusClaimed   = "CLAIMED"   // 已被领取
+	TransferStatusCancelled = "CANCELLED" // 已取消
+	TransferStatusExpired   = "EXPIRED"   // 已过期
+)
+
+// 秒杀领取结果状态
+const (
+	ClaimStatusPending = "PENDING" // 已抢到，等待落库
+	ClaimStatusSuccess = "SUCCESS" // 已落库
+	ClaimStatusFailed  = "FAILED"  // 落库失败
+)
+
+// 优惠券过期提醒类型
+const (
+	RemindTypeBefore24H = "BEFORE_24H" // 过期前24小时
+	RemindTypeBefore1H  = "BEFORE_1H"  // 过期前1小时
+)
+
+// 提醒投递状态
+const (
+	RemindStatusPending   = "PENDING"   // 待发送
+	RemindStatusSending   = "SENDING"   // 发送中，异常中断后不自动重发，避免重复打扰
+	RemindStatusSent      = "SENT"      // 已发送
+	RemindStatusFailed    = "FAILED"    // 发送失败，未超过重试次数时重发
+	RemindStatusCancelled = "CANCELLED" // 已取消，如优惠券已使用
+)
+
+// 兑换码状态
+const (
+	CodeStatusUnused   = "UNUSED"   // 未兑换
+	CodeStatusRedeemed = "REDEEMED" // 已兑换
+	CodeStatusRevoked  = "REVOKED"  // 已作废
+)
+
+// 兑换码批次状态
+const (
+	BatchStatusActive  = "ACTIVE"  // 有效
+	BatchStatusRevoked = "REVOKED" // 已作废
+)
+
+// 定向发券任务状态
+const (
+	DistStatusPending   = "PENDING"   // 待执行
+	DistStatusRunning   = "RUNNING"   // 执行中
+	DistStatusCompleted = "COMPLETED" // 已完成
+	DistStatusStockOut  = "STOCK_OUT" // 库存不足终止
+	DistStatusFailed    = "FAILED"    // 连续失败或优惠券失效，可恢复重试
+)
+
+// 分页默认值
+const (
+	DefaultPage     = 1
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
@@ -0,0 +1,8 @@
+package constant
+
+// 商家审核状态
+const (
+	AuditStatusInitial  = "INITIAL"  // 待审核
+	AuditStatusApproved = "APPROVED" // 审核通过
+	AuditStatusRejected = "REJECTED" // 审核驳回
+)
@@ -0,0 +1,61 @@
+package constant
+
+// 订单状态
+const (
+	OrderStatusDraft      = "DRAFT"       // 草稿
+	OrderStatusPendingPay = "PENDING_PAY" // 待支付
+	OrderStatusPaid       = "PAID"        // 已支付，待核销
+	OrderStatusVerified   = "VERIFIED"    // 已核销
+	OrderStatusCancelled  = "CANCELLED"   // 已取消
+	OrderStatusRefunding  = "REFUNDING"   // 退款中
+	OrderStatusRefunded   = "REFUNDED"    // 已退款
+)
+
+// 订单明细状态，每位出行人可单独核销或退款
+const (
+	ItemStatusUnused   = "UNUSED"   // 待核销
+	ItemStatusVerified = "VERIFIED" // 已核销
+	ItemStatusRefunded = "REFUNDED" // 已退款
+)
+
+// 支付记录状态
+const (
+	PayStatusSuccess   = "SUCCESS"   // 支付成功
+	PayStatusFail      = "FAIL"      // 支付失败
+	PayStatusRefund    = "REFUND"    // 已退款
+	PayStatusRefunding = "REFUNDING" // 退款中
+)
+
+// 改期记录状态，需补差价的改期在支付回调后完成
+const (
+	RescheduleStatusPendingPay = "PENDING_PAY" // 待补差价
+	RescheduleStatusDone       = "DONE"        // 已改期
+	RescheduleStatusClosed     = "CLOSED"      // 已失效，如被新的改期申请取代或条件不再满足
+)
+
+// 门票状态
+const (
+	TicketStatusOnSale   = "ON_SALE"   // 在售
+	TicketStatusOffSale  = "OFF_SALE"  // 下架
+	TicketStatusStockOut = "STOCK_OUT" // 售罄
+)
+
+// 支付方式
+const (
+	PayTypeWechat = "WECHAT" // 微信
+	PayTypeAlipay = "ALIPAY" // 支付宝
+)
+
+// 检票闸机状态
+const (
+	GateDeviceEnabled  = "ENABLED"  // 启用
+	GateDeviceDisabled = "DISABLED" // 停用
+)
+
+// 离线核销对账结果
+const (
+	OfflineResultApplied   = "APPLIED"   // 已补录到订单
+	OfflineResultDuplicate = "DUPLICATE" // 同一闸机重复上传或重复扫码
+	OfflineResultConflict  = "CONFLICT"  // 与其他闸机核销或退款冲突
+	OfflineResultRejected  = "REJECTED"  // 核销码无效、非本景点或不在有效期
+)
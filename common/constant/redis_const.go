@@ -0,0 +1,15 @@
+package constant
+
+// Redis Key 格式
+const (
+	RedisKeyCouponStock     = "coupon:stock:%d"             // 优惠券剩余库存，参数：优惠券ID
+	RedisKeyCouponUserClaim = "coupon:user_claim:%d:%d"     // 用户已领取张数，参数：优惠券ID、用户ID
+	RedisKeyAntiBrushUser   = "coupon:anti_brush:user:%d"   // 用户维度领取滑动窗口，参数：用户ID
+	RedisKeyAntiBrushDevice = "coupon:anti_brush:device:%s" // 设备维度领取滑动窗口，参数：设备ID
+	RedisKeyClaimToken      = "coupon:claim_token:%s"       // 秒杀领取结果，参数：领取凭证
+	RedisKeyClaimStream     = "coupon:claim:stream"         // 秒杀领取消息流
+	RedisGroupClaimStream   = "coupon_claim_group"          // 秒杀领取消息流消费组
+	RedisKeySpotCoupons     = "coupon:spot:%d:%d"           // 景点可用优惠券列表缓存，参数：缓存版本、景点ID
+	RedisKeySpotCouponsVer  = "coupon:spot:version"         // 景点可用优惠券缓存版本，优惠券变更时递增
+	RedisKeyOrderPayTimeout = "order:pay_timeout"           // 待支付订单超时取消延时队列，成员：订单ID，分值：超时时间
+)
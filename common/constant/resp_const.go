@@ -0,0 +1,12 @@
+package constant
+
+// 响应状态码
+const (
+	CodeSuccess     = 200 // 成功
+	CodeParamError  = 400 // 参数错误
+	CodeForbidden   = 403 // 无权操作
+	CodeNotFound    = 404 // 数据不存在
+	CodeConflict    = 409 // 状态冲突
+	CodeTooFrequent = 429 // 操作过于频繁
+	CodeServerError = 500 // 服务内部错误
+)
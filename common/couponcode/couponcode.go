@@ -0,0 +1,83 @@
+// Package couponcode 兑换码生成与校验
+// 兑换码由 11 位随机字符加 1 位 Luhn mod N 校验位组成，字符集去除了易混淆的 0/O/1/I，
+// 单字符输错及相邻字符颠倒均可在本地识别，无需请求服务端
+package couponcode
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// alphabet 兑换码字符集，共32个字符
+const alphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+const (
+	bodyLen = 11          // 随机部分长度，约55位熵
+	Length  = bodyLen + 1 // 兑换码总长度（含校验位）
+	groupSz = 4           // 展示时每组字符数
+)
+
+var ErrInvalidCode = errors.New("兑换码格式错误")
+
+// Generate 生成一个带校验位的兑换码
+func Generate() (string, error) {
+	n := big.NewInt(int64(len(alphabet)))
+	b := make([]byte, bodyLen, Length)
+	for i := range b {
+		idx, err := rand.Int(rand.Reader, n)
+		if err != nil {
+			return "", err
+		}
+		b[i] = alphabet[idx.Int64()]
+	}
+	return string(append(b, checkChar(string(b)))), nil
+}
+
+// Normalize 去除空格与分隔符并转为大写，校验通过返回规范化后的兑换码
+func Normalize(code string) (string, error) {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+	if !Valid(code) {
+		return "", ErrInvalidCode
+	}
+	return code, nil
+}
+
+// Valid 校验规范化后的兑换码长度、字符集与校验位
+func Valid(code string) bool {
+	if len(code) != Length {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if strings.IndexByte(alphabet, code[i]) < 0 {
+			return false
+		}
+	}
+	return checkChar(code[:bodyLen]) == code[bodyLen]
+}
+
+// Format 按4位一组以短横线分隔，便于印刷与输入
+func Format(code string) string {
+	var sb strings.Builder
+	for i := 0; i < len(code); i++ {
+		if i > 0 && i%groupSz == 0 {
+			sb.WriteByte('-')
+		}
+		sb.WriteByte(code[i])
+	}
+	return sb.String()
+}
+
+// checkChar 计算 Luhn mod N 校验字符
+func checkChar(body string) byte {
+	n := len(alphabet)
+	factor := 2
+	sum := 0
+	for i := len(body) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(alphabet, body[i])
+		factor = 3 - factor
+		sum += addend/n + addend%n
+	}
+	return alphabet[(n-sum%n)%n]
+}
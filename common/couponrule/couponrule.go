@@ -0,0 +1,268 @@
+// Package couponrule 优惠券规则引擎：解析、校验 Coupon.ExtFields 中的规则，并在领取与使用时判定
+package couponrule
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"example_shop/common/config"
+	"example_shop/common/constant"
+	"example_shop/common/model"
+	"fmt"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 节假日限制取值
+const (
+	HolidayExclude = "EXCLUDE" // 节假日不可用
+	HolidayOnly    = "ONLY"    // 仅节假日可用
+)
+
+const clockLayout = "15:04"
+
+// Violation 不满足优惠券规则，消息可直接返回给调用方
+type Violation struct{ Msg string }
+
+func (v *Violation) Error() string { return v.Msg }
+
+// IsViolation 判断错误是否为规则不满足
+func IsViolation(err error) bool {
+	var v *Violation
+	return errors.As(err, &v)
+}
+
+// Parse 严格解析扩展字段：不认识的字段、不支持的版本或非法取值均返回错误，用于创建和修改优惠券时校验
+func Parse(raw []byte) (model.CouponExt, error) {
+	var ext model.CouponExt
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return ext, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&ext); err != nil {
+		return ext, fmt.Errorf("扩展规则格式错误: %w", err)
+	}
+	return ext, Validate(ext)
+}
+
+// Validate 校验规则取值
+func Validate(ext model.CouponExt) error {
+	if ext.Version != 0 && ext.Version != model.CouponExtVersion {
+		return fmt.Errorf("不支持的规则版本: %d", ext.Version)
+	}
+	if ext.PerUserLimit < 0 {
+		return errors.New("每人限领张数不能小于0")
+	}
+	if ext.MaxDiscount < 0 {
+		return errors.New("最高优惠金额不能小于0")
+	}
+	seen := make(map[int]bool, len(ext.Weekdays))
+	for _, d := range ext.Weekdays {
+		if d < 1 || d > 7 {
+			return errors.New("可用星期取值为1-7")
+		}
+		if seen[d] {
+			return fmt.Errorf("可用星期重复: %d", d)
+		}
+		seen[d] = true
+	}
+	switch ext.Holiday {
+	case "", HolidayExclude, HolidayOnly:
+	default:
+		return errors.New("节假日限制只能是EXCLUDE或ONLY")
+	}
+	for _, w := range ext.TimeWindows {
+		start, err1 := time.Parse(clockLayout, w.Start)
+		end, err2 := time.Parse(clockLayout, w.End)
+		if err1 != nil || err2 != nil {
+			return fmt.Errorf("可用时段格式错误: %s-%s", w.Start, w.End)
+		}
+		if start.Equal(end) {
+			return fmt.Errorf("可用时段开始与结束时间不能相同: %s", w.Start)
+		}
+	}
+	if ext.MinTicketCount < 0 {
+		return errors.New("最少购票张数不能小于0")
+	}
+	if ext.MaxTransfers < 0 {
+		return errors.New("最多转赠次数不能小于0")
+	}
+	if ext.MaxTransfers > 0 && !ext.Transferable {
+		return errors.New("设置最多转赠次数时需同时允许转赠")
+	}
+	if ext.MaxTransfers > math.MaxUint8 {
+		return fmt.Errorf("最多转赠次数不能超过%d", math.MaxUint8)
+	}
+	for _, pt := range ext.PayTypes {
+		if pt != constant.PayTypeWechat && pt != constant.PayTypeAlipay {
+			return fmt.Errorf("不支持的支付方式: %s", pt)
+		}
+	}
+	return nil
+}
+
+// ClaimInput 领取时的判定输入
+type ClaimInput struct {
+	NewUser bool // 用户是否从未支付过订单
+}
+
+// CheckClaim 校验领取规则，每人限领张数由领取流程的库存脚本保证
+func CheckClaim(ext model.CouponExt, in ClaimInput) error {
+	if ext.NewUserOnly && !in.NewUser {
+		return &Violation{"该优惠券仅限新用户领取"}
+	}
+	return nil
+}
+
+// RedeemInput 使用时的判定输入
+type RedeemInput struct {
+	Now     time.Time
+	NewUser bool
+	PayType string // 空表示尚未选择支付方式
+	// Strict 下单锁券时为true：尚未选择支付方式时视为不满足支付方式限制；预览与推荐时为false
+	Strict bool
+}
+
+// CheckRedeem 校验使用规则：新用户、星期、节假日、时段与支付方式，最少购票张数由计价时按适用明细判定
+func CheckRedeem(ext model.CouponExt, in RedeemInput) error {
+	if ext.NewUserOnly && !in.NewUser {
+		return &Violation{"该优惠券仅限新用户使用"}
+	}
+	if len(ext.Weekdays) > 0 && !containsInt(ext.Weekdays, isoWeekday(in.Now)) {
+		return &Violation{"该优惠券今日不可用"}
+	}
+	switch ext.Holiday {
+	case HolidayExclude:
+		if IsHoliday(in.Now) {
+			return &Violation{"该优惠券节假日不可用"}
+		}
+	case HolidayOnly:
+		if !IsHoliday(in.Now) {
+			return &Violation{"该优惠券仅限节假日使用"}
+		}
+	}
+	if len(ext.TimeWindows) > 0 && !inTimeWindows(ext.TimeWindows, in.Now) {
+		return &Violation{"当前时段不可使用该优惠券"}
+	}
+	if len(ext.PayTypes) > 0 {
+		if in.PayType == "" {
+			if in.Strict {
+				return &Violation{"该优惠券限定支付方式，请先选择支付方式"}
+			}
+		} else if !containsString(ext.PayTypes, in.PayType) {
+			return &Violation{"当前支付方式不可使用该优惠券"}
+		}
+	}
+	return nil
+}
+
+// CheckTicketCount 校验适用门票的购票张数
+func CheckTicketCount(ext model.CouponExt, tickets int) error {
+	if ext.MinTicketCount > 0 && tickets < ext.MinTicketCount {
+		return &Violation{fmt.Sprintf("该优惠券需至少购买%d张适用门票", ext.MinTicketCount)}
+	}
+	return nil
+}
+
+// MaxTransfers 返回沿转赠链允许的最多转赠次数，不可转赠时为0
+func MaxTransfers(ext model.CouponExt) int {
+	if !ext.Transferable {
+		return 0
+	}
+	if ext.MaxTransfers <= 0 {
+		return 1
+	}
+	return ext.MaxTransfers
+}
+
+// CheckTransfer 校验已被转赠 transferCount 次的券能否再次转赠
+func CheckTransfer(ext model.CouponExt, transferCount int) error {
+	if !ext.Transferable {
+		return &Violation{"该优惠券不支持转赠"}
+	}
+	if transferCount >= MaxTransfers(ext) {
+		return &Violation{"该优惠券转赠次数已达上限"}
+	}
+	return nil
+}
+
+// NeedsNewUser 判断优惠券中是否有仅限新用户的规则，调用方据此决定是否查询 IsNewUser
+func NeedsNewUser(coupons ...*model.Coupon) bool {
+	for _, c := range coupons {
+		if c == nil {
+			continue
+		}
+		if ext, err := c.Ext(); err == nil && ext.NewUserOnly {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNewUser 用户是否从未支付过订单
+func IsNewUser(tx *gorm.DB, userID uint64) (bool, error) {
+	var ids []uint64
+	err := tx.Model(&model.OrderMain{}).Where("user_id = ? AND pay_time IS NOT NULL", userID).
+		Limit(1).Pluck("id", &ids).Error
+	return len(ids) == 0, err
+}
+
+// IsHoliday 判断日期是否为配置的法定节假日
+func IsHoliday(t time.Time) bool {
+	day := t.Format("2006-01-02")
+	for _, h := range config.Cfg.Coupon.Holidays {
+		if h == day {
+			return true
+		}
+	}
+	return false
+}
+
+// isoWeekday 返回1-7表示周一至周日
+func isoWeekday(t time.Time) int {
+	if wd := int(t.Weekday()); wd != 0 {
+		return wd
+	}
+	return 7
+}
+
+// inTimeWindows 判断时刻是否落在任一时段内
+func inTimeWindows(windows []model.TimeWindow, t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	for _, w := range windows {
+		start, err1 := time.Parse(clockLayout, w.Start)
+		end, err2 := time.Parse(clockLayout, w.End)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		s, e := start.Hour()*60+start.Minute(), end.Hour()*60+end.Minute()
+		if s < e && minute >= s && minute < e {
+			return true
+		}
+		if s > e && (minute >= s || minute < e) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(list []int, v int) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package db
+
+import (
+	"example_shop/common/constant"
+	"example_shop/common/model"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const scopeMigrateBatch = 200
+
+// MigrateCouponScopes 将 Coupon.ApplySpotIDs 逗号串迁移为 coupon_scope 景点范围，迁移成功后清空原字段，可重复执行
+// 解析不出任何合法景点ID的记录保留原值，由计价兼容逻辑按原语义处理
+// 一次性数据迁移，由 cmd/migrate_coupon_scope 在发布时手动执行，不随服务启动
+func MigrateCouponScopes(db *gorm.DB) error {
+	var lastID, migrated uint64
+	for {
+		var list []model.Coupon
+		err := db.Unscoped().Select("id", "apply_spot_ids").
+			Where("id > ? AND apply_spot_ids IS NOT NULL AND apply_spot_ids <> ''", lastID).
+			Order("id").Limit(scopeMigrateBatch).Find(&list).Error
+		if err != nil {
+			return fmt.Errorf("查询待迁移优惠券失败: %w", err)
+		}
+		for _, c := range list {
+			lastID = c.ID
+			scopes := parseLegacySpotIDs(c.ID, *c.ApplySpotIDs)
+			if len(scopes) == 0 {
+				log.Printf("优惠券%d的适用景点无法解析，保留原值: %q", c.ID, *c.ApplySpotIDs)
+				continue
+			}
+			err = db.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&scopes).Error; err != nil {
+					return err
+				}
+				return tx.Unscoped().Model(&model.Coupon{}).Where("id = ?", c.ID).
+					Update("apply_spot_ids", nil).Error
+			})
+			if err != nil {
+				return fmt.Errorf("迁移优惠券%d适用景点失败: %w", c.ID, err)
+			}
+			migrated++
+		}
+		if len(list) < scopeMigrateBatch {
+			break
+		}
+	}
+	if migrated > 0 {
+		log.Printf("已迁移%d张优惠券的适用景点至 coupon_scope", migrated)
+	}
+	return nil
+}
+
+// parseLegacySpotIDs 解析逗号分隔的景点ID，忽略非法与重复值
+func parseLegacySpotIDs(couponID uint64, raw string) []model.CouponScope {
+	seen := make(map[uint64]struct{})
+	var scopes []model.CouponScope
+	for _, part := range strings.Split(raw, ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(part), 10, 64)
+		if err != nil || id == 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		scopes = append(scopes, model.CouponScope{
+			CouponID:  couponID,
+			ScopeType: constant.ScopeTypeSpot,
+			SpotID:    id,
+		})
+	}
+	return scopes
+}
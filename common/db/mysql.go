@@ -58,6 +58,21 @@ func MysqlInit() error {
 		&model.OrderItem{},   // 订单详情表（依赖 OrderMain, TicketType, Traveler）
 		&model.PayRecord{},   // 支付记录表（依赖 OrderMain）
 		&model.SysOperLog{},  // 操作日志表（依赖 SysAdmin）
+		// 第四层：业务扩展表
+		&model.CouponRemindLog{},     // 优惠券过期提醒记录表（依赖 UserCoupon）
+		&model.CouponCodeBatch{},     // 兑换码批次表（依赖 Coupon）
+		&model.CouponCode{},          // 兑换码表（依赖 CouponCodeBatch）
+		&model.OrderCoupon{},         // 订单优惠券关联表（依赖 OrderMain, UserCoupon）
+		&model.CouponScope{},         // 优惠券适用范围表（依赖 Coupon, SpotInfo, TicketType）
+		&model.CouponSegment{},       // 用户分群表
+		&model.CouponDistribution{},  // 定向发券任务表（依赖 Coupon, CouponSegment）
+		&model.CouponStat{},          // 优惠券累计统计表（依赖 Coupon）
+		&model.CouponStatDaily{},     // 优惠券日统计表（依赖 Coupon）
+		&model.CouponTransfer{},      // 优惠券转赠记录表（依赖 UserCoupon）
+		&model.OrderStatusLog{},      // 订单状态流转记录表（依赖 OrderMain）
+		&model.GateDevice{},          // 检票闸机表（依赖 SysMerchant, SpotInfo）
+		&model.OfflineVerifyRecord{}, // 离线核销记录表（依赖 GateDevice, OrderMain）
+		&model.OrderReschedule{},     // 订单改期记录表（依赖 OrderMain）
 	)
 	if err != nil {
 		// 恢复外键检查
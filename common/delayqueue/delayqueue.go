@@ -0,0 +1,62 @@
+// Package delayqueue 基于 Redis 有序集合的延时任务：成员为任务ID，分值为到期时间（秒级时间戳）
+// 任务处理成功后才从集合中删除，处理失败的任务在下次拉取时重试，处理逻辑需保证幂等
+package delayqueue
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Queue 一个延时队列
+type Queue struct {
+	rdb *redis.Client
+	key string
+}
+
+// New 创建延时队列，key 为有序集合的 Redis Key
+func New(rdb *redis.Client, key string) *Queue {
+	return &Queue{rdb: rdb, key: key}
+}
+
+// Add 添加或更新任务的到期时间
+func (q *Queue) Add(ctx context.Context, id uint64, at time.Time) error {
+	return q.rdb.ZAdd(ctx, q.key, redis.Z{Score: float64(at.Unix()), Member: id}).Err()
+}
+
+// Due 按到期时间从早到晚返回截至 now 已到期的任务，最多 limit 个
+func (q *Queue) Due(ctx context.Context, now time.Time, limit int64) ([]uint64, error) {
+	members, err := q.rdb.ZRangeByScore(ctx, q.key, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.Unix(), 10),
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint64, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseUint(m, 10, 64)
+		if err != nil {
+			// 非法成员直接移除，避免每次都被拉取
+			q.rdb.ZRem(ctx, q.key, m)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Remove 删除任务
+func (q *Queue) Remove(ctx context.Context, ids ...uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	members := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		members = append(members, id)
+	}
+	return q.rdb.ZRem(ctx, q.key, members...).Err()
+}
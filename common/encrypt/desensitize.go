@@ -1 +1,29 @@
 package encrypt
+
+// MaskPhone 手机号脱敏，保留前3位和后4位，如 138****5678
+func MaskPhone(phone string) string {
+	r := []rune(phone)
+	if len(r) < 7 {
+		return phone
+	}
+	masked := make([]rune, 0, len(r))
+	masked = append(masked, r[:3]...)
+	for i := 3; i < len(r)-4; i++ {
+		masked = append(masked, '*')
+	}
+	return string(append(masked, r[len(r)-4:]...))
+}
+
+// MaskName 姓名脱敏，仅保留首字，如 张**
+func MaskName(name string) string {
+	r := []rune(name)
+	if len(r) < 2 {
+		return name
+	}
+	masked := make([]rune, 0, len(r))
+	masked = append(masked, r[0])
+	for i := 1; i < len(r); i++ {
+		masked = append(masked, '*')
+	}
+	return string(masked)
+}
@@ -0,0 +1,115 @@
+package idgen
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	timeLayout = "20060102150405" // 编号中的时间部分，精确到秒
+	refundPre  = "RF"
+
+	// codeAlphabet 核销码字符集，去除易混淆的 0/O/1/I，共32个字符
+	codeAlphabet  = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+	codeIDLen     = 13 // ID 部分长度，32^13 > 2^63
+	codeRandLen   = 7  // 随机部分长度，35位熵，防止按ID规律枚举
+	VerifyCodeLen = codeIDLen + codeRandLen
+)
+
+var ErrNotInit = errors.New("ID生成器未初始化")
+
+// numberZone 编号中的时间统一按东八区格式化，固定偏移避免夏令时造成秒级时间重复
+var numberZone = time.FixedZone("CST", 8*3600)
+
+// defaultNode 服务进程使用的生成器，由 Init 设置
+var defaultNode *Node
+
+// Init 从 Redis 租用节点号并设置进程默认生成器，服务启动时调用一次
+func Init(ctx context.Context, rdb *redis.Client) error {
+	n, err := Lease(ctx, rdb)
+	if err != nil {
+		return err
+	}
+	defaultNode = n
+	return nil
+}
+
+// OrderNo 使用默认生成器生成订单编号，见 Node.OrderNo
+func OrderNo(userID uint64) (string, error) {
+	if defaultNode == nil {
+		return "", ErrNotInit
+	}
+	return defaultNode.OrderNo(userID)
+}
+
+// RefundNo 使用默认生成器生成退款单号，见 Node.RefundNo
+func RefundNo() (string, error) {
+	if defaultNode == nil {
+		return "", ErrNotInit
+	}
+	return defaultNode.RefundNo()
+}
+
+// VerifyCode 使用默认生成器生成核销码，见 Node.VerifyCode
+func VerifyCode() (string, error) {
+	if defaultNode == nil {
+		return "", ErrNotInit
+	}
+	return defaultNode.VerifyCode()
+}
+
+// OrderNo 订单编号，28位数字：14位下单时间（秒）+ 4位用户ID后4位 + 10位毫秒内序列
+// 序列由ID的毫秒、节点号与序号组成，与时间部分一起可还原完整ID，因此不会重复；编号按秒有序
+func (n *Node) OrderNo(userID uint64) (string, error) {
+	id, err := n.Next()
+	if err != nil {
+		return "", err
+	}
+	t, tail := split(id)
+	return fmt.Sprintf("%s%04d%010d", t, userID%10000, tail), nil
+}
+
+// RefundNo 退款单号，26位：RF + 14位时间（秒）+ 10位毫秒内序列
+func (n *Node) RefundNo() (string, error) {
+	id, err := n.Next()
+	if err != nil {
+		return "", err
+	}
+	t, tail := split(id)
+	return fmt.Sprintf("%s%s%010d", refundPre, t, tail), nil
+}
+
+// VerifyCode 核销码，20位：13位ID编码保证唯一 + 7位随机字符防止枚举
+func (n *Node) VerifyCode() (string, error) {
+	id, err := n.Next()
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, VerifyCodeLen)
+	for i := codeIDLen - 1; i >= 0; i-- {
+		b[i] = codeAlphabet[id&31]
+		id >>= 5
+	}
+	max := big.NewInt(int64(len(codeAlphabet)))
+	for i := codeIDLen; i < VerifyCodeLen; i++ {
+		idx, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = codeAlphabet[idx.Int64()]
+	}
+	return string(b), nil
+}
+
+// split 将ID拆为秒级时间串与秒内序列（毫秒<<22 | 节点号<<12 | 序号，最大 4194303999，10位）
+func split(id int64) (string, int64) {
+	t, _, _ := Parts(id)
+	ms := int64(t.Nanosecond() / 1e6)
+	return t.In(numberZone).Format(timeLayout), ms<<(workerBits+seqBits) | id&(1<<(workerBits+seqBits)-1)
+}
@@ -0,0 +1,219 @@
+package idgen
+
+import (
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+)
+
+// collect 多个节点各启动若干协程并发生成，返回全部结果
+func collect(t *testing.T, nodes []*Node, goroutines, perG int, gen func(*Node) (string, error)) []string {
+	t.Helper()
+	out := make([][]string, len(nodes)*goroutines)
+	var wg sync.WaitGroup
+	for i, n := range nodes {
+		for g := 0; g < goroutines; g++ {
+			idx := i*goroutines + g
+			wg.Add(1)
+			go func(n *Node) {
+				defer wg.Done()
+				list := make([]string, 0, perG)
+				for k := 0; k < perG; k++ {
+					s, err := gen(n)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					list = append(list, s)
+				}
+				out[idx] = list
+			}(n)
+		}
+	}
+	wg.Wait()
+	var all []string
+	for _, list := range out {
+		all = append(all, list...)
+	}
+	return all
+}
+
+func newNodes(t *testing.T, count int) []*Node {
+	t.Helper()
+	nodes := make([]*Node, count)
+	for i := range nodes {
+		n, err := NewNode(int64(i * 97 % (MaxWorker + 1)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes[i] = n
+	}
+	return nodes
+}
+
+func assertUnique(t *testing.T, list []string, want int) {
+	t.Helper()
+	if len(list) != want {
+		t.Fatalf("生成数量 %d, 期望 %d", len(list), want)
+	}
+	seen := make(map[string]struct{}, len(list))
+	for _, s := range list {
+		if _, dup := seen[s]; dup {
+			t.Fatalf("出现重复: %s", s)
+		}
+		seen[s] = struct{}{}
+	}
+}
+
+func TestNextUniqueConcurrent(t *testing.T) {
+	const nodes, goroutines, perG = 4, 32, 10000
+	ids := make(chan int64, nodes*goroutines*perG)
+	var wg sync.WaitGroup
+	for _, n := range newNodes(t, nodes) {
+		for g := 0; g < goroutines; g++ {
+			wg.Add(1)
+			go func(n *Node) {
+				defer wg.Done()
+				var last int64
+				for k := 0; k < perG; k++ {
+					id, err := n.Next()
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					if id <= last {
+						t.Errorf("同一节点ID未递增: %d <= %d", id, last)
+						return
+					}
+					last = id
+					ids <- id
+				}
+			}(n)
+		}
+	}
+	wg.Wait()
+	close(ids)
+	seen := make(map[int64]struct{}, cap(ids))
+	for id := range ids {
+		if _, dup := seen[id]; dup {
+			t.Fatalf("出现重复ID: %d", id)
+		}
+		seen[id] = struct{}{}
+	}
+	if len(seen) != nodes*goroutines*perG {
+		t.Fatalf("生成数量 %d, 期望 %d", len(seen), nodes*goroutines*perG)
+	}
+}
+
+func TestOrderNoUniqueConcurrent(t *testing.T) {
+	const nodes, goroutines, perG = 4, 32, 5000
+	// 同一用户并发下单，用户ID部分相同，完全依赖序列保证唯一
+	list := collect(t, newNodes(t, nodes), goroutines, perG, func(n *Node) (string, error) { return n.OrderNo(123456789) })
+	assertUnique(t, list, nodes*goroutines*perG)
+	format := regexp.MustCompile(`^\d{14}6789\d{10}$`)
+	for _, s := range list[:100] {
+		if !format.MatchString(s) || len(s) > 32 {
+			t.Fatalf("订单编号格式错误: %s", s)
+		}
+	}
+}
+
+func TestRefundNoAndVerifyCodeUniqueConcurrent(t *testing.T) {
+	const nodes, goroutines, perG = 2, 32, 5000
+	refunds := collect(t, newNodes(t, nodes), goroutines, perG, func(n *Node) (string, error) { return n.RefundNo() })
+	assertUnique(t, refunds, nodes*goroutines*perG)
+
+	codes := collect(t, newNodes(t, nodes), goroutines, perG, func(n *Node) (string, error) { return n.VerifyCode() })
+	assertUnique(t, codes, nodes*goroutines*perG)
+	format := regexp.MustCompile(`^[23456789ABCDEFGHJKLMNPQRSTUVWXYZ]{20}$`)
+	for _, s := range codes[:100] {
+		if !format.MatchString(s) {
+			t.Fatalf("核销码格式错误: %s", s)
+		}
+	}
+}
+
+func TestOrderNoSortedByTime(t *testing.T) {
+	n, _ := NewNode(1)
+	base := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	var prev string
+	for i := 0; i < 5; i++ {
+		at := base.Add(time.Duration(i) * time.Second)
+		n.now = func() time.Time { return at }
+		no, err := n.OrderNo(42)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if no <= prev {
+			t.Fatalf("订单编号未按时间递增: %s <= %s", no, prev)
+		}
+		prev = no
+	}
+	if want := "20260501180004"; prev[:14] != want {
+		t.Fatalf("时间部分 %s, 期望东八区 %s", prev[:14], want)
+	}
+}
+
+func TestClockBackwardsAndSeqOverflow(t *testing.T) {
+	n, _ := NewNode(7)
+	at := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	n.now = func() time.Time { return at }
+	seen := make(map[int64]struct{})
+	var last int64
+	next := func() {
+		id, err := n.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, dup := seen[id]; dup || id <= last {
+			t.Fatalf("ID重复或未递增: %d (last %d)", id, last)
+		}
+		seen[id] = struct{}{}
+		last = id
+	}
+	// 同一毫秒内超过序号上限，借用下一毫秒
+	for i := 0; i < 3*(maxSeq+1); i++ {
+		next()
+	}
+	// 时钟回拨1秒
+	at = at.Add(-time.Second)
+	for i := 0; i < 1000; i++ {
+		next()
+	}
+	_, worker, _ := Parts(last)
+	if worker != 7 {
+		t.Fatalf("节点号 %d, 期望 7", worker)
+	}
+}
+
+func TestLeaseExpiredStopsIssuing(t *testing.T) {
+	n, _ := NewNode(3)
+	n.validUntil.Store(time.Now().Add(-time.Millisecond).UnixMilli())
+	if _, err := n.Next(); err != ErrLeaseExpired {
+		t.Fatalf("租约失效后应拒绝发号, err=%v", err)
+	}
+	if _, err := NewNode(MaxWorker + 1); err == nil {
+		t.Fatal("节点号越界应返回错误")
+	}
+}
+
+func TestSetWorkerKeepsIncreasing(t *testing.T) {
+	n, _ := NewNode(9)
+	n.validUntil.Store(time.Now().Add(time.Minute).UnixMilli())
+	before, err := n.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.setWorker(2)
+	after, err := n.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after <= before {
+		t.Fatalf("更换节点号后ID未递增: %d <= %d", after, before)
+	}
+	if _, worker, _ := Parts(after); worker != 2 {
+		t.Fatalf("节点号 %d, 期望 2", worker)
+	}
+}
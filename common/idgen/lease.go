@@ -0,0 +1,92 @@
+package idgen
+
+import (
+	"context"
+	"example_shop/common/redislease"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis Key 格式：工作节点号租约，参数：节点号
+const workerKeyFormat = "idgen:worker:%d"
+
+const (
+	leaseTTL    = 30 * time.Second
+	leaseRenew  = leaseTTL / 3
+	leaseMargin = 2 * time.Second // 本地提前判定租约失效的余量，抵消网络延迟与时钟误差
+)
+
+// Lease 从 Redis 租用一个空闲节点号并创建生成器，后台定期续期，ctx 取消后释放租约
+// 续期失败时在本地租约到期后停止发号（Next 返回 ErrLeaseExpired），
+// 原节点号空闲时重新租用，已被其他副本占用时改租其他空闲节点号后恢复
+func Lease(ctx context.Context, rdb *redis.Client) (*Node, error) {
+	owner := redislease.InstanceID()
+	worker, acquired, err := acquireWorker(ctx, rdb, owner)
+	if err != nil {
+		return nil, err
+	}
+	n, _ := NewNode(worker)
+	n.validUntil.Store(acquired.Add(leaseTTL - leaseMargin).UnixMilli())
+	go n.keepLease(ctx, rdb, owner)
+	log.Printf("租用ID工作节点号: %d", worker)
+	return n, nil
+}
+
+// acquireWorker 从随机位置开始依次尝试租用空闲节点号，返回节点号与发起租用的时间
+func acquireWorker(ctx context.Context, rdb *redis.Client, owner string) (int64, time.Time, error) {
+	start := rand.Int64N(MaxWorker + 1)
+	for i := int64(0); i <= MaxWorker; i++ {
+		worker := (start + i) % (MaxWorker + 1)
+		acquired := time.Now()
+		ok, err := redislease.Acquire(ctx, rdb, fmt.Sprintf(workerKeyFormat, worker), owner, leaseTTL)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		if ok {
+			return worker, acquired, nil
+		}
+	}
+	return 0, time.Time{}, fmt.Errorf("无空闲的ID工作节点号（共%d个）", MaxWorker+1)
+}
+
+// keepLease 定期续期租约
+func (n *Node) keepLease(ctx context.Context, rdb *redis.Client, owner string) {
+	ticker := time.NewTicker(leaseRenew)
+	defer ticker.Stop()
+	for {
+		key := fmt.Sprintf(workerKeyFormat, n.Worker())
+		select {
+		case <-ctx.Done():
+			n.validUntil.Store(0)
+			if err := redislease.Release(context.Background(), rdb, key, owner); err != nil {
+				log.Printf("释放ID工作节点号租约失败: %v", err)
+			}
+			return
+		case <-ticker.C:
+		}
+		renewed := time.Now()
+		ok, err := redislease.Renew(ctx, rdb, key, owner, leaseTTL)
+		if err == nil && !ok {
+			// 租约已过期，节点号未被他人占用时重新租用
+			ok, err = redislease.Acquire(ctx, rdb, key, owner, leaseTTL)
+		}
+		if err == nil && !ok {
+			// 节点号已被其他副本接手，改租其他空闲节点号；本地租约此前已到期，旧节点号不会再发号
+			var worker int64
+			if worker, renewed, err = acquireWorker(ctx, rdb, owner); err == nil {
+				n.setWorker(worker)
+				ok = true
+				log.Printf("原ID工作节点号已被占用，改租节点号: %d", worker)
+			}
+		}
+		if err != nil || !ok {
+			log.Printf("ID工作节点号续期失败: %s, err=%v", key, err)
+			continue
+		}
+		n.validUntil.Store(renewed.Add(leaseTTL - leaseMargin).UnixMilli())
+	}
+}
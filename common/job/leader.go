@@ -0,0 +1,81 @@
+package job
+
+import (
+	"context"
+	"example_shop/common/redislease"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis Key 格式：选主锁，参数：选主组名
+const leaderKeyFormat = "job:leader:%s"
+
+// Elector 基于 Redis SET NX PX 的选主，同一组内同一时刻只有一个副本为主
+type Elector struct {
+	rdb    *redis.Client
+	key    string
+	id     string
+	ttl    time.Duration
+	leader atomic.Bool
+}
+
+// NewElector 创建选主器，ttl 为租约时长，每 ttl/3 续期一次
+func NewElector(rdb *redis.Client, group string, ttl time.Duration) *Elector {
+	return &Elector{
+		rdb: rdb,
+		key: fmt.Sprintf(leaderKeyFormat, group),
+		id:  redislease.InstanceID(),
+		ttl: ttl,
+	}
+}
+
+// IsLeader 当前副本是否为主
+func (e *Elector) IsLeader() bool {
+	return e.leader.Load()
+}
+
+// Run 持续竞选与续期，ctx 取消后主动释放租约
+func (e *Elector) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+	for {
+		e.campaign(ctx)
+		select {
+		case <-ctx.Done():
+			if e.leader.Swap(false) {
+				if err := redislease.Release(context.Background(), e.rdb, e.key, e.id); err != nil {
+					log.Printf("释放选主租约失败: %v", err)
+				}
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// campaign 已是主则续期，否则尝试抢占
+func (e *Elector) campaign(ctx context.Context) {
+	if e.leader.Load() {
+		ok, err := redislease.Renew(ctx, e.rdb, e.key, e.id, e.ttl)
+		if err != nil || !ok {
+			e.leader.Store(false)
+			log.Printf("失去主节点身份: %s, err=%v", e.key, err)
+		}
+		return
+	}
+	ok, err := redislease.Acquire(ctx, e.rdb, e.key, e.id, e.ttl)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("竞选主节点失败: %v", err)
+		}
+		return
+	}
+	if ok {
+		e.leader.Store(true)
+		log.Printf("成为主节点: %s, id=%s", e.key, e.id)
+	}
+}
@@ -0,0 +1,74 @@
+// Package job 后台定时任务，多副本部署时通过 Redis 选主，仅主节点执行任务
+package job
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 选主租约时长
+const leaderTTL = 30 * time.Second
+
+// Job 定时任务
+type Job struct {
+	Name     string                          // 任务名称
+	Interval time.Duration                   // 执行间隔
+	Run      func(ctx context.Context) error // 任务逻辑，需自行保证幂等
+}
+
+// Scheduler 任务调度器
+type Scheduler struct {
+	elector *Elector
+	jobs    []Job
+}
+
+// NewScheduler 创建调度器，group 相同的副本之间竞选主节点
+func NewScheduler(rdb *redis.Client, group string) *Scheduler {
+	return &Scheduler{elector: NewElector(rdb, group, leaderTTL)}
+}
+
+// Register 注册任务，需在 Start 之前调用
+func (s *Scheduler) Register(j Job) {
+	s.jobs = append(s.jobs, j)
+}
+
+// Start 启动选主与所有任务，ctx 取消后退出
+func (s *Scheduler) Start(ctx context.Context) {
+	go s.elector.Run(ctx)
+	for _, j := range s.jobs {
+		go s.loop(ctx, j)
+	}
+}
+
+// loop 按间隔执行任务，非主节点跳过
+func (s *Scheduler) loop(ctx context.Context, j Job) {
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if s.elector.IsLeader() {
+				s.runOnce(ctx, j)
+			}
+		}
+	}
+}
+
+// runOnce 执行一次任务，捕获 panic 避免影响其他任务
+func (s *Scheduler) runOnce(ctx context.Context, j Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[job] %s panic: %v\n%s", j.Name, r, debug.Stack())
+		}
+	}()
+	start := time.Now()
+	if err := j.Run(ctx); err != nil {
+		log.Printf("[job] %s 执行失败, 耗时%s: %v", j.Name, time.Since(start), err)
+	}
+}
@@ -16,15 +16,20 @@ type Coupon struct {
 	ValidStartTime time.Time      `gorm:"column:valid_start_time;type:DATETIME;NOT NULL;index:idx_valid_time;comment:有效期开始时间" json:"valid_start_time"`
 	ValidEndTime   time.Time      `gorm:"column:valid_end_time;type:DATETIME;NOT NULL;index:idx_valid_time;comment:有效期结束时间" json:"valid_end_time"`
 	Stock          uint32         `gorm:"column:stock;type:INT UNSIGNED;NOT NULL;default:0;comment:优惠券库存" json:"stock"`
-	ApplySpotIDs   *string        `gorm:"column:apply_spot_ids;type:VARCHAR(512);comment:适用景点ID集合，逗号分隔，空=全景点通用" json:"apply_spot_ids,omitempty"`
+	ApplySpotIDs   *string        `gorm:"column:apply_spot_ids;type:VARCHAR(512);comment:适用景点ID集合（已迁移至coupon_scope，仅兼容未迁移数据），逗号分隔，空=全景点通用" json:"apply_spot_ids,omitempty"`
 	CouponStatus   string         `gorm:"column:coupon_status;type:VARCHAR(20);NOT NULL;default:'VALID';index:idx_coupon_status;comment:状态：VALID-有效，INVALID-失效" json:"coupon_status"`
 	ExtFields      *JSON          `gorm:"column:ext_fields;type:JSON;comment:扩展字段，如使用规则、限制条件" json:"ext_fields,omitempty"`
+	IssuerType     string         `gorm:"column:issuer_type;type:VARCHAR(20);NOT NULL;default:'PLATFORM';comment:发券方：PLATFORM-平台，MERCHANT-商家" json:"issuer_type"`
+	MerchantID     uint64         `gorm:"column:merchant_id;type:BIGINT UNSIGNED;NOT NULL;default:0;index:idx_merchant_id;comment:发券商家ID，平台券为0" json:"merchant_id"`
+	StackGroup     string         `gorm:"column:stack_group;type:VARCHAR(50);NOT NULL;default:'';comment:互斥组，同组优惠券不可叠加使用，空=不限制" json:"stack_group"`
+	Priority       int32          `gorm:"column:priority;type:INT;NOT NULL;default:0;comment:叠加抵扣优先级，数值大的先抵扣" json:"priority"`
 	CreatedAt      time.Time      `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
 	UpdatedAt      time.Time      `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
 	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at;type:DATETIME;index;comment:软删除时间" json:"deleted_at,omitempty"`
 
 	// 关联关系
-	UserCoupons []UserCoupon `gorm:"foreignKey:CouponID;references:ID" json:"user_coupons,omitempty"`
+	UserCoupons []UserCoupon  `gorm:"foreignKey:CouponID;references:ID" json:"user_coupons,omitempty"`
+	Scopes      []CouponScope `gorm:"foreignKey:CouponID;references:ID" json:"scopes,omitempty"`
 }
 
 func (Coupon) TableName() string {
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+)
+
+// CouponCodeBatch 兑换码批次表-营销线下发放的兑换码按批次生成、导出与作废
+type CouponCodeBatch struct {
+	ID          uint64    `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:批次主键ID" json:"id"`
+	CouponID    uint64    `gorm:"column:coupon_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_coupon_id;comment:关联优惠券ID" json:"coupon_id"`
+	BatchName   string    `gorm:"column:batch_name;type:VARCHAR(100);NOT NULL;comment:批次名称" json:"batch_name"`
+	TotalCount  uint32    `gorm:"column:total_count;type:INT UNSIGNED;NOT NULL;comment:生成数量" json:"total_count"`
+	BatchStatus string    `gorm:"column:batch_status;type:VARCHAR(20);NOT NULL;default:'ACTIVE';comment:批次状态：ACTIVE-有效，REVOKED-已作废" json:"batch_status"`
+	CreatedAt   time.Time `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
+
+	// 关联关系
+	Coupon *Coupon `gorm:"foreignKey:CouponID;references:ID" json:"coupon,omitempty"`
+}
+
+func (CouponCodeBatch) TableName() string {
+	return "coupon_code_batch"
+}
+
+// CouponCode 兑换码表-每个兑换码只能兑换一次，兑换后生成用户优惠券
+type CouponCode struct {
+	ID           uint64     `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:兑换码主键ID" json:"id"`
+	BatchID      uint64     `gorm:"column:batch_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_batch_id;comment:所属批次ID" json:"batch_id"`
+	CouponID     uint64     `gorm:"column:coupon_id;type:BIGINT UNSIGNED;NOT NULL;comment:关联优惠券ID（冗余）" json:"coupon_id"`
+	Code         string     `gorm:"column:code;type:VARCHAR(20);NOT NULL;uniqueIndex:uk_code;comment:兑换码，含校验位" json:"code"`
+	CodeStatus   string     `gorm:"column:code_status;type:VARCHAR(20);NOT NULL;default:'UNUSED';comment:状态：UNUSED-未兑换，REDEEMED-已兑换，REVOKED-已作废" json:"code_status"`
+	UserID       uint64     `gorm:"column:user_id;type:BIGINT UNSIGNED;default:0;comment:兑换用户ID，0=未兑换" json:"user_id"`
+	UserCouponID uint64     `gorm:"column:user_coupon_id;type:BIGINT UNSIGNED;default:0;comment:兑换生成的用户优惠券ID" json:"user_coupon_id"`
+	RedeemTime   *time.Time `gorm:"column:redeem_time;type:DATETIME;comment:兑换时间" json:"redeem_time,omitempty"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
+
+	// 关联关系
+	Batch *CouponCodeBatch `gorm:"foreignKey:BatchID;references:ID" json:"batch,omitempty"`
+}
+
+func (CouponCode) TableName() string {
+	return "coupon_code"
+}
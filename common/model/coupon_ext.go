@@ -0,0 +1,46 @@
+package model
+
+import "encoding/json"
+
+// CouponExtVersion Coupon.ExtFields 规则格式的当前版本，未填写版本的历史数据按版本1处理
+const CouponExtVersion = 1
+
+// CouponExt Coupon.ExtFields 中的优惠券规则，所有字段均可省略，省略表示不限制
+//
+// 示例（工作日9点至18点可用、非节假日、仅限新用户、至少2张票、仅微信支付、可转赠1次）：
+//
+//	{"version":1,"per_user_limit":1,"weekdays":[1,2,3,4,5],"holiday":"EXCLUDE",
+//	 "time_windows":[{"start":"09:00","end":"18:00"}],"new_user_only":true,
+//	 "min_ticket_count":2,"pay_types":["WECHAT"],"transferable":true,"max_transfers":1}
+//
+// 规则的校验与判定见 common/couponrule
+type CouponExt struct {
+	Version        int          `json:"version,omitempty"`          // 规则版本
+	PerUserLimit   int          `json:"per_user_limit,omitempty"`   // 每人限领张数，<=0 使用默认值
+	FlashSale      bool         `json:"flash_sale,omitempty"`       // 秒杀模式：Redis判定领取结果，异步批量落库
+	MaxDiscount    float64      `json:"max_discount,omitempty"`     // 折扣券最高优惠金额，<=0 不封顶
+	NewUserOnly    bool         `json:"new_user_only,omitempty"`    // 仅限从未支付过订单的用户领取和使用
+	Weekdays       []int        `json:"weekdays,omitempty"`         // 可用星期，1-7 表示周一至周日
+	Holiday        string       `json:"holiday,omitempty"`          // 节假日限制：EXCLUDE-节假日不可用，ONLY-仅节假日可用
+	TimeWindows    []TimeWindow `json:"time_windows,omitempty"`     // 每日可用时段，满足任一时段即可
+	MinTicketCount int          `json:"min_ticket_count,omitempty"` // 适用门票的最少购票张数
+	PayTypes       []string     `json:"pay_types,omitempty"`        // 限定支付方式：WECHAT、ALIPAY
+	Transferable   bool         `json:"transferable,omitempty"`     // 未使用的券可转赠给其他用户
+	MaxTransfers   int          `json:"max_transfers,omitempty"`    // 沿转赠链最多转赠次数，<=0 为1次
+}
+
+// TimeWindow 每日可用时段，格式 HH:MM，左闭右开；End 小于 Start 表示跨零点
+type TimeWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// Ext 解析优惠券扩展字段，字段为空时返回零值
+func (c *Coupon) Ext() (CouponExt, error) {
+	var ext CouponExt
+	if c.ExtFields == nil || len(*c.ExtFields) == 0 {
+		return ext, nil
+	}
+	err := json.Unmarshal(*c.ExtFields, &ext)
+	return ext, err
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+// CouponRemindLog 优惠券过期提醒记录表-提醒去重与投递状态，服务重启不重复发送
+type CouponRemindLog struct {
+	ID           uint64     `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:提醒记录主键ID" json:"id"`
+	UserCouponID uint64     `gorm:"column:user_coupon_id;type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:uk_remind,priority:1;comment:用户优惠券ID" json:"user_coupon_id"`
+	RemindType   string     `gorm:"column:remind_type;type:VARCHAR(20);NOT NULL;uniqueIndex:uk_remind,priority:2;comment:提醒类型：BEFORE_24H-过期前24小时，BEFORE_1H-过期前1小时" json:"remind_type"`
+	Channel      string     `gorm:"column:channel;type:VARCHAR(20);NOT NULL;uniqueIndex:uk_remind,priority:3;comment:通知渠道：SMS-短信，IN_APP-站内信，WEBHOOK-回调" json:"channel"`
+	UserID       uint64     `gorm:"column:user_id;type:BIGINT UNSIGNED;NOT NULL;comment:用户ID" json:"user_id"`
+	SendStatus   string     `gorm:"column:send_status;type:VARCHAR(20);NOT NULL;default:'PENDING';index:idx_send_status;comment:投递状态：PENDING-待发送，SENDING-发送中，SENT-已发送，FAILED-失败，CANCELLED-已取消" json:"send_status"`
+	Attempts     uint8      `gorm:"column:attempts;type:TINYINT UNSIGNED;NOT NULL;default:0;comment:发送尝试次数" json:"attempts"`
+	LastError    *string    `gorm:"column:last_error;type:VARCHAR(512);comment:最近一次失败原因" json:"last_error,omitempty"`
+	SentTime     *time.Time `gorm:"column:sent_time;type:DATETIME;comment:发送成功时间" json:"sent_time,omitempty"`
+	CreatedAt    time.Time  `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
+}
+
+func (CouponRemindLog) TableName() string {
+	return "coupon_remind_log"
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+// CouponScope 优惠券适用范围表-按景点、门票类型、城市/省份限定使用范围，优惠券无范围记录时不限范围
+type CouponScope struct {
+	ID           uint64    `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:适用范围主键ID" json:"id"`
+	CouponID     uint64    `gorm:"column:coupon_id;type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:uk_scope,priority:1;comment:优惠券ID" json:"coupon_id"`
+	ScopeType    string    `gorm:"column:scope_type;type:VARCHAR(20);NOT NULL;uniqueIndex:uk_scope,priority:2;comment:范围类型：SPOT-景点，TICKET_TYPE-门票类型，CITY-城市，PROVINCE-省份" json:"scope_type"`
+	SpotID       uint64    `gorm:"column:spot_id;type:BIGINT UNSIGNED;NOT NULL;default:0;uniqueIndex:uk_scope,priority:3;index:idx_spot_id;comment:景点ID，门票类型范围冗余其所属景点" json:"spot_id"`
+	TicketTypeID uint64    `gorm:"column:ticket_type_id;type:BIGINT UNSIGNED;NOT NULL;default:0;uniqueIndex:uk_scope,priority:4;comment:门票类型ID，仅TICKET_TYPE范围有值" json:"ticket_type_id"`
+	Province     string    `gorm:"column:province;type:VARCHAR(30);NOT NULL;default:'';uniqueIndex:uk_scope,priority:5;index:idx_region,priority:1;comment:省份，CITY/PROVINCE范围有值" json:"province"`
+	City         string    `gorm:"column:city;type:VARCHAR(30);NOT NULL;default:'';uniqueIndex:uk_scope,priority:6;index:idx_region,priority:2;comment:城市，仅CITY范围有值" json:"city"`
+	CreatedAt    time.Time `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
+}
+
+func (CouponScope) TableName() string {
+	return "coupon_scope"
+}
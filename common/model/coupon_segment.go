@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CouponSegment 用户分群表-定向发券的目标人群定义，规则格式见 common/segment
+type CouponSegment struct {
+	ID          uint64         `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:分群主键ID" json:"id"`
+	SegmentName string         `gorm:"column:segment_name;type:VARCHAR(100);NOT NULL;comment:分群名称" json:"segment_name"`
+	Rule        JSON           `gorm:"column:rule;type:JSON;NOT NULL;comment:分群规则" json:"rule"`
+	Remark      *string        `gorm:"column:remark;type:VARCHAR(255);comment:备注" json:"remark,omitempty"`
+	CreatedAt   time.Time      `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
+	UpdatedAt   time.Time      `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"column:deleted_at;type:DATETIME;index;comment:软删除时间" json:"deleted_at,omitempty"`
+}
+
+func (CouponSegment) TableName() string {
+	return "coupon_segment"
+}
+
+// CouponDistribution 定向发券任务表-按用户ID游标分页发放，游标与发券在同一事务内推进，服务重启后从游标处继续
+type CouponDistribution struct {
+	ID             uint64     `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:发券任务主键ID" json:"id"`
+	CouponID       uint64     `gorm:"column:coupon_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_coupon_id;comment:优惠券ID" json:"coupon_id"`
+	SegmentID      uint64     `gorm:"column:segment_id;type:BIGINT UNSIGNED;NOT NULL;comment:分群ID" json:"segment_id"`
+	DistStatus     string     `gorm:"column:dist_status;type:VARCHAR(20);NOT NULL;default:'PENDING';index:idx_dist_status;comment:任务状态：PENDING-待执行，RUNNING-执行中，COMPLETED-已完成，STOCK_OUT-库存不足终止，FAILED-失败" json:"dist_status"`
+	EstimatedUsers uint32     `gorm:"column:estimated_users;type:INT UNSIGNED;NOT NULL;default:0;comment:创建任务时预估的目标用户数" json:"estimated_users"`
+	LastUserID     uint64     `gorm:"column:last_user_id;type:BIGINT UNSIGNED;NOT NULL;default:0;comment:已处理到的用户ID游标" json:"last_user_id"`
+	MatchedCount   uint32     `gorm:"column:matched_count;type:INT UNSIGNED;NOT NULL;default:0;comment:已处理的目标用户数" json:"matched_count"`
+	IssuedCount    uint32     `gorm:"column:issued_count;type:INT UNSIGNED;NOT NULL;default:0;comment:已发放张数" json:"issued_count"`
+	SkippedCount   uint32     `gorm:"column:skipped_count;type:INT UNSIGNED;NOT NULL;default:0;comment:因已达每人限领跳过的用户数" json:"skipped_count"`
+	FailCount      uint8      `gorm:"column:fail_count;type:TINYINT UNSIGNED;NOT NULL;default:0;comment:连续失败次数" json:"fail_count"`
+	LastError      *string    `gorm:"column:last_error;type:VARCHAR(512);comment:最近一次失败原因" json:"last_error,omitempty"`
+	Operator       string     `gorm:"column:operator;type:VARCHAR(50);NOT NULL;default:'';comment:操作人" json:"operator"`
+	FinishedAt     *time.Time `gorm:"column:finished_at;type:DATETIME;comment:结束时间" json:"finished_at,omitempty"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
+	UpdatedAt      time.Time  `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
+
+	// 关联关系
+	Segment *CouponSegment `gorm:"foreignKey:SegmentID;references:ID" json:"segment,omitempty"`
+}
+
+func (CouponDistribution) TableName() string {
+	return "coupon_distribution"
+}
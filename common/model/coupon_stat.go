@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// CouponStat 优惠券累计统计表-由统计任务按券全量重算，领用状态为刷新时的快照
+type CouponStat struct {
+	CouponID       uint64    `gorm:"column:coupon_id;type:BIGINT UNSIGNED;primaryKey;autoIncrement:false;comment:优惠券ID" json:"coupon_id"`
+	IssuedCount    uint32    `gorm:"column:issued_count;type:INT UNSIGNED;NOT NULL;default:0;comment:累计发放张数" json:"issued_count"`
+	UnusedCount    uint32    `gorm:"column:unused_count;type:INT UNSIGNED;NOT NULL;default:0;comment:未使用张数" json:"unused_count"`
+	LockedCount    uint32    `gorm:"column:locked_count;type:INT UNSIGNED;NOT NULL;default:0;comment:已锁定张数" json:"locked_count"`
+	UsedCount      uint32    `gorm:"column:used_count;type:INT UNSIGNED;NOT NULL;default:0;comment:已使用张数" json:"used_count"`
+	ExpiredCount   uint32    `gorm:"column:expired_count;type:INT UNSIGNED;NOT NULL;default:0;comment:已过期张数" json:"expired_count"`
+	DiscountAmount float64   `gorm:"column:discount_amount;type:DECIMAL(14,2);NOT NULL;default:0.00;comment:累计优惠金额" json:"discount_amount"`
+	GmvAmount      float64   `gorm:"column:gmv_amount;type:DECIMAL(14,2);NOT NULL;default:0.00;comment:使用该券的订单总金额" json:"gmv_amount"`
+	RefreshedAt    time.Time `gorm:"column:refreshed_at;type:DATETIME;NOT NULL;comment:统计刷新时间，之后有变动的用户优惠券会触发重算" json:"refreshed_at"`
+}
+
+func (CouponStat) TableName() string {
+	return "coupon_stat"
+}
+
+// CouponStatDaily 优惠券日统计表-按天、按景点聚合，SpotID=0 的行记录与景点无关的发放与过期数
+type CouponStatDaily struct {
+	ID             uint64    `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:主键ID" json:"id"`
+	CouponID       uint64    `gorm:"column:coupon_id;type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:uk_coupon_date_spot,priority:1;comment:优惠券ID" json:"coupon_id"`
+	StatDate       time.Time `gorm:"column:stat_date;type:DATE;NOT NULL;uniqueIndex:uk_coupon_date_spot,priority:2;comment:统计日期" json:"stat_date"`
+	SpotID         uint64    `gorm:"column:spot_id;type:BIGINT UNSIGNED;NOT NULL;default:0;uniqueIndex:uk_coupon_date_spot,priority:3;comment:使用订单所属景点ID，0=不区分景点" json:"spot_id"`
+	ClaimedCount   uint32    `gorm:"column:claimed_count;type:INT UNSIGNED;NOT NULL;default:0;comment:当天发放张数（按领取时间）" json:"claimed_count"`
+	UsedCount      uint32    `gorm:"column:used_count;type:INT UNSIGNED;NOT NULL;default:0;comment:当天使用张数（按使用时间），即使用订单数" json:"used_count"`
+	ExpiredCount   uint32    `gorm:"column:expired_count;type:INT UNSIGNED;NOT NULL;default:0;comment:当天过期张数（按有效期结束时间）" json:"expired_count"`
+	DiscountAmount float64   `gorm:"column:discount_amount;type:DECIMAL(14,2);NOT NULL;default:0.00;comment:优惠金额" json:"discount_amount"`
+	GmvAmount      float64   `gorm:"column:gmv_amount;type:DECIMAL(14,2);NOT NULL;default:0.00;comment:使用该券的订单总金额" json:"gmv_amount"`
+}
+
+func (CouponStatDaily) TableName() string {
+	return "coupon_stat_daily"
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// CouponTransfer 优惠券转赠记录表-转赠链接的创建、领取、取消均记录于此，作为转赠审计记录
+type CouponTransfer struct {
+	ID              uint64     `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:转赠记录主键ID" json:"id"`
+	Token           string     `gorm:"column:token;type:VARCHAR(64);NOT NULL;uniqueIndex:uk_token;comment:转赠凭证，分享链接携带" json:"token"`
+	UserCouponID    uint64     `gorm:"column:user_coupon_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_user_coupon_id;comment:被转赠的用户优惠券ID" json:"user_coupon_id"`
+	CouponID        uint64     `gorm:"column:coupon_id;type:BIGINT UNSIGNED;NOT NULL;comment:优惠券ID（冗余）" json:"coupon_id"`
+	FromUserID      uint64     `gorm:"column:from_user_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_from_user_id;comment:转赠人用户ID" json:"from_user_id"`
+	ToUserID        uint64     `gorm:"column:to_user_id;type:BIGINT UNSIGNED;NOT NULL;default:0;index:idx_to_user_id;comment:受赠人用户ID，0=未领取" json:"to_user_id"`
+	NewUserCouponID uint64     `gorm:"column:new_user_coupon_id;type:BIGINT UNSIGNED;NOT NULL;default:0;comment:受赠人获得的用户优惠券ID" json:"new_user_coupon_id"`
+	TransferStatus  string     `gorm:"column:transfer_status;type:VARCHAR(20);NOT NULL;default:'PENDING';comment:状态：PENDING-待领取，CLAIMED-已领取，CANCELLED-已取消，EXPIRED-已过期" json:"transfer_status"`
+	ExpireAt        time.Time  `gorm:"column:expire_at;type:DATETIME;NOT NULL;comment:转赠链接过期时间" json:"expire_at"`
+	ClaimedAt       *time.Time `gorm:"column:claimed_at;type:DATETIME;comment:领取时间" json:"claimed_at,omitempty"`
+	CancelledAt     *time.Time `gorm:"column:cancelled_at;type:DATETIME;comment:取消时间" json:"cancelled_at,omitempty"`
+	CreatedAt       time.Time  `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
+}
+
+func (CouponTransfer) TableName() string {
+	return "coupon_transfer"
+}
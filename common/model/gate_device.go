@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GateDevice 检票闸机表-景点入口的核销设备，归属景点所属商家
+type GateDevice struct {
+	ID           uint64         `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:设备主键ID" json:"id"`
+	DeviceNo     string         `gorm:"column:device_no;type:VARCHAR(40);NOT NULL;uniqueIndex:uk_device_no;comment:设备编号，唯一" json:"device_no"`
+	DeviceName   string         `gorm:"column:device_name;type:VARCHAR(100);NOT NULL;comment:设备名称（如东门1号闸机）" json:"device_name"`
+	MerchantID   uint64         `gorm:"column:merchant_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_merchant_id;comment:所属商家ID" json:"merchant_id"`
+	SpotID       uint64         `gorm:"column:spot_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_spot_id;comment:所在景点ID" json:"spot_id"`
+	SecretSalt   string         `gorm:"column:secret_salt;type:VARCHAR(32);NOT NULL;default:'';comment:设备密钥派生盐值，重置后旧密钥失效，为空表示未下发密钥" json:"-"`
+	DeviceStatus string         `gorm:"column:device_status;type:VARCHAR(20);NOT NULL;default:'ENABLED';comment:设备状态：ENABLED-启用，DISABLED-停用" json:"device_status"`
+	LastActiveAt *time.Time     `gorm:"column:last_active_at;type:DATETIME;comment:最近一次核销时间" json:"last_active_at,omitempty"`
+	CreatedAt    time.Time      `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
+	UpdatedAt    time.Time      `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"column:deleted_at;type:DATETIME;index;comment:软删除时间" json:"deleted_at,omitempty"`
+
+	// 关联关系
+	Merchant *SysMerchant `gorm:"foreignKey:MerchantID;references:ID" json:"merchant,omitempty"`
+	Spot     *SpotInfo    `gorm:"foreignKey:SpotID;references:ID" json:"spot,omitempty"`
+}
+
+func (GateDevice) TableName() string {
+	return "gate_device"
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// OfflineVerifyRecord 离线核销记录表-闸机离线期间的核销，恢复网络后批量上传并对账
+type OfflineVerifyRecord struct {
+	ID          uint64    `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:记录主键ID" json:"id"`
+	DeviceNo    string    `gorm:"column:device_no;type:VARCHAR(40);NOT NULL;uniqueIndex:uk_device_code_time,priority:1;comment:上传闸机编号" json:"device_no"`
+	SpotID      uint64    `gorm:"column:spot_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_spot_verified,priority:1;comment:闸机所在景点ID" json:"spot_id"`
+	VerifyCode  string    `gorm:"column:verify_code;type:VARCHAR(64);NOT NULL;uniqueIndex:uk_device_code_time,priority:2;comment:核销码" json:"verify_code"`
+	VerifiedAt  time.Time `gorm:"column:verified_at;type:DATETIME;NOT NULL;uniqueIndex:uk_device_code_time,priority:3;index:idx_spot_verified,priority:2;comment:闸机离线核销时间" json:"verified_at"`
+	OrderID     uint64    `gorm:"column:order_id;type:BIGINT UNSIGNED;NOT NULL;default:0;index:idx_order_id;comment:核销码所属订单ID，未识别为0" json:"order_id"`
+	OrderItemID uint64    `gorm:"column:order_item_id;type:BIGINT UNSIGNED;NOT NULL;default:0;comment:出行人核销码对应的明细ID，订单核销码为0" json:"order_item_id"`
+	Result      string    `gorm:"column:result;type:VARCHAR(20);NOT NULL;index:idx_result;comment:对账结果：APPLIED-已补录，DUPLICATE-重复上传，CONFLICT-冲突，REJECTED-无效" json:"result"`
+	Reason      string    `gorm:"column:reason;type:VARCHAR(255);NOT NULL;default:'';comment:异常原因" json:"reason"`
+	CreatedAt   time.Time `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:上传时间" json:"created_at"`
+}
+
+func (OfflineVerifyRecord) TableName() string {
+	return "offline_verify_record"
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+)
+
+// OrderCoupon 订单优惠券关联表-记录订单叠加使用的每张优惠券及其优惠贡献
+type OrderCoupon struct {
+	ID             uint64    `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:关联主键ID" json:"id"`
+	OrderID        uint64    `gorm:"column:order_id;type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:uk_order_user_coupon,priority:1;comment:订单ID" json:"order_id"`
+	UserCouponID   uint64    `gorm:"column:user_coupon_id;type:BIGINT UNSIGNED;NOT NULL;uniqueIndex:uk_order_user_coupon,priority:2;index:idx_user_coupon_id;comment:用户优惠券ID" json:"user_coupon_id"`
+	CouponID       uint64    `gorm:"column:coupon_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_coupon_id;comment:优惠券ID" json:"coupon_id"`
+	IssuerType     string    `gorm:"column:issuer_type;type:VARCHAR(20);NOT NULL;comment:发券方：PLATFORM-平台，MERCHANT-商家" json:"issuer_type"`
+	MerchantID     uint64    `gorm:"column:merchant_id;type:BIGINT UNSIGNED;NOT NULL;default:0;comment:发券商家ID，平台券为0" json:"merchant_id"`
+	ApplySeq       uint8     `gorm:"column:apply_seq;type:TINYINT UNSIGNED;NOT NULL;comment:抵扣顺序，从1开始" json:"apply_seq"`
+	DiscountAmount float64   `gorm:"column:discount_amount;type:DECIMAL(10,2);NOT NULL;comment:该券优惠金额" json:"discount_amount"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
+
+	// 关联关系
+	UserCoupon *UserCoupon `gorm:"foreignKey:UserCouponID;references:ID" json:"user_coupon,omitempty"`
+}
+
+func (OrderCoupon) TableName() string {
+	return "order_coupon"
+}
@@ -15,6 +15,13 @@ type OrderItem struct {
 	TicketName   string         `gorm:"column:ticket_name;type:VARCHAR(100);NOT NULL;comment:门票名称（冗余存储，防止门票名称修改）" json:"ticket_name"`
 	SinglePrice  float64        `gorm:"column:single_price;type:DECIMAL(10,2);NOT NULL;comment:单张门票价格" json:"single_price"`
 	TicketNum    uint8          `gorm:"column:ticket_num;type:TINYINT UNSIGNED;NOT NULL;default:1;comment:购票数量" json:"ticket_num"`
+	PayAmount    float64        `gorm:"column:pay_amount;type:DECIMAL(10,2);NOT NULL;default:0.00;comment:实付小计（已分摊优惠），部分退款按此退还" json:"pay_amount"`
+	ItemStatus   string         `gorm:"column:item_status;type:VARCHAR(20);NOT NULL;default:'UNUSED';comment:明细状态：UNUSED-待核销，VERIFIED-已核销，REFUNDED-已退款" json:"item_status"`
+	VerifyCode   *string        `gorm:"column:verify_code;type:VARCHAR(64);uniqueIndex:uk_verify_code;comment:出行人核销码，唯一，支付后生成" json:"verify_code,omitempty"`
+	VerifyTime   *time.Time     `gorm:"column:verify_time;type:DATETIME;comment:核销时间" json:"verify_time,omitempty"`
+	VerifyDevice string         `gorm:"column:verify_device;type:VARCHAR(40);NOT NULL;default:'';comment:核销闸机编号" json:"verify_device"`
+	RefundAmount float64        `gorm:"column:refund_amount;type:DECIMAL(10,2);NOT NULL;default:0.00;comment:退款金额" json:"refund_amount"`
+	RefundTime   *time.Time     `gorm:"column:refund_time;type:DATETIME;comment:退款时间" json:"refund_time,omitempty"`
 	ExtFields    *JSON          `gorm:"column:ext_fields;type:JSON;comment:扩展字段，如门票有效期、入园须知等" json:"ext_fields,omitempty"`
 	CreatedAt    time.Time      `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
 	UpdatedAt    time.Time      `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
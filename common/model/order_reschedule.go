@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// OrderReschedule 订单改期记录表-每次改期一条，记录游玩日期与门票的变更及差价处理
+// 需补差价的改期先记为待补差价，支付回调时按记录的变更重新校验后执行
+type OrderReschedule struct {
+	ID            uint64     `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:改期记录主键ID" json:"id"`
+	OrderID       uint64     `gorm:"column:order_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_order_id;comment:关联订单ID" json:"order_id"`
+	FromVisitDate *time.Time `gorm:"column:from_visit_date;type:DATE;comment:原游玩日期" json:"from_visit_date,omitempty"`
+	ToVisitDate   *time.Time `gorm:"column:to_visit_date;type:DATE;comment:新游玩日期" json:"to_visit_date,omitempty"`
+	TicketChanges *JSON      `gorm:"column:ticket_changes;type:JSON;comment:门票变更，如 [{\"from\":1,\"to\":2}]" json:"ticket_changes,omitempty"`
+	PriceDiff     float64    `gorm:"column:price_diff;type:DECIMAL(10,2);NOT NULL;default:0.00;comment:实付差价，正数为补款，负数为退款" json:"price_diff"`
+	PayRecordID   uint64     `gorm:"column:pay_record_id;type:BIGINT UNSIGNED;NOT NULL;default:0;comment:补款或退款的支付记录ID，无差价为0" json:"pay_record_id"`
+	Status        string     `gorm:"column:status;type:VARCHAR(20);NOT NULL;default:'DONE';comment:状态：PENDING_PAY待补差价/DONE已改期/CLOSED已失效" json:"status"`
+	Operator      string     `gorm:"column:operator;type:VARCHAR(50);NOT NULL;default:'';comment:操作方，格式同订单状态流转记录" json:"operator"`
+	CreatedAt     time.Time  `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:改期时间" json:"created_at"`
+}
+
+func (OrderReschedule) TableName() string {
+	return "order_reschedule"
+}
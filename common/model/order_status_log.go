@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// OrderStatusLog 订单状态流转记录表-每次状态变更写入一条，创建订单时 FromStatus 为空
+type OrderStatusLog struct {
+	ID         uint64    `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:主键ID" json:"id"`
+	OrderID    uint64    `gorm:"column:order_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_order_id;comment:订单ID" json:"order_id"`
+	FromStatus string    `gorm:"column:from_status;type:VARCHAR(30);NOT NULL;default:'';comment:变更前状态" json:"from_status"`
+	ToStatus   string    `gorm:"column:to_status;type:VARCHAR(30);NOT NULL;comment:变更后状态" json:"to_status"`
+	Operator   string    `gorm:"column:operator;type:VARCHAR(50);NOT NULL;default:'';comment:操作方：USER:用户ID、MERCHANT:商家ID、GATE:闸机编号、SYSTEM" json:"operator"`
+	Reason     string    `gorm:"column:reason;type:VARCHAR(255);NOT NULL;default:'';comment:变更原因" json:"reason"`
+	CreatedAt  time.Time `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:变更时间" json:"created_at"`
+}
+
+func (OrderStatusLog) TableName() string {
+	return "order_status_log"
+}
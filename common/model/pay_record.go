@@ -15,6 +15,7 @@ type PayRecord struct {
 	PayAmount        float64        `gorm:"column:pay_amount;type:DECIMAL(10,2);NOT NULL;comment:支付金额" json:"pay_amount"`
 	PayStatus        string         `gorm:"column:pay_status;type:VARCHAR(20);NOT NULL;index:idx_pay_status;comment:支付状态：SUCCESS-成功，FAIL-失败，REFUND-退款，REFUNDING-退款中" json:"pay_status"`
 	PlatformTradeNo  *string        `gorm:"column:platform_trade_no;type:VARCHAR(64);index:idx_platform_trade_no;comment:支付平台流水号（微信/支付宝返回）" json:"platform_trade_no,omitempty"`
+	RefundNo         *string        `gorm:"column:refund_no;type:VARCHAR(32);uniqueIndex:uk_refund_no;comment:退款单号，退款记录必填，见 common/idgen" json:"refund_no,omitempty"`
 	PlatformRefundNo *string        `gorm:"column:platform_refund_no;type:VARCHAR(64);comment:支付平台退款单号" json:"platform_refund_no,omitempty"`
 	NotifyTime       *time.Time     `gorm:"column:notify_time;type:DATETIME;comment:支付平台回调时间" json:"notify_time,omitempty"`
 	ExtFields        *JSON          `gorm:"column:ext_fields;type:JSON;comment:扩展字段，如支付签名、回调参数等" json:"ext_fields,omitempty"`
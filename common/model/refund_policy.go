@@ -0,0 +1,38 @@
+package model
+
+import "encoding/json"
+
+// RefundPolicyVersion TicketType.RefundPolicy 格式的当前版本
+const RefundPolicyVersion = 1
+
+// RefundPolicy TicketType.RefundPolicy 中的结构化退改规则，与展示用的 RefundRule 文本并存
+//
+// 示例（游玩前24小时免费退，游玩前2小时至24小时收取20%手续费，之后不可退，可改期1次）：
+//
+//	{"version":1,"tiers":[{"hours_before":24,"fee_percent":0},{"hours_before":2,"fee_percent":20}],
+//	 "reschedule_limit":1}
+//
+// 未配置任何档位表示不可退款，规则的校验与计算见 common/refundrule
+type RefundPolicy struct {
+	Version         int          `json:"version,omitempty"`          // 规则版本
+	Tiers           []RefundTier `json:"tiers,omitempty"`            // 退款档位，距游玩开始时间越早手续费越低
+	RescheduleLimit int          `json:"reschedule_limit,omitempty"` // 可改期次数，0 表示不可改期
+}
+
+// RefundTier 退款档位：申请时距游玩开始不少于 HoursBefore 小时，按 FeePercent 收取手续费
+type RefundTier struct {
+	HoursBefore int `json:"hours_before"`
+	FeePercent  int `json:"fee_percent"`
+}
+
+// Policy 解析门票结构化退改规则，未配置时返回nil
+func (t *TicketType) Policy() (*RefundPolicy, error) {
+	if t.RefundPolicy == nil || len(*t.RefundPolicy) == 0 {
+		return nil, nil
+	}
+	var p RefundPolicy
+	if err := json.Unmarshal(*t.RefundPolicy, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
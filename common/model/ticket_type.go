@@ -20,6 +20,7 @@ type TicketType struct {
 	ValidEndTime   sql.NullTime   `gorm:"column:valid_end_time;type:DATE;NOT NULL;comment:门票有效期结束时间" json:"valid_end_time"`
 	TicketStatus   string         `gorm:"column:ticket_status;type:VARCHAR(20);NOT NULL;default:'ON_SALE';index:idx_ticket_status;comment:门票状态：ON_SALE-在售，OFF_SALE-下架，STOCK_OUT-售罄" json:"ticket_status"`
 	RefundRule     string         `gorm:"column:refund_rule;type:TEXT;NOT NULL;comment:退改规则（如：游玩前24小时可退，逾期不退，改期限1次）" json:"refund_rule"`
+	RefundPolicy   *JSON          `gorm:"column:refund_policy;type:JSON;comment:结构化退改规则，见 model.RefundPolicy，未配置时按 RefundRule 文本解析" json:"refund_policy,omitempty"`
 	UseRule        string         `gorm:"column:use_rule;type:TEXT;NOT NULL;comment:使用规则（如：实名制入园、有效期内通用）" json:"use_rule"`
 	ExtFields      *JSON          `gorm:"column:ext_fields;type:JSON;comment:扩展字段，如适用人群、免票政策等" json:"ext_fields,omitempty"`
 	CreatedAt      time.Time      `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
@@ -10,17 +10,20 @@ import (
 type UserCoupon struct {
 	ID             uint64         `gorm:"column:id;type:BIGINT UNSIGNED;primaryKey;autoIncrement;comment:用户优惠券主键ID" json:"id"`
 	UserID         uint64         `gorm:"column:user_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_user_id;comment:用户ID" json:"user_id"`
-	CouponID       uint64         `gorm:"column:coupon_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_coupon_id;comment:优惠券ID" json:"coupon_id"`
+	CouponID       uint64         `gorm:"column:coupon_id;type:BIGINT UNSIGNED;NOT NULL;index:idx_coupon_id;index:idx_coupon_updated,priority:1;comment:优惠券ID" json:"coupon_id"`
 	CouponName     string         `gorm:"column:coupon_name;type:VARCHAR(100);NOT NULL;comment:优惠券名称（冗余）" json:"coupon_name"`
 	Denomination   float64        `gorm:"column:denomination;type:DECIMAL(10,2);NOT NULL;comment:优惠券面额" json:"denomination"`
 	MinUseAmount   float64        `gorm:"column:min_use_amount;type:DECIMAL(10,2);NOT NULL;comment:最低使用金额" json:"min_use_amount"`
 	ValidStartTime time.Time      `gorm:"column:valid_start_time;type:DATETIME;NOT NULL;comment:有效期开始时间" json:"valid_start_time"`
 	ValidEndTime   time.Time      `gorm:"column:valid_end_time;type:DATETIME;NOT NULL;comment:有效期结束时间" json:"valid_end_time"`
-	UseStatus      string         `gorm:"column:use_status;type:VARCHAR(20);NOT NULL;default:'UNUSED';index:idx_use_status;comment:使用状态：UNUSED-未使用，USED-已使用，EXPIRED-已过期" json:"use_status"`
-	OrderID        uint64         `gorm:"column:order_id;type:BIGINT UNSIGNED;default:0;comment:使用的订单ID，0=未使用" json:"order_id"`
+	UseStatus      string         `gorm:"column:use_status;type:VARCHAR(20);NOT NULL;default:'UNUSED';index:idx_use_status;comment:使用状态：UNUSED-未使用，LOCKED-已锁定，USED-已使用，EXPIRED-已过期，TRANSFERRED-已转赠" json:"use_status"`
+	OrderID        uint64         `gorm:"column:order_id;type:BIGINT UNSIGNED;default:0;index:idx_order_id;comment:使用的订单ID，0=未使用" json:"order_id"`
 	UseTime        *time.Time     `gorm:"column:use_time;type:DATETIME;comment:使用时间" json:"use_time,omitempty"`
+	TransferCount  uint8          `gorm:"column:transfer_count;type:TINYINT UNSIGNED;NOT NULL;default:0;comment:已被转赠次数，沿转赠链累加" json:"transfer_count"`
+	SourceID       uint64         `gorm:"column:source_id;type:BIGINT UNSIGNED;NOT NULL;default:0;comment:转赠来源用户优惠券ID，0=直接发放" json:"source_id"`
+	ClaimToken     *string        `gorm:"column:claim_token;type:VARCHAR(64);uniqueIndex:uk_claim_token;comment:秒杀领取凭证，异步落库幂等键" json:"claim_token,omitempty"`
 	CreatedAt      time.Time      `gorm:"column:created_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`
-	UpdatedAt      time.Time      `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`
+	UpdatedAt      time.Time      `gorm:"column:updated_at;type:DATETIME;NOT NULL;default:CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;index:idx_coupon_updated,priority:2;comment:更新时间" json:"updated_at"`
 	DeletedAt      gorm.DeletedAt `gorm:"column:deleted_at;type:DATETIME;index;comment:软删除时间" json:"deleted_at,omitempty"`
 
 	// 关联关系
@@ -0,0 +1,48 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	inAppKeyFormat = "notify:inapp:%d" // 站内信列表，参数：用户ID
+	inAppMaxSize   = 100               // 每个用户保留的站内信条数
+)
+
+// inAppMessage 站内信存储结构
+type inAppMessage struct {
+	Message
+	CreatedAt int64 `json:"created_at"`
+}
+
+// InAppNotifier 站内信通知，写入用户的 Redis 消息列表
+type InAppNotifier struct {
+	rdb *redis.Client
+}
+
+// NewInAppNotifier 创建站内信通知
+func NewInAppNotifier(rdb *redis.Client) *InAppNotifier {
+	return &InAppNotifier{rdb: rdb}
+}
+
+func (n *InAppNotifier) Channel() string {
+	return ChannelInApp
+}
+
+func (n *InAppNotifier) Send(ctx context.Context, msg Message) error {
+	data, err := json.Marshal(inAppMessage{Message: msg, CreatedAt: time.Now().Unix()})
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf(inAppKeyFormat, msg.UserID)
+	pipe := n.rdb.TxPipeline()
+	pipe.LPush(ctx, key, data)
+	pipe.LTrim(ctx, key, 0, inAppMaxSize-1)
+	_, err = pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,29 @@
+// Package notify 用户通知渠道，短信、站内信、Webhook 均实现 Notifier 接口
+package notify
+
+import "context"
+
+// 通知渠道
+const (
+	ChannelSMS     = "SMS"     // 短信
+	ChannelInApp   = "IN_APP"  // 站内信
+	ChannelWebhook = "WEBHOOK" // Webhook 回调
+)
+
+// Message 通知内容
+type Message struct {
+	UserID  uint64 `json:"user_id"`
+	Phone   string `json:"-"` // 短信渠道使用，不对外输出
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	BizType string `json:"biz_type"` // 业务类型，如 COUPON_EXPIRE_REMIND
+	BizID   uint64 `json:"biz_id"`   // 业务ID，如用户优惠券ID
+}
+
+// Notifier 通知发送器
+type Notifier interface {
+	// Channel 渠道标识
+	Channel() string
+	// Send 发送通知，返回错误时由调用方决定是否重试
+	Send(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,28 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/encrypt"
+	"log"
+)
+
+// SMSNotifier 短信通知，当前为本地桩实现，仅打印日志
+type SMSNotifier struct{}
+
+// NewSMSNotifier 创建短信通知
+func NewSMSNotifier() *SMSNotifier {
+	return &SMSNotifier{}
+}
+
+func (n *SMSNotifier) Channel() string {
+	return ChannelSMS
+}
+
+func (n *SMSNotifier) Send(ctx context.Context, msg Message) error {
+	if msg.Phone == "" {
+		return errors.New("手机号为空")
+	}
+	log.Printf("[SMS] to=%s content=%s", encrypt.MaskPhone(msg.Phone), msg.Content)
+	return nil
+}
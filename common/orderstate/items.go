@@ -0,0 +1,45 @@
+package orderstate
+
+import (
+	"example_shop/common/constant"
+	"example_shop/common/model"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SettleItems 明细核销或退款后汇总订单状态，需在锁定订单的事务中调用：
+// 仍有待核销明细时订单保持已支付；否则有已核销明细则 PAID -> VERIFIED，全部退款则经退款中变为已退款
+// 返回剩余待核销明细数
+func SettleItems(tx *gorm.DB, orderID uint64, operator string, now time.Time) (int64, error) {
+	var rows []struct {
+		ItemStatus string
+		Cnt        int64
+	}
+	err := tx.Model(&model.OrderItem{}).Select("item_status, COUNT(*) AS cnt").
+		Where("order_id = ?", orderID).Group("item_status").Scan(&rows).Error
+	if err != nil {
+		return 0, err
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, r := range rows {
+		counts[r.ItemStatus] = r.Cnt
+	}
+	if n := counts[constant.ItemStatusUnused]; n > 0 {
+		return n, nil
+	}
+	if counts[constant.ItemStatusVerified] > 0 {
+		return 0, Apply(tx, &Transition{
+			OrderID: orderID, From: Paid, To: Verified, Operator: operator, Reason: "全部出行人已核销",
+		}, now)
+	}
+	err = Apply(tx, &Transition{
+		OrderID: orderID, From: Paid, To: Refunding, Operator: operator, Reason: "全部出行人已申请退款",
+	}, now)
+	if err != nil {
+		return 0, err
+	}
+	return 0, Apply(tx, &Transition{
+		OrderID: orderID, From: Refunding, To: Refunded, Operator: operator, Reason: "全部出行人已退款",
+	}, now)
+}
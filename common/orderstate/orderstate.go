@@ -0,0 +1,164 @@
+// Package orderstate 订单状态机：定义 OrderMain.OrderStatus 的状态与合法流转，
+// 所有状态变更均通过 Apply 以前置状态为条件更新，并由钩子写入时间戳与流转记录
+package orderstate
+
+import (
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/model"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Status 订单状态
+type Status string
+
+const (
+	Draft      Status = constant.OrderStatusDraft
+	PendingPay Status = constant.OrderStatusPendingPay
+	Paid       Status = constant.OrderStatusPaid
+	Verified   Status = constant.OrderStatusVerified
+	Cancelled  Status = constant.OrderStatusCancelled
+	Refunding  Status = constant.OrderStatusRefunding
+	Refunded   Status = constant.OrderStatusRefunded
+)
+
+// OperatorSystem 系统任务发起的变更
+const OperatorSystem = "SYSTEM"
+
+var (
+	ErrIllegalTransition = errors.New("订单状态不允许该操作")
+	ErrStatusChanged     = errors.New("订单状态已变化，请刷新后重试")
+)
+
+// transitions 合法流转：from -> 允许的 to
+var transitions = map[Status][]Status{
+	Draft:      {PendingPay, Cancelled},
+	PendingPay: {Paid, Cancelled},
+	Paid:       {Verified, Refunding},
+	Refunding:  {Refunded, Paid}, // 退款被拒绝或失败时回到已支付
+}
+
+// stampColumns 进入状态时写入的时间字段
+var stampColumns = map[Status]string{
+	Paid:      "pay_time",
+	Cancelled: "cancel_time",
+	Verified:  "verify_time",
+	Refunded:  "refund_time",
+}
+
+// Transition 一次状态变更
+type Transition struct {
+	OrderID  uint64
+	From     Status
+	To       Status
+	Operator string                 // 见 model.OrderStatusLog.Operator
+	Reason   string                 // 变更原因，写入流转记录
+	Fields   map[string]interface{} // 随状态一并更新的其他列
+}
+
+// Hook 状态变更成功后在同一事务内执行的副作用，返回错误时整个事务回滚
+type Hook func(tx *gorm.DB, t *Transition, now time.Time) error
+
+// hooks 按目标状态注册的钩子
+var hooks = map[Status][]Hook{}
+
+// OnEnter 注册进入某状态时执行的钩子，需在服务启动时注册
+func OnEnter(to Status, hook Hook) {
+	hooks[to] = append(hooks[to], hook)
+}
+
+// Valid 判断状态取值是否合法
+func Valid(s Status) bool {
+	switch s {
+	case Draft, PendingPay, Paid, Verified, Cancelled, Refunding, Refunded:
+		return true
+	}
+	return false
+}
+
+// CanTransit 判断 from -> to 是否为合法流转
+func CanTransit(from, to Status) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Terminal 判断是否为终态
+func Terminal(s Status) bool {
+	return Valid(s) && len(transitions[s]) == 0
+}
+
+// Apply 执行状态变更，需在事务中调用：
+// 以 order_status = From 为条件更新状态、进入状态的时间戳与附加列，写入流转记录后执行已注册的钩子
+// 流转不合法返回 ErrIllegalTransition，订单当前状态不是 From 返回 ErrStatusChanged
+func Apply(tx *gorm.DB, t *Transition, now time.Time) error {
+	if !CanTransit(t.From, t.To) {
+		return fmt.Errorf("%w: %s -> %s", ErrIllegalTransition, t.From, t.To)
+	}
+	updates := make(map[string]interface{}, len(t.Fields)+2)
+	for k, v := range t.Fields {
+		updates[k] = v
+	}
+	updates["order_status"] = string(t.To)
+	if col, ok := stampColumns[t.To]; ok {
+		updates[col] = now
+	}
+	result := tx.Model(&model.OrderMain{}).
+		Where("id = ? AND order_status = ?", t.OrderID, string(t.From)).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrStatusChanged
+	}
+	if err := writeLog(tx, t.OrderID, t.From, t.To, t.Operator, t.Reason, now); err != nil {
+		return err
+	}
+	for _, hook := range hooks[t.To] {
+		if err := hook(tx, t, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Created 记录订单以初始状态创建，需与创建订单在同一事务中调用
+func Created(tx *gorm.DB, orderID uint64, initial Status, operator string, now time.Time) error {
+	if initial != Draft && initial != PendingPay {
+		return fmt.Errorf("%w: 订单不能以%s状态创建", ErrIllegalTransition, initial)
+	}
+	return writeLog(tx, orderID, "", initial, operator, "创建订单", now)
+}
+
+// UserOperator 用户发起的变更
+func UserOperator(userID uint64) string {
+	return fmt.Sprintf("USER:%d", userID)
+}
+
+// MerchantOperator 商家发起的变更
+func MerchantOperator(merchantID uint64) string {
+	return fmt.Sprintf("MERCHANT:%d", merchantID)
+}
+
+// GateOperator 检票闸机发起的变更
+func GateOperator(deviceNo string) string {
+	return "GATE:" + deviceNo
+}
+
+func writeLog(tx *gorm.DB, orderID uint64, from, to Status, operator, reason string, now time.Time) error {
+	return tx.Create(&model.OrderStatusLog{
+		OrderID:    orderID,
+		FromStatus: string(from),
+		ToStatus:   string(to),
+		Operator:   operator,
+		Reason:     reason,
+		CreatedAt:  now,
+	}).Error
+}
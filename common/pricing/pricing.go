@@ -0,0 +1,304 @@
+// Package pricing 优惠券抵扣计算，订单实付金额统一由此计算
+package pricing
+
+import (
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/couponrule"
+	"example_shop/common/model"
+	"fmt"
+	"math"
+	"time"
+)
+
+// 优惠券不可用原因
+var (
+	ErrCouponMissing     = errors.New("优惠券信息缺失")
+	ErrCouponUnavailable = errors.New("优惠券不可用")
+	ErrCouponNotStarted  = errors.New("优惠券未到使用时间")
+	ErrCouponExpired     = errors.New("优惠券已过期")
+	ErrSpotNotApplicable = errors.New("优惠券不适用于所选景点或门票")
+	ErrBelowMinAmount    = errors.New("未达到优惠券最低使用金额")
+	ErrUnknownCouponType = errors.New("未知的优惠券类型")
+)
+
+// Allocation 某张优惠券分摊到明细上的优惠金额
+type Allocation struct {
+	UserCouponID uint64
+	Discount     float64
+}
+
+// ItemPrice 单条订单明细的价格拆分
+type ItemPrice struct {
+	Index        int    // 在 OrderMain.OrderItems 中的下标
+	OrderItemID  uint64 // 草稿订单为0
+	TicketTypeID uint64
+	TicketName   string
+	SinglePrice  float64
+	TicketNum    uint8
+	Amount       float64      // 原价小计 = SinglePrice * TicketNum
+	Discount     float64      // 分摊的优惠金额合计
+	PayAmount    float64      // 实付小计
+	Eligible     bool         // 是否参与了任一优惠券抵扣
+	Allocations  []Allocation // 各优惠券在该明细上的分摊
+}
+
+// CouponDiscount 单张优惠券对订单的优惠贡献
+type CouponDiscount struct {
+	UserCouponID uint64
+	CouponID     uint64
+	CouponName   string
+	IssuerType   string
+	MerchantID   uint64
+	Discount     float64
+}
+
+// Result 订单价格计算结果
+type Result struct {
+	TotalAmount    float64          // 订单总金额
+	EligibleAmount float64          // 参与抵扣的金额
+	Discount       float64          // 优惠金额合计
+	PayAmount      float64          // 实付金额
+	Items          []ItemPrice      // 明细拆分，顺序与 OrderItems 一致
+	Coupons        []CouponDiscount // 各优惠券贡献，按抵扣顺序排列
+}
+
+// Env 计价时判定优惠券使用规则所需的环境
+type Env struct {
+	Now     time.Time
+	NewUser bool // 下单用户是否从未支付过订单，见 couponrule.IsNewUser
+	Strict  bool // 下单锁券时为true，未选择支付方式视为不满足支付方式限制
+}
+
+// CalculateDiscount 计算单张用户优惠券对订单的抵扣，userCoupon 为nil时按原价计算
+func CalculateDiscount(order *model.OrderMain, userCoupon *model.UserCoupon, env Env) (*Result, error) {
+	if userCoupon == nil {
+		return Calculate(order, nil, env)
+	}
+	return Calculate(order, []*model.UserCoupon{userCoupon}, env)
+}
+
+// Calculate 校验叠加规则后按抵扣顺序依次计算多张优惠券的抵扣：
+// 每张券的门槛与折扣均基于前序优惠券抵扣后的剩余金额，优惠金额按剩余金额比例分摊到明细
+// userCoupon.Coupon 及其 Scopes 需预加载，用于读取券类型、适用范围与使用规则；
+// 按城市/省份限定范围的券需预加载 order.Spot
+func Calculate(order *model.OrderMain, userCoupons []*model.UserCoupon, env Env) (*Result, error) {
+	res := &Result{Items: make([]ItemPrice, len(order.OrderItems))}
+	remaining := make([]int64, len(order.OrderItems))
+	var totalCents int64
+	for i, item := range order.OrderItems {
+		cents := ToCents(item.SinglePrice) * int64(item.TicketNum)
+		remaining[i] = cents
+		totalCents += cents
+		res.Items[i] = ItemPrice{
+			Index:        i,
+			OrderItemID:  item.ID,
+			TicketTypeID: item.TicketTypeID,
+			TicketName:   item.TicketName,
+			SinglePrice:  item.SinglePrice,
+			TicketNum:    item.TicketNum,
+			Amount:       FromCents(cents),
+			PayAmount:    FromCents(cents),
+		}
+	}
+	res.TotalAmount = FromCents(totalCents)
+	res.PayAmount = res.TotalAmount
+	if len(userCoupons) == 0 {
+		return res, nil
+	}
+
+	if err := ValidateStacking(order, userCoupons); err != nil {
+		return nil, err
+	}
+	ordered := SortForApply(userCoupons)
+	eligibleAny := make([]bool, len(remaining))
+	var discountTotal int64
+	for _, uc := range ordered {
+		cents, err := applyCoupon(order, uc, remaining, eligibleAny, res.Items, env)
+		if err != nil {
+			if len(ordered) > 1 {
+				return nil, fmt.Errorf("%s: %w", uc.CouponName, err)
+			}
+			return nil, err
+		}
+		discountTotal += cents
+		res.Coupons = append(res.Coupons, CouponDiscount{
+			UserCouponID: uc.ID,
+			CouponID:     uc.CouponID,
+			CouponName:   uc.CouponName,
+			IssuerType:   issuerType(uc.Coupon),
+			MerchantID:   uc.Coupon.MerchantID,
+			Discount:     FromCents(cents),
+		})
+	}
+
+	var eligibleCents int64
+	for i := range res.Items {
+		amount := ToCents(res.Items[i].Amount)
+		if eligibleAny[i] {
+			res.Items[i].Eligible = true
+			eligibleCents += amount
+		}
+		res.Items[i].PayAmount = FromCents(remaining[i])
+		res.Items[i].Discount = FromCents(amount - remaining[i])
+	}
+	res.EligibleAmount = FromCents(eligibleCents)
+	res.Discount = FromCents(discountTotal)
+	res.PayAmount = FromCents(totalCents - discountTotal)
+	return res, nil
+}
+
+// applyCoupon 计算单张优惠券在当前剩余金额上的抵扣并扣减 remaining，返回优惠金额（分）
+func applyCoupon(order *model.OrderMain, uc *model.UserCoupon, remaining []int64, eligibleAny []bool, items []ItemPrice, env Env) (int64, error) {
+	if err := CheckUsable(order, uc, env); err != nil {
+		return 0, err
+	}
+	eligible := make([]bool, len(remaining))
+	var eligibleCents int64
+	var tickets int
+	for i, item := range order.OrderItems {
+		if itemApplicable(uc.Coupon, order, item.TicketTypeID) {
+			eligible[i] = true
+			eligibleCents += remaining[i]
+			tickets += int(item.TicketNum)
+		}
+	}
+	ext, err := uc.Coupon.Ext()
+	if err != nil {
+		return 0, ErrCouponUnavailable
+	}
+	if err = couponrule.CheckTicketCount(ext, tickets); err != nil {
+		return 0, err
+	}
+	if eligibleCents < ToCents(uc.MinUseAmount) {
+		return 0, ErrBelowMinAmount
+	}
+	discount, err := couponDiscount(uc.Coupon, uc, eligibleCents)
+	if err != nil {
+		return 0, err
+	}
+	shares := allocate(remaining, eligible, discount)
+	for i, share := range shares {
+		if eligible[i] {
+			eligibleAny[i] = true
+		}
+		if share == 0 {
+			continue
+		}
+		remaining[i] -= share
+		items[i].Allocations = append(items[i].Allocations, Allocation{UserCouponID: uc.ID, Discount: FromCents(share)})
+	}
+	return discount, nil
+}
+
+// CheckUsable 校验用户优惠券的状态、有效期、适用范围与使用规则，不含金额与张数门槛
+func CheckUsable(order *model.OrderMain, userCoupon *model.UserCoupon, env Env) error {
+	now := env.Now
+	if userCoupon.Coupon == nil {
+		return ErrCouponMissing
+	}
+	if userCoupon.UseStatus != constant.UseStatusUnused {
+		return ErrCouponUnavailable
+	}
+	if now.Before(userCoupon.ValidStartTime) {
+		return ErrCouponNotStarted
+	}
+	if !now.Before(userCoupon.ValidEndTime) {
+		return ErrCouponExpired
+	}
+	if !anyItemApplicable(userCoupon.Coupon, order) {
+		return ErrSpotNotApplicable
+	}
+	if issuerType(userCoupon.Coupon) == constant.IssuerTypeMerchant && userCoupon.Coupon.MerchantID != order.MerchantID {
+		return ErrMerchantMismatch
+	}
+	ext, err := userCoupon.Coupon.Ext()
+	if err != nil {
+		return ErrCouponUnavailable
+	}
+	in := couponrule.RedeemInput{Now: now, NewUser: env.NewUser, Strict: env.Strict}
+	if order.PayType != nil {
+		in.PayType = *order.PayType
+	}
+	return couponrule.CheckRedeem(ext, in)
+}
+
+// couponDiscount 按券类型计算优惠金额（分），不超过可抵扣金额
+func couponDiscount(c *model.Coupon, userCoupon *model.UserCoupon, eligibleCents int64) (int64, error) {
+	var discount int64
+	switch c.CouponType {
+	case constant.CouponTypeFixed:
+		discount = ToCents(userCoupon.Denomination)
+	case constant.CouponTypeDiscount:
+		rate := userCoupon.Denomination
+		if rate <= 0 || rate >= 1 {
+			return 0, ErrCouponUnavailable
+		}
+		discount = int64(math.Round(float64(eligibleCents) * (1 - rate)))
+		ext, err := c.Ext()
+		if err != nil {
+			return 0, ErrCouponUnavailable
+		}
+		if ext.MaxDiscount > 0 && discount > ToCents(ext.MaxDiscount) {
+			discount = ToCents(ext.MaxDiscount)
+		}
+	default:
+		return 0, ErrUnknownCouponType
+	}
+	if discount > eligibleCents {
+		discount = eligibleCents
+	}
+	return discount, nil
+}
+
+// allocate 将优惠金额按剩余金额比例分摊到参与抵扣的明细，尾差按最大余数补齐，返回各明细分摊额（分）
+func allocate(remaining []int64, eligible []bool, discountCents int64) []int64 {
+	shares := make([]int64, len(remaining))
+	var base int64
+	for i, cents := range remaining {
+		if eligible[i] {
+			base += cents
+		}
+	}
+	if base == 0 || discountCents == 0 {
+		return shares
+	}
+	remainders := make([]int64, len(remaining))
+	var allocated int64
+	for i, cents := range remaining {
+		if !eligible[i] {
+			continue
+		}
+		product := cents * discountCents
+		shares[i] = product / base
+		remainders[i] = product % base
+		allocated += shares[i]
+	}
+	for left := discountCents - allocated; left > 0; left-- {
+		best := -1
+		for i := range remaining {
+			if !eligible[i] || shares[i] >= remaining[i] {
+				continue
+			}
+			if best < 0 || remainders[i] > remainders[best] {
+				best = i
+			}
+		}
+		if best < 0 {
+			break
+		}
+		shares[best]++
+		remainders[best] = -1
+	}
+	return shares
+}
+
+// ToCents 元转分，四舍五入
+func ToCents(yuan float64) int64 {
+	return int64(math.Round(yuan * 100))
+}
+
+// FromCents 分转元
+func FromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
@@ -0,0 +1,66 @@
+package pricing
+
+import (
+	"example_shop/common/constant"
+	"example_shop/common/model"
+	"strconv"
+	"strings"
+)
+
+// itemApplicable 判断订单明细是否在优惠券适用范围内，Coupon.Scopes 需预加载
+// 无范围记录时兼容未迁移的 ApplySpotIDs，两者皆空表示不限范围
+func itemApplicable(c *model.Coupon, order *model.OrderMain, ticketTypeID uint64) bool {
+	if len(c.Scopes) == 0 {
+		return spotApplicable(c.ApplySpotIDs, order.SpotID)
+	}
+	for i := range c.Scopes {
+		if ScopeMatches(&c.Scopes[i], order.Spot, order.SpotID, ticketTypeID) {
+			return true
+		}
+	}
+	return false
+}
+
+// anyItemApplicable 订单中是否至少有一条明细可用该优惠券
+func anyItemApplicable(c *model.Coupon, order *model.OrderMain) bool {
+	for _, item := range order.OrderItems {
+		if itemApplicable(c, order, item.TicketTypeID) {
+			return true
+		}
+	}
+	return false
+}
+
+// ScopeMatches 判断单条适用范围是否命中景点与门票类型
+// ticketTypeID 为0时只判断景点维度，门票类型范围按其所属景点命中；spot 为nil时地区范围不命中
+func ScopeMatches(s *model.CouponScope, spot *model.SpotInfo, spotID, ticketTypeID uint64) bool {
+	switch s.ScopeType {
+	case constant.ScopeTypeSpot:
+		return s.SpotID == spotID
+	case constant.ScopeTypeTicketType:
+		if ticketTypeID == 0 {
+			return s.SpotID == spotID
+		}
+		return s.TicketTypeID == ticketTypeID
+	case constant.ScopeTypeCity:
+		return spot != nil && s.Province == spot.Province && s.City == spot.City
+	case constant.ScopeTypeProvince:
+		return spot != nil && s.Province == spot.Province
+	default:
+		return false
+	}
+}
+
+// spotApplicable 判断景点是否在适用范围内，空=全景点通用
+func spotApplicable(applySpotIDs *string, spotID uint64) bool {
+	if applySpotIDs == nil || strings.TrimSpace(*applySpotIDs) == "" {
+		return true
+	}
+	target := strconv.FormatUint(spotID, 10)
+	for _, id := range strings.Split(*applySpotIDs, ",") {
+		if strings.TrimSpace(id) == target {
+			return true
+		}
+	}
+	return false
+}
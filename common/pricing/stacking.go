@@ -0,0 +1,99 @@
+package pricing
+
+import (
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/couponrule"
+	"example_shop/common/model"
+	"sort"
+)
+
+// 叠加规则校验失败原因
+var (
+	ErrTooManyCoupons    = errors.New("单个订单最多同时使用一张平台券和一张商家券")
+	ErrDuplicateCoupon   = errors.New("同一张优惠券不能重复使用")
+	ErrSameIssuerType    = errors.New("平台券、商家券各限使用一张")
+	ErrStackGroupClash   = errors.New("所选优惠券属于同一互斥组，不能同时使用")
+	ErrMerchantMismatch  = errors.New("商家券仅限发券商家的订单使用")
+	ErrUnknownIssuerType = errors.New("未知的发券方类型")
+)
+
+// ValidateStacking 校验多张优惠券能否同时用于一个订单：
+// 数量不超过 MaxStackCoupons，平台券、商家券各至多一张，同一非空互斥组内的券不能同时使用
+func ValidateStacking(order *model.OrderMain, userCoupons []*model.UserCoupon) error {
+	if len(userCoupons) > constant.MaxStackCoupons {
+		return ErrTooManyCoupons
+	}
+	seen := make(map[uint64]struct{}, len(userCoupons))
+	issuers := make(map[string]struct{}, len(userCoupons))
+	groups := make(map[string]struct{}, len(userCoupons))
+	for _, uc := range userCoupons {
+		if uc.Coupon == nil {
+			return ErrCouponMissing
+		}
+		if _, ok := seen[uc.ID]; ok {
+			return ErrDuplicateCoupon
+		}
+		seen[uc.ID] = struct{}{}
+
+		issuer := issuerType(uc.Coupon)
+		if issuer != constant.IssuerTypePlatform && issuer != constant.IssuerTypeMerchant {
+			return ErrUnknownIssuerType
+		}
+		if _, ok := issuers[issuer]; ok {
+			return ErrSameIssuerType
+		}
+		issuers[issuer] = struct{}{}
+
+		if group := uc.Coupon.StackGroup; group != "" {
+			if _, ok := groups[group]; ok {
+				return ErrStackGroupClash
+			}
+			groups[group] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// SortForApply 返回按抵扣顺序排列的副本：优先级高的先抵扣，同优先级商家券先于平台券，最后按用户优惠券ID
+func SortForApply(userCoupons []*model.UserCoupon) []*model.UserCoupon {
+	ordered := append([]*model.UserCoupon(nil), userCoupons...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		a, b := ordered[i].Coupon, ordered[j].Coupon
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		am, bm := issuerType(a) == constant.IssuerTypeMerchant, issuerType(b) == constant.IssuerTypeMerchant
+		if am != bm {
+			return am
+		}
+		return ordered[i].ID < ordered[j].ID
+	})
+	return ordered
+}
+
+// issuerType 发券方，历史数据为空时视为平台券
+func issuerType(c *model.Coupon) string {
+	if c.IssuerType == "" {
+		return constant.IssuerTypePlatform
+	}
+	return c.IssuerType
+}
+
+// IsUnusable 判断错误是否为优惠券不可用、不满足使用规则或叠加规则校验失败，此类错误消息可直接返回给调用方
+func IsUnusable(err error) bool {
+	if couponrule.IsViolation(err) {
+		return true
+	}
+	for _, target := range []error{
+		ErrCouponMissing, ErrCouponUnavailable, ErrCouponNotStarted, ErrCouponExpired,
+		ErrSpotNotApplicable, ErrBelowMinAmount, ErrUnknownCouponType,
+		ErrTooManyCoupons, ErrDuplicateCoupon, ErrSameIssuerType, ErrStackGroupClash,
+		ErrMerchantMismatch, ErrUnknownIssuerType,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
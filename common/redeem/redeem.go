@@ -0,0 +1,239 @@
+// Package redeem 用户优惠券与订单生命周期绑定：下单锁定、支付核销、取消/退款释放
+// 所有状态流转均使用带前置状态的条件更新，同一张用户优惠券不会被两个订单同时使用
+package redeem
+
+import (
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/couponrule"
+	"example_shop/common/model"
+	"example_shop/common/pricing"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrNotLockable   = errors.New("优惠券不可用或已被其他订单使用")
+	ErrOrderMismatch = errors.New("订单不存在、不属于该用户、不是待支付状态或已使用优惠券")
+	ErrNotLocked     = errors.New("订单未锁定优惠券")
+	ErrNotReleasable = errors.New("订单未取消或未全额退款，不能释放优惠券")
+	ErrNotPaid       = errors.New("订单未支付，不能核销优惠券")
+)
+
+// Lock 将用户优惠券锁定到订单，需在事务中调用，仅草稿与待支付订单可锁券，否则返回 ErrOrderMismatch
+// 多张券叠加时按 pricing 的叠加规则校验并计算各券优惠，写入订单优惠券关联，
+// 订单上记录首张抵扣券的优惠券ID并回写实付金额
+func Lock(tx *gorm.DB, userID, orderID uint64, userCouponIDs []uint64, now time.Time) (*pricing.Result, error) {
+	if len(userCouponIDs) == 0 {
+		return nil, ErrNotLockable
+	}
+	var order model.OrderMain
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND user_id = ? AND coupon_id = 0", orderID, userID).First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOrderMismatch
+		}
+		return nil, err
+	}
+	if order.OrderStatus != constant.OrderStatusDraft && order.OrderStatus != constant.OrderStatusPendingPay {
+		return nil, ErrOrderMismatch
+	}
+	if err := tx.Where("order_id = ?", orderID).Order("id").Find(&order.OrderItems).Error; err != nil {
+		return nil, err
+	}
+	var spot model.SpotInfo
+	if err := tx.Select("id", "merchant_id", "province", "city").Where("id = ?", order.SpotID).
+		First(&spot).Error; err == nil {
+		order.Spot = &spot
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	var list []model.UserCoupon
+	if err := tx.Preload("Coupon.Scopes").Where("id IN ? AND user_id = ?", userCouponIDs, userID).
+		Find(&list).Error; err != nil {
+		return nil, err
+	}
+	found := make(map[uint64]*model.UserCoupon, len(list))
+	for i := range list {
+		found[list[i].ID] = &list[i]
+	}
+	userCoupons := make([]*model.UserCoupon, 0, len(userCouponIDs))
+	for _, id := range userCouponIDs {
+		uc, ok := found[id]
+		if !ok {
+			return nil, ErrNotLockable
+		}
+		userCoupons = append(userCoupons, uc)
+	}
+	env := pricing.Env{Now: now, Strict: true}
+	coupons := make([]*model.Coupon, 0, len(userCoupons))
+	for _, uc := range userCoupons {
+		coupons = append(coupons, uc.Coupon)
+	}
+	if couponrule.NeedsNewUser(coupons...) {
+		newUser, err := couponrule.IsNewUser(tx, userID)
+		if err != nil {
+			return nil, err
+		}
+		env.NewUser = newUser
+	}
+	res, err := pricing.Calculate(&order, userCoupons, env)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]model.OrderCoupon, 0, len(res.Coupons))
+	for i, cd := range res.Coupons {
+		result := tx.Model(&model.UserCoupon{}).
+			Where("id = ? AND user_id = ? AND use_status = ? AND valid_start_time <= ? AND valid_end_time > ?",
+				cd.UserCouponID, userID, constant.UseStatusUnused, now, now).
+			Updates(map[string]interface{}{
+				"use_status": constant.UseStatusLocked,
+				"order_id":   orderID,
+			})
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, ErrNotLockable
+		}
+		links = append(links, model.OrderCoupon{
+			OrderID:        orderID,
+			UserCouponID:   cd.UserCouponID,
+			CouponID:       cd.CouponID,
+			IssuerType:     cd.IssuerType,
+			MerchantID:     cd.MerchantID,
+			ApplySeq:       uint8(i + 1),
+			DiscountAmount: cd.Discount,
+		})
+	}
+	if err = tx.Create(&links).Error; err != nil {
+		return nil, err
+	}
+
+	result := tx.Model(&model.OrderMain{}).
+		Where("id = ? AND user_id = ? AND coupon_id = 0", orderID, userID).
+		Updates(map[string]interface{}{
+			"coupon_id":  res.Coupons[0].CouponID,
+			"pay_amount": res.PayAmount,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrOrderMismatch
+	}
+	return res, nil
+}
+
+// CheckPayType 支付时校验订单锁定的优惠券是否允许该支付方式，需在锁定订单的事务中调用
+// 其他使用规则已在锁券时校验，这里只复核下单时未选择支付方式的订单
+func CheckPayType(tx *gorm.DB, orderID uint64, payType string) error {
+	var list []model.UserCoupon
+	if err := tx.Preload("Coupon").Where("order_id = ? AND use_status = ?", orderID, constant.UseStatusLocked).
+		Find(&list).Error; err != nil {
+		return err
+	}
+	for _, uc := range list {
+		if uc.Coupon == nil {
+			return pricing.ErrCouponMissing
+		}
+		ext, err := uc.Coupon.Ext()
+		if err != nil {
+			return pricing.ErrCouponUnavailable
+		}
+		in := couponrule.RedeemInput{PayType: payType, Strict: true}
+		if err = couponrule.CheckRedeem(model.CouponExt{PayTypes: ext.PayTypes}, in); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Consume 订单支付成功后核销其锁定的优惠券，返回是否有优惠券被核销，需在事务中调用
+// 锁定订单行后校验订单已支付，否则返回 ErrNotPaid；订单记录了优惠券但未找到锁定记录时返回 ErrNotLocked
+func Consume(tx *gorm.DB, orderID uint64, now time.Time) (bool, error) {
+	var order model.OrderMain
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "order_status", "coupon_id").
+		Where("id = ?", orderID).First(&order).Error; err != nil {
+		return false, err
+	}
+	if order.OrderStatus != constant.OrderStatusPaid {
+		return false, ErrNotPaid
+	}
+	result := tx.Model(&model.UserCoupon{}).
+		Where("order_id = ? AND use_status = ?", orderID, constant.UseStatusLocked).
+		Updates(map[string]interface{}{
+			"use_status": constant.UseStatusUsed,
+			"use_time":   now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return true, nil
+	}
+	if order.CouponID == 0 {
+		return false, nil
+	}
+	var used int64
+	if err := tx.Model(&model.UserCoupon{}).
+		Where("order_id = ? AND use_status = ?", orderID, constant.UseStatusUsed).
+		Count(&used).Error; err != nil {
+		return false, err
+	}
+	if used > 0 {
+		// 重复回调，已核销
+		return false, nil
+	}
+	return false, ErrNotLocked
+}
+
+// Release 订单取消或全额退款时释放优惠券：仍在有效期内的恢复为未使用，已过有效期的置为过期，需在事务中调用
+// 已取消订单释放锁定与已核销的券，已退款订单只释放已核销的券，其他状态返回 ErrNotReleasable；
+// 同时删除订单优惠券关联并清空订单的优惠券ID。返回被释放的优惠券数量，订单未使用优惠券时返回0
+func Release(tx *gorm.DB, orderID uint64, now time.Time) (int64, error) {
+	var order model.OrderMain
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "order_status").
+		Where("id = ?", orderID).First(&order).Error; err != nil {
+		return 0, err
+	}
+	var held []string
+	switch order.OrderStatus {
+	case constant.OrderStatusCancelled:
+		held = []string{constant.UseStatusLocked, constant.UseStatusUsed}
+	case constant.OrderStatusRefunded:
+		held = []string{constant.UseStatusUsed}
+	default:
+		return 0, ErrNotReleasable
+	}
+	restored := tx.Model(&model.UserCoupon{}).
+		Where("order_id = ? AND use_status IN ? AND valid_end_time > ?", orderID, held, now).
+		Updates(map[string]interface{}{
+			"use_status": constant.UseStatusUnused,
+			"order_id":   0,
+			"use_time":   nil,
+		})
+	if restored.Error != nil {
+		return 0, restored.Error
+	}
+	expired := tx.Model(&model.UserCoupon{}).
+		Where("order_id = ? AND use_status IN ? AND valid_end_time <= ?", orderID, held, now).
+		Updates(map[string]interface{}{
+			"use_status": constant.UseStatusExpired,
+			"use_time":   nil,
+		})
+	if expired.Error != nil {
+		return 0, expired.Error
+	}
+	if err := tx.Where("order_id = ?", orderID).Delete(&model.OrderCoupon{}).Error; err != nil {
+		return 0, err
+	}
+	if err := tx.Model(&model.OrderMain{}).Where("id = ? AND coupon_id <> 0", orderID).Update("coupon_id", 0).Error; err != nil {
+		return 0, err
+	}
+	return restored.RowsAffected + expired.RowsAffected, nil
+}
@@ -0,0 +1,54 @@
+// Package redislease 基于 Redis SET NX PX 的租约：同一 Key 同一时刻只有一个持有者，仅持有者可续期与释放，
+// 供任务选主与ID工作节点号分配共用
+package redislease
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// renewScript 仅当租约仍属于自己时续期
+var renewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript 仅当租约仍属于自己时释放
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// Acquire 租约空闲时抢占，返回是否成功
+func Acquire(ctx context.Context, rdb *redis.Client, key, owner string, ttl time.Duration) (bool, error) {
+	return rdb.SetNX(ctx, key, owner, ttl).Result()
+}
+
+// Renew 续期自己持有的租约，租约已过期或被他人持有时返回 false
+func Renew(ctx context.Context, rdb *redis.Client, key, owner string, ttl time.Duration) (bool, error) {
+	ok, err := renewScript.Run(ctx, rdb, []string{key}, owner, ttl.Milliseconds()).Int()
+	return ok == 1, err
+}
+
+// Release 释放自己持有的租约，已被他人持有时不做处理
+func Release(ctx context.Context, rdb *redis.Client, key, owner string) error {
+	return releaseScript.Run(ctx, rdb, []string{key}, owner).Err()
+}
+
+// InstanceID 主机名+进程号+随机串，区分不同副本
+func InstanceID() string {
+	host, _ := os.Hostname()
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(b))
+}
@@ -0,0 +1,163 @@
+// Package refundrule 门票退改规则：解析、校验 TicketType.RefundPolicy，按档位计算退款手续费与改期次数限制
+// 未配置结构化规则的历史门票按 RefundRule 文本中的常见写法解析，无法识别时不支持自动退改
+package refundrule
+
+import (
+	"errors"
+	"example_shop/common/model"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	maxHoursBefore = 24 * 365
+	maxReschedule  = 10
+)
+
+// Violation 不满足退改规则，消息可直接返回给调用方
+type Violation struct{ Msg string }
+
+func (v *Violation) Error() string { return v.Msg }
+
+// IsViolation 判断错误是否为规则不满足
+func IsViolation(err error) bool {
+	var v *Violation
+	return errors.As(err, &v)
+}
+
+// ErrUnrecognized 门票未配置结构化规则且退改规则文本无法识别
+var ErrUnrecognized = &Violation{"该门票退改规则需人工处理，请联系客服"}
+
+// Validate 校验规则取值：档位提前小时数不重复，手续费比例 0-99，改期次数 0-10
+func Validate(p *model.RefundPolicy) error {
+	if p.Version != 0 && p.Version != model.RefundPolicyVersion {
+		return fmt.Errorf("不支持的退改规则版本: %d", p.Version)
+	}
+	seen := make(map[int]struct{}, len(p.Tiers))
+	for _, t := range p.Tiers {
+		if t.HoursBefore < 0 || t.HoursBefore > maxHoursBefore {
+			return fmt.Errorf("退款档位提前小时数需在0到%d之间", maxHoursBefore)
+		}
+		if t.FeePercent < 0 || t.FeePercent >= 100 {
+			return errors.New("退款手续费比例需在0到99之间")
+		}
+		if _, dup := seen[t.HoursBefore]; dup {
+			return fmt.Errorf("退款档位提前小时数重复: %d", t.HoursBefore)
+		}
+		seen[t.HoursBefore] = struct{}{}
+	}
+	if p.RescheduleLimit < 0 || p.RescheduleLimit > maxReschedule {
+		return fmt.Errorf("改期次数需在0到%d之间", maxReschedule)
+	}
+	return nil
+}
+
+// Resolve 取门票的退改规则：优先结构化规则，否则解析退改规则文本
+func Resolve(t *model.TicketType) (*model.RefundPolicy, error) {
+	p, err := t.Policy()
+	if err != nil {
+		return nil, fmt.Errorf("门票%d退改规则格式错误: %w", t.ID, err)
+	}
+	if p != nil {
+		return p, Validate(p)
+	}
+	return FromText(t.RefundRule)
+}
+
+var (
+	reBeforeFree = regexp.MustCompile(`(?:游玩|使用|入园)前\s*(\d+)\s*(小时|天)\s*(?:以上)?(?:可|免费)?退`)
+	reFeeTier    = regexp.MustCompile(`(?:游玩|使用|入园)前\s*(\d+)\s*(小时|天)\s*(?:以上|以内|内)?[^，。；,;]*?(?:收取|扣除|扣)\s*(\d+)\s*%`)
+	reReschedule = regexp.MustCompile(`改期限\s*(\d+)\s*次`)
+	reAnytime    = regexp.MustCompile(`随时(?:可)?退|未使用(?:可|全额)?退`)
+	reNoRefund   = regexp.MustCompile(`不可退|不支持退|不退不改`)
+)
+
+// FromText 识别退改规则文本中的常见写法，如“游玩前24小时可退，逾期不退，改期限1次”“游玩前2小时收取20%手续费”，
+// 无法识别出任何退款或改期约定时返回 ErrUnrecognized
+func FromText(text string) (*model.RefundPolicy, error) {
+	text = strings.TrimSpace(text)
+	p := &model.RefundPolicy{Version: model.RefundPolicyVersion}
+	recognized := false
+	if m := reReschedule.FindStringSubmatch(text); m != nil {
+		p.RescheduleLimit, _ = strconv.Atoi(m[1])
+		recognized = true
+	}
+	seen := make(map[int]struct{})
+	for _, m := range reFeeTier.FindAllStringSubmatch(text, -1) {
+		hours := toHours(m[1], m[2])
+		fee, _ := strconv.Atoi(m[3])
+		p.Tiers = append(p.Tiers, model.RefundTier{HoursBefore: hours, FeePercent: fee})
+		seen[hours] = struct{}{}
+	}
+	for _, m := range reBeforeFree.FindAllStringSubmatch(text, -1) {
+		hours := toHours(m[1], m[2])
+		if _, ok := seen[hours]; ok {
+			continue
+		}
+		p.Tiers = append(p.Tiers, model.RefundTier{HoursBefore: hours})
+		seen[hours] = struct{}{}
+	}
+	if _, ok := seen[0]; !ok && reAnytime.MatchString(text) {
+		p.Tiers = append(p.Tiers, model.RefundTier{})
+	}
+	// “逾期不可退”等只是对档位之外的补充说明，未识别出任何档位时才视为整体不可退
+	if len(p.Tiers) == 0 && reNoRefund.MatchString(text) {
+		return p, Validate(p)
+	}
+	if !recognized && len(p.Tiers) == 0 {
+		return nil, ErrUnrecognized
+	}
+	if err := Validate(p); err != nil {
+		return nil, ErrUnrecognized
+	}
+	return p, nil
+}
+
+func toHours(n, unit string) int {
+	v, _ := strconv.Atoi(n)
+	if unit == "天" {
+		return v * 24
+	}
+	return v
+}
+
+// FeePercent 按申请时间距游玩开始时间的剩余小时数匹配档位，返回手续费比例；无匹配档位时不可退
+func FeePercent(p *model.RefundPolicy, visitStart, now time.Time) (int, error) {
+	if len(p.Tiers) == 0 {
+		return 0, &Violation{"该门票不支持退款"}
+	}
+	tiers := append([]model.RefundTier(nil), p.Tiers...)
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].HoursBefore > tiers[j].HoursBefore })
+	left := visitStart.Sub(now)
+	for _, t := range tiers {
+		if left >= time.Duration(t.HoursBefore)*time.Hour {
+			return t.FeePercent, nil
+		}
+	}
+	last := tiers[len(tiers)-1]
+	return 0, &Violation{fmt.Sprintf("已超过退款期限（需在游玩前%d小时申请）", last.HoursBefore)}
+}
+
+// Fee 按比例计算手续费（分），四舍五入且不超过金额本身
+func Fee(amountCents int64, feePercent int) int64 {
+	fee := (amountCents*int64(feePercent) + 50) / 100
+	if fee > amountCents {
+		return amountCents
+	}
+	return fee
+}
+
+// CheckReschedule 校验已改期次数是否仍可改期
+func CheckReschedule(p *model.RefundPolicy, rescheduled int) error {
+	if p.RescheduleLimit <= 0 {
+		return &Violation{"该门票不支持改期"}
+	}
+	if rescheduled >= p.RescheduleLimit {
+		return &Violation{fmt.Sprintf("该门票最多改期%d次，已用完", p.RescheduleLimit)}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package refundrule
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"example_shop/common/model"
+)
+
+func TestFromText(t *testing.T) {
+	cases := []struct {
+		name       string
+		text       string
+		tiers      []model.RefundTier
+		reschedule int
+		err        error
+	}{
+		{
+			name:       "历史默认文本",
+			text:       "游玩前24小时可退，逾期不退，改期限1次",
+			tiers:      []model.RefundTier{{HoursBefore: 24}},
+			reschedule: 1,
+		},
+		{
+			name:  "按天免费退",
+			text:  "入园前2天以上免费退",
+			tiers: []model.RefundTier{{HoursBefore: 48}},
+		},
+		{
+			name:  "手续费档位",
+			text:  "游玩前2小时收取20%手续费",
+			tiers: []model.RefundTier{{HoursBefore: 2, FeePercent: 20}},
+		},
+		{
+			name: "免费档位与手续费档位并存",
+			text: "游玩前1天可退，游玩前2小时以内扣除50%，改期限2次",
+			tiers: []model.RefundTier{
+				{HoursBefore: 2, FeePercent: 50},
+				{HoursBefore: 24},
+			},
+			reschedule: 2,
+		},
+		{
+			name:  "同一档位手续费优先",
+			text:  "游玩前24小时可退，游玩前24小时收取10%",
+			tiers: []model.RefundTier{{HoursBefore: 24, FeePercent: 10}},
+		},
+		{
+			name:  "随时退",
+			text:  "未使用随时退",
+			tiers: []model.RefundTier{{}},
+		},
+		{
+			name: "不可退",
+			text: "不可退",
+		},
+		{
+			name: "不退不改",
+			text: "特价票，不退不改",
+		},
+		{
+			name:       "不可退但可改期",
+			text:       "不可退，改期限1次",
+			reschedule: 1,
+		},
+		{
+			name:  "退款档位优先于不可退",
+			text:  "游玩前24小时可退，特价场次不可退",
+			tiers: []model.RefundTier{{HoursBefore: 24}},
+		},
+		{
+			name:       "逾期不可退",
+			text:       "游玩前24小时可退，逾期不可退，改期限1次",
+			tiers:      []model.RefundTier{{HoursBefore: 24}},
+			reschedule: 1,
+		},
+		{
+			name:  "手续费档位与逾期不可退",
+			text:  "游玩前2小时收取20%手续费，逾期不支持退款",
+			tiers: []model.RefundTier{{HoursBefore: 2, FeePercent: 20}},
+		},
+		{name: "空文本", text: "", err: ErrUnrecognized},
+		{name: "无退改约定", text: "请凭身份证入园", err: ErrUnrecognized},
+		{name: "逾期不退无档位", text: "逾期不退", err: ErrUnrecognized},
+		{name: "手续费超限", text: "游玩前2小时收取100%手续费", err: ErrUnrecognized},
+		{name: "改期次数超限", text: "游玩前24小时可退，改期限11次", err: ErrUnrecognized},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p, err := FromText(c.text)
+			if c.err != nil {
+				if !errors.Is(err, c.err) {
+					t.Fatalf("FromText(%q) err = %v, want %v", c.text, err, c.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromText(%q) err = %v", c.text, err)
+			}
+			if p.Version != model.RefundPolicyVersion {
+				t.Errorf("Version = %d, want %d", p.Version, model.RefundPolicyVersion)
+			}
+			if !reflect.DeepEqual(p.Tiers, c.tiers) {
+				t.Errorf("Tiers = %+v, want %+v", p.Tiers, c.tiers)
+			}
+			if p.RescheduleLimit != c.reschedule {
+				t.Errorf("RescheduleLimit = %d, want %d", p.RescheduleLimit, c.reschedule)
+			}
+		})
+	}
+}
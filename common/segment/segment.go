@@ -0,0 +1,227 @@
+// Package segment 用户分群规则：以JSON描述的条件组合，编译为对 sys_user 的参数化查询条件
+//
+// 规则示例（近90天在杭州下过单的用户）：
+//
+//	{"version":1,"match":"ALL","conditions":[{"type":"ORDER","city":"杭州","within_days":90,"order_statuses":["PAID","VERIFIED"]}]}
+//
+// 条件类型：
+//   - REGISTERED：注册时间在 within_days 天内
+//   - ORDER：存在满足条件的订单，可按城市、省份、景点、订单状态、下单时间筛选，min_count 为最少订单数
+//   - COUPON：持有满足条件的优惠券，可按优惠券ID、使用状态、领取时间筛选
+//
+// 任一条件可设置 negate 取反，如 {"type":"ORDER","negate":true} 表示从未下单的新用户。
+package segment
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"example_shop/common/constant"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SchemaVersion 当前规则版本
+const SchemaVersion = 1
+
+// 条件组合方式
+const (
+	MatchAll = "ALL" // 满足全部条件
+	MatchAny = "ANY" // 满足任一条件
+)
+
+// 条件类型
+const (
+	CondRegistered = "REGISTERED"
+	CondOrder      = "ORDER"
+	CondCoupon     = "COUPON"
+)
+
+const (
+	maxConditions = 20
+	maxWithinDays = 3650
+	maxListSize   = 100
+)
+
+// Rule 分群规则
+type Rule struct {
+	Version    int         `json:"version"`
+	Match      string      `json:"match"`
+	Conditions []Condition `json:"conditions"`
+}
+
+// Condition 单个筛选条件，未使用的字段保持零值
+type Condition struct {
+	Type       string `json:"type"`
+	Negate     bool   `json:"negate,omitempty"`
+	WithinDays int    `json:"within_days,omitempty"` // 0=不限时间
+
+	// ORDER
+	Province      string   `json:"province,omitempty"`
+	City          string   `json:"city,omitempty"`
+	SpotIDs       []uint64 `json:"spot_ids,omitempty"`
+	OrderStatuses []string `json:"order_statuses,omitempty"`
+	MinCount      int      `json:"min_count,omitempty"` // 0/1=至少一单
+
+	// COUPON
+	CouponID  uint64 `json:"coupon_id,omitempty"`
+	UseStatus string `json:"use_status,omitempty"`
+}
+
+// Parse 解析并校验分群规则，不认识的字段视为错误
+func Parse(raw []byte) (*Rule, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	var r Rule
+	if err := dec.Decode(&r); err != nil {
+		return nil, fmt.Errorf("分群规则格式错误: %w", err)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Validate 校验规则各字段取值
+func (r *Rule) Validate() error {
+	if r.Version != SchemaVersion {
+		return fmt.Errorf("不支持的分群规则版本: %d", r.Version)
+	}
+	r.Match = strings.ToUpper(r.Match)
+	if r.Match == "" {
+		r.Match = MatchAll
+	}
+	if r.Match != MatchAll && r.Match != MatchAny {
+		return errors.New("match 只能是ALL或ANY")
+	}
+	if len(r.Conditions) == 0 {
+		return errors.New("分群规则至少包含一个条件")
+	}
+	if len(r.Conditions) > maxConditions {
+		return fmt.Errorf("分群规则最多包含%d个条件", maxConditions)
+	}
+	for i := range r.Conditions {
+		if err := r.Conditions[i].validate(); err != nil {
+			return fmt.Errorf("第%d个条件: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (c *Condition) validate() error {
+	c.Type = strings.ToUpper(c.Type)
+	if c.WithinDays < 0 || c.WithinDays > maxWithinDays {
+		return fmt.Errorf("within_days 取值范围为0-%d", maxWithinDays)
+	}
+	orderFields := c.Province != "" || c.City != "" || len(c.SpotIDs) > 0 || len(c.OrderStatuses) > 0 || c.MinCount != 0
+	couponFields := c.CouponID != 0 || c.UseStatus != ""
+	switch c.Type {
+	case CondRegistered:
+		if c.WithinDays == 0 {
+			return errors.New("REGISTERED 条件须指定 within_days")
+		}
+		if orderFields || couponFields {
+			return errors.New("REGISTERED 条件只支持 within_days")
+		}
+	case CondOrder:
+		if couponFields {
+			return errors.New("ORDER 条件不支持优惠券字段")
+		}
+		if c.MinCount < 0 {
+			return errors.New("min_count 不能小于0")
+		}
+		if len(c.SpotIDs) > maxListSize || len(c.OrderStatuses) > maxListSize {
+			return fmt.Errorf("spot_ids、order_statuses 最多%d项", maxListSize)
+		}
+	case CondCoupon:
+		if orderFields {
+			return errors.New("COUPON 条件不支持订单字段")
+		}
+		switch c.UseStatus {
+		case "", constant.UseStatusUnused, constant.UseStatusLocked, constant.UseStatusUsed, constant.UseStatusExpired:
+		default:
+			return fmt.Errorf("未知的优惠券使用状态: %s", c.UseStatus)
+		}
+	default:
+		return fmt.Errorf("未知的条件类型: %s", c.Type)
+	}
+	return nil
+}
+
+// Apply 将规则追加为对 sys_user 表的查询条件，query 须以 sys_user 为主表
+func (r *Rule) Apply(query *gorm.DB, now time.Time) *gorm.DB {
+	parts := make([]string, 0, len(r.Conditions))
+	var args []interface{}
+	for _, c := range r.Conditions {
+		sql, a := c.compile(now)
+		if c.Negate {
+			sql = "NOT " + sql
+		}
+		parts = append(parts, sql)
+		args = append(args, a...)
+	}
+	sep := " AND "
+	if r.Match == MatchAny {
+		sep = " OR "
+	}
+	return query.Where("("+strings.Join(parts, sep)+")", args...)
+}
+
+// compile 编译单个条件为带括号的SQL片段与参数
+func (c *Condition) compile(now time.Time) (string, []interface{}) {
+	var since time.Time
+	if c.WithinDays > 0 {
+		since = now.AddDate(0, 0, -c.WithinDays)
+	}
+	switch c.Type {
+	case CondRegistered:
+		return "(sys_user.created_at >= ?)", []interface{}{since}
+	case CondOrder:
+		where := []string{"o.user_id = sys_user.id", "o.deleted_at IS NULL"}
+		var args []interface{}
+		if !since.IsZero() {
+			where = append(where, "o.created_at >= ?")
+			args = append(args, since)
+		}
+		if c.Province != "" {
+			where = append(where, "s.province = ?")
+			args = append(args, c.Province)
+		}
+		if c.City != "" {
+			where = append(where, "s.city = ?")
+			args = append(args, c.City)
+		}
+		if len(c.SpotIDs) > 0 {
+			where = append(where, "o.spot_id IN ?")
+			args = append(args, c.SpotIDs)
+		}
+		if len(c.OrderStatuses) > 0 {
+			where = append(where, "o.order_status IN ?")
+			args = append(args, c.OrderStatuses)
+		}
+		from := "FROM order_main o JOIN spot_info s ON s.id = o.spot_id WHERE " + strings.Join(where, " AND ")
+		if c.MinCount > 1 {
+			return "((SELECT COUNT(*) " + from + ") >= ?)", append(args, c.MinCount)
+		}
+		return "(EXISTS (SELECT 1 " + from + "))", args
+	default: // CondCoupon
+		where := []string{"uc.user_id = sys_user.id", "uc.deleted_at IS NULL"}
+		var args []interface{}
+		if !since.IsZero() {
+			where = append(where, "uc.created_at >= ?")
+			args = append(args, since)
+		}
+		if c.CouponID != 0 {
+			where = append(where, "uc.coupon_id = ?")
+			args = append(args, c.CouponID)
+		}
+		if c.UseStatus != "" {
+			where = append(where, "uc.use_status = ?")
+			args = append(args, c.UseStatus)
+		}
+		return "(EXISTS (SELECT 1 FROM user_coupon uc WHERE " + strings.Join(where, " AND ") + "))", args
+	}
+}
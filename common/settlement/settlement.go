@@ -0,0 +1,68 @@
+// Package settlement 订单结算拆分：优惠由发券方出资，平台券由平台补贴给商家，商家券由商家自行承担
+package settlement
+
+import (
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/model"
+	"example_shop/common/pricing"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+var ErrAmountMismatch = errors.New("订单优惠明细与订单金额不一致")
+
+// Result 订单结算拆分结果，金额单位：元
+type Result struct {
+	OrderID          uint64  `json:"order_id"`
+	MerchantID       uint64  `json:"merchant_id"`
+	TotalAmount      float64 `json:"total_amount"`      // 订单原价
+	PayAmount        float64 `json:"pay_amount"`        // 用户实付
+	PlatformDiscount float64 `json:"platform_discount"` // 平台券优惠，由平台出资
+	MerchantDiscount float64 `json:"merchant_discount"` // 商家券优惠，由商家承担
+	MerchantIncome   float64 `json:"merchant_income"`   // 商家应收 = 用户实付 + 平台补贴 = 原价 - 商家承担优惠
+}
+
+// ForOrder 查询订单及其优惠券关联并计算结算拆分
+func ForOrder(tx *gorm.DB, orderID uint64) (*Result, error) {
+	var order model.OrderMain
+	if err := tx.Preload("OrderCoupons").Where("id = ?", orderID).First(&order).Error; err != nil {
+		return nil, err
+	}
+	return Compute(&order, order.OrderCoupons)
+}
+
+// Compute 按订单优惠券关联拆分优惠出资方
+// 早期订单无关联记录但记录了优惠券ID时，按平台券处理，优惠金额取原价与实付之差
+func Compute(order *model.OrderMain, coupons []model.OrderCoupon) (*Result, error) {
+	total, pay := pricing.ToCents(order.TotalAmount), pricing.ToCents(order.PayAmount)
+	var platform, merchant int64
+	if len(coupons) == 0 && order.CouponID != 0 {
+		platform = total - pay
+	}
+	for _, oc := range coupons {
+		cents := pricing.ToCents(oc.DiscountAmount)
+		switch oc.IssuerType {
+		case constant.IssuerTypeMerchant:
+			if oc.MerchantID != order.MerchantID {
+				return nil, fmt.Errorf("订单%d使用了其他商家(%d)的商家券", order.ID, oc.MerchantID)
+			}
+			merchant += cents
+		default:
+			platform += cents
+		}
+	}
+	if total-platform-merchant != pay {
+		return nil, ErrAmountMismatch
+	}
+	return &Result{
+		OrderID:          order.ID,
+		MerchantID:       order.MerchantID,
+		TotalAmount:      pricing.FromCents(total),
+		PayAmount:        pricing.FromCents(pay),
+		PlatformDiscount: pricing.FromCents(platform),
+		MerchantDiscount: pricing.FromCents(merchant),
+		MerchantIncome:   pricing.FromCents(total - merchant),
+	}, nil
+}
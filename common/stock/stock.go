@@ -0,0 +1,53 @@
+// Package stock 门票库存扣减与回补，统一通过 TicketType.Version 乐观锁防止超卖
+package stock
+
+import (
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/model"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrNotEnough       = errors.New("门票库存不足")
+	ErrVersionConflict = errors.New("门票库存已被修改，请重试")
+)
+
+// Deduct 按读取时的版本号扣减库存并递增版本号，库存扣完时置为售罄，需在事务中调用
+// 版本号已变化返回 ErrVersionConflict，调用方应回滚事务后重新读取再试
+func Deduct(tx *gorm.DB, t *model.TicketType, num uint32) error {
+	if t.Stock < num {
+		return ErrNotEnough
+	}
+	// gorm 按列名排序生成 SET，MySQL 按顺序赋值，ticket_status 判断的是扣减后的库存
+	result := tx.Model(&model.TicketType{}).
+		Where("id = ? AND version = ? AND stock >= ?", t.ID, t.Version, num).
+		Updates(map[string]interface{}{
+			"stock":         gorm.Expr("stock - ?", num),
+			"version":       gorm.Expr("version + 1"),
+			"ticket_status": gorm.Expr("IF(stock = 0, ?, ticket_status)", constant.TicketStatusStockOut),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrVersionConflict
+	}
+	t.Stock -= num
+	t.Version++
+	return nil
+}
+
+// Restore 回补库存并递增版本号，售罄的门票恢复在售，需在事务中调用
+func Restore(tx *gorm.DB, ticketTypeID uint64, num uint32) error {
+	if num == 0 {
+		return nil
+	}
+	return tx.Model(&model.TicketType{}).Where("id = ?", ticketTypeID).
+		Updates(map[string]interface{}{
+			"stock":         gorm.Expr("stock + ?", num),
+			"version":       gorm.Expr("version + 1"),
+			"ticket_status": gorm.Expr("IF(ticket_status = ?, ?, ticket_status)", constant.TicketStatusStockOut, constant.TicketStatusOnSale),
+		}).Error
+}
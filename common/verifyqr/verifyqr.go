@@ -0,0 +1,70 @@
+// Package verifyqr 核销二维码内容的签发与校验
+// 内容格式 V1.<订单ID>.<过期时间戳>.<核销码>.<签名>，签名为前四段的 HMAC-SHA256 截取前16字节后 base64url 编码，
+// 篡改任一字段或超过有效期均校验失败，用户截图外传也只在短时间内有效
+package verifyqr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	version = "V1"
+	sigLen  = 16
+)
+
+var (
+	ErrInvalid = errors.New("核销二维码无效")
+	ErrExpired = errors.New("核销二维码已过期，请刷新后重试")
+)
+
+// Payload 二维码携带的信息
+type Payload struct {
+	OrderID    uint64
+	VerifyCode string
+	ExpireAt   time.Time
+}
+
+// Sign 签发二维码内容
+func Sign(secret []byte, p *Payload) string {
+	body := fmt.Sprintf("%s.%d.%d.%s", version, p.OrderID, p.ExpireAt.Unix(), p.VerifyCode)
+	return body + "." + sign(secret, body)
+}
+
+// Parse 校验签名与有效期并解析二维码内容
+func Parse(secret []byte, content string, now time.Time) (*Payload, error) {
+	content = strings.TrimSpace(content)
+	i := strings.LastIndexByte(content, '.')
+	if i < 0 || !hmac.Equal([]byte(content[i+1:]), []byte(sign(secret, content[:i]))) {
+		return nil, ErrInvalid
+	}
+	parts := strings.Split(content[:i], ".")
+	if len(parts) != 4 || parts[0] != version || parts[3] == "" {
+		return nil, ErrInvalid
+	}
+	orderID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	expire, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	p := &Payload{OrderID: orderID, VerifyCode: parts[3], ExpireAt: time.Unix(expire, 0)}
+	if !now.Before(p.ExpireAt) {
+		return nil, ErrExpired
+	}
+	return p, nil
+}
+
+func sign(secret []byte, body string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:sigLen])
+}
@@ -0,0 +1,126 @@
+// Package whitelist 闸机离线核销白名单：按景点、按日导出当日可核销的核销码，Ed25519 签名后供闸机下载，
+// 闸机仅持有公钥即可校验白名单未被篡改，离线时凭白名单放行并在恢复网络后上传核销记录
+//
+// 格式 WL2.<景点ID>.<日期yyyymmdd>.<生成时间戳>.<条目>.<签名>，条目与签名均为 base64url 编码；
+// 每个条目9字节：核销码摘要8字节 + 可入园人数1字节，按摘要升序排列便于二分查找。
+// 摘要为 HMAC-SHA256(摘要密钥, 日期|景点ID|核销码) 的前8字节，摘要密钥为下载白名单的闸机的设备密钥，
+// 不随白名单下发，白名单单独泄露时无法离线穷举还原核销码
+package whitelist
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	version    = "WL2"
+	dateLayout = "20060102"
+	hashLen    = 8
+	entryLen   = hashLen + 1
+	MaxCount   = 255 // 单个核销码可入园人数上限
+)
+
+var ErrInvalid = errors.New("白名单格式错误或签名无效")
+
+// Entry 一个可核销的核销码
+type Entry struct {
+	Code  string
+	Count int // 该核销码可入园人数：出行人核销码为1，订单核销码为待核销人数
+}
+
+// Bundle 解析后的白名单
+type Bundle struct {
+	SpotID      uint64
+	Date        string // yyyymmdd
+	GeneratedAt time.Time
+	entries     []byte
+	digestKey   []byte
+}
+
+// Build 生成并签名白名单，digestKey 为核销码摘要密钥，date 按其所在时区取日期
+func Build(key ed25519.PrivateKey, digestKey []byte, spotID uint64, date, now time.Time, entries []Entry) (string, error) {
+	day := date.Format(dateLayout)
+	raw := make([][]byte, 0, len(entries))
+	for _, e := range entries {
+		if e.Count <= 0 || e.Count > MaxCount {
+			return "", fmt.Errorf("核销码可入园人数超出范围: %d", e.Count)
+		}
+		b := make([]byte, entryLen)
+		copy(b, digest(digestKey, day, spotID, e.Code))
+		b[hashLen] = byte(e.Count)
+		raw = append(raw, b)
+	}
+	sort.Slice(raw, func(i, j int) bool { return bytes.Compare(raw[i], raw[j]) < 0 })
+	body := fmt.Sprintf("%s.%d.%s.%d.%s", version, spotID, day, now.Unix(),
+		base64.RawURLEncoding.EncodeToString(bytes.Join(raw, nil)))
+	sig := ed25519.Sign(key, []byte(body))
+	return body + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// Parse 校验签名并解析白名单，闸机端使用，digestKey 为闸机本地保存的设备密钥
+func Parse(pub ed25519.PublicKey, digestKey []byte, content string) (*Bundle, error) {
+	i := strings.LastIndexByte(content, '.')
+	if i < 0 {
+		return nil, ErrInvalid
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(content[i+1:])
+	if err != nil || !ed25519.Verify(pub, []byte(content[:i]), sig) {
+		return nil, ErrInvalid
+	}
+	parts := strings.Split(content[:i], ".")
+	if len(parts) != 5 || parts[0] != version {
+		return nil, ErrInvalid
+	}
+	spotID, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	generated, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	entries, err := base64.RawURLEncoding.DecodeString(parts[4])
+	if err != nil || len(entries)%entryLen != 0 {
+		return nil, ErrInvalid
+	}
+	return &Bundle{SpotID: spotID, Date: parts[2], GeneratedAt: time.Unix(generated, 0), entries: entries, digestKey: digestKey}, nil
+}
+
+// Len 条目数
+func (b *Bundle) Len() int {
+	return len(b.entries) / entryLen
+}
+
+// Lookup 查询核销码可入园人数，不在白名单中返回0
+func (b *Bundle) Lookup(code string) int {
+	h := digest(b.digestKey, b.Date, b.SpotID, code)
+	n := b.Len()
+	i := sort.Search(n, func(k int) bool {
+		return bytes.Compare(b.entries[k*entryLen:k*entryLen+hashLen], h) >= 0
+	})
+	if i < n && bytes.Equal(b.entries[i*entryLen:i*entryLen+hashLen], h) {
+		return int(b.entries[i*entryLen+hashLen])
+	}
+	return 0
+}
+
+// KeyFromSeed 由配置的种子串派生签名私钥，公钥通过 Public() 获取后预置到闸机
+func KeyFromSeed(seed string) ed25519.PrivateKey {
+	sum := sha256.Sum256([]byte(seed))
+	return ed25519.NewKeyFromSeed(sum[:])
+}
+
+func digest(key []byte, day string, spotID uint64, code string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(day + "|" + strconv.FormatUint(spotID, 10) + "|" + code))
+	return mac.Sum(nil)[:hashLen]
+}
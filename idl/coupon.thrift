@@ -1,18 +1,517 @@
-// 必须的Thrift文档头部，解决「not document」核心问题
 namespace go coupon
 
-// 极简响应体（仅占位）
+// 通用响应体
 struct BaseResp {
     1: i32 code,
     2: string msg
 }
 
-// 极简入参（仅占位）
-struct EmptyReq {
-    1: i64 id
+// 优惠券适用范围，优惠券无范围时不限（商家券限本商家景点）
+struct CouponScopeInfo {
+    1: string scope_type,    // SPOT-景点，TICKET_TYPE-门票类型，CITY-城市，PROVINCE-省份
+    2: i64 spot_id,          // SPOT 必填；TICKET_TYPE 返回时为门票所属景点
+    3: i64 ticket_type_id,   // TICKET_TYPE 必填
+    4: string province,      // CITY/PROVINCE 必填
+    5: string city           // CITY 必填
+}
+
+// 优惠券详情，时间字段均为秒级时间戳
+struct CouponInfo {
+    1: i64 id,
+    2: string coupon_name,
+    3: string coupon_type,
+    4: double denomination,
+    5: double min_use_amount,
+    6: i64 valid_start_time,
+    7: i64 valid_end_time,
+    8: i32 stock,
+    9: string apply_spot_ids,
+    10: string coupon_status,
+    11: string ext_fields,
+    12: i64 created_at,
+    13: i64 updated_at,
+    14: string issuer_type,  // PLATFORM-平台券，MERCHANT-商家券
+    15: i64 merchant_id,
+    16: string stack_group,  // 互斥组，同组券不可叠加
+    17: i32 priority,        // 叠加抵扣优先级，数值大的先抵扣
+    18: list<CouponScopeInfo> scopes
+}
+
+// 创建优惠券
+struct CreateCouponReq {
+    1: string coupon_name,
+    2: string coupon_type,
+    3: double denomination,
+    4: double min_use_amount,
+    5: i64 valid_start_time,
+    6: i64 valid_end_time,
+    7: i32 stock,
+    8: string apply_spot_ids,  // 逗号分隔，空=全景点通用
+    9: string ext_fields,      // JSON字符串
+    10: string issuer_type,    // 空时按 merchant_id 推断：0=PLATFORM，否则MERCHANT
+    11: i64 merchant_id,       // 商家券必填，商家须审核通过，适用景点须属于该商家
+    12: string stack_group,
+    13: i32 priority,
+    14: list<CouponScopeInfo> scopes  // 与 apply_spot_ids 合并，均为空=不限范围
+}
+
+struct CreateCouponResp {
+    1: BaseResp base,
+    2: i64 coupon_id
+}
+
+// 修改优惠券，未传的字段保持不变
+struct UpdateCouponReq {
+    1: i64 coupon_id,
+    2: optional string coupon_name,
+    3: optional string coupon_type,
+    4: optional double denomination,
+    5: optional double min_use_amount,
+    6: optional i64 valid_start_time,
+    7: optional i64 valid_end_time,
+    8: optional i32 stock,
+    9: optional string apply_spot_ids,
+    10: optional string ext_fields,
+    11: optional string stack_group,
+    12: optional i32 priority,
+    13: i64 operator_merchant_id, // 商家操作时传入，只能修改本商家的券；平台操作传0
+    14: optional list<CouponScopeInfo> scopes  // 传入时与 apply_spot_ids 合并后整体替换原范围
+}
+
+struct UpdateCouponResp {
+    1: BaseResp base
+}
+
+struct GetCouponReq {
+    1: i64 coupon_id
+}
+
+struct GetCouponResp {
+    1: BaseResp base,
+    2: CouponInfo coupon
+}
+
+// 分页查询优惠券，筛选条件为空/0时不生效
+struct ListCouponsReq {
+    1: i32 page,
+    2: i32 page_size,
+    3: string coupon_status,
+    4: i64 start_time,  // 与有效期有交集
+    5: i64 end_time,
+    6: i64 spot_id,     // 适用于该景点（含全景点通用券）
+    7: string issuer_type,
+    8: i64 merchant_id  // 商家后台查询本商家的券
+}
+
+struct ListCouponsResp {
+    1: BaseResp base,
+    2: list<CouponInfo> coupons,
+    3: i64 total
+}
+
+// 查询景点当前可领取/使用的优惠券，结果走缓存，库存以领取时为准
+struct ListCouponsForSpotReq {
+    1: i64 spot_id,
+    2: i64 ticket_type_id  // 大于0时只返回适用于该门票类型的券
+}
+
+struct ListCouponsForSpotResp {
+    1: BaseResp base,
+    2: list<CouponInfo> coupons
+}
+
+// 使优惠券失效
+struct InvalidateCouponReq {
+    1: i64 coupon_id,
+    2: i64 operator_merchant_id  // 商家操作时传入，只能操作本商家的券；平台操作传0
+}
+
+struct InvalidateCouponResp {
+    1: BaseResp base
+}
+
+// 领取优惠券
+struct ClaimCouponReq {
+    1: i64 user_id,
+    2: i64 coupon_id,
+    3: string device_id  // 设备标识，用于设备维度防刷
+}
+
+// 普通券直接返回 user_coupon_id；秒杀券异步落库，返回 claim_token 供轮询
+struct ClaimCouponResp {
+    1: BaseResp base,
+    2: i64 user_coupon_id,
+    3: string claim_token
+}
+
+// 查询秒杀领取结果
+struct GetClaimStatusReq {
+    1: string claim_token
+}
+
+struct GetClaimStatusResp {
+    1: BaseResp base,
+    2: string claim_status,  // PENDING-处理中，SUCCESS-成功，FAILED-失败
+    3: i64 user_coupon_id
+}
+
+// 订单明细入参
+struct OrderItemReq {
+    1: i64 ticket_type_id,
+    2: i32 ticket_num
+}
+
+// 单张优惠券在明细上的分摊
+struct CouponAllocationInfo {
+    1: i64 user_coupon_id,
+    2: double discount
+}
+
+// 单张优惠券对订单的优惠贡献，按抵扣顺序排列
+struct CouponContributionInfo {
+    1: i64 user_coupon_id,
+    2: i64 coupon_id,
+    3: string coupon_name,
+    4: string issuer_type,
+    5: i64 merchant_id,
+    6: double discount
+}
+
+// 订单明细价格拆分
+struct ItemPriceInfo {
+    1: i64 ticket_type_id,
+    2: string ticket_name,
+    3: double single_price,
+    4: i32 ticket_num,
+    5: double amount,     // 原价小计
+    6: double discount,   // 分摊优惠
+    7: double pay_amount, // 实付小计
+    8: list<CouponAllocationInfo> allocations  // 各优惠券分摊
+}
+
+// 订单价格预览，user_coupon_id 为0时不使用优惠券
+struct PreviewOrderPriceReq {
+    1: i64 user_id,
+    2: list<OrderItemReq> items,
+    3: i64 user_coupon_id,
+    4: list<i64> user_coupon_ids  // 叠加使用多张券，与 user_coupon_id 合并去重
+}
+
+struct PreviewOrderPriceResp {
+    1: BaseResp base,
+    2: double total_amount,
+    3: double discount_amount,
+    4: double pay_amount,
+    5: list<ItemPriceInfo> items,
+    6: list<CouponContributionInfo> coupons
+}
+
+// 结算页优惠券推荐
+struct RecommendCouponsReq {
+    1: i64 user_id,
+    2: list<OrderItemReq> items
+}
+
+// 推荐结果，可用券按优惠金额从高到低排列在前，不可用券附带原因
+struct CouponRecommendation {
+    1: i64 user_coupon_id,
+    2: i64 coupon_id,
+    3: string coupon_name,
+    4: string coupon_type,
+    5: double denomination,
+    6: double min_use_amount,
+    7: i64 valid_start_time,
+    8: i64 valid_end_time,
+    9: bool usable,
+    10: double discount_amount,
+    11: double pay_amount,
+    12: string reason
+}
+
+struct RecommendCouponsResp {
+    1: BaseResp base,
+    2: double total_amount,
+    3: list<CouponRecommendation> coupons
+}
+
+// 订单进入支付时锁定优惠券
+struct LockCouponReq {
+    1: i64 user_id,
+    2: i64 user_coupon_id,
+    3: i64 order_id,
+    4: list<i64> user_coupon_ids  // 叠加使用多张券，与 user_coupon_id 合并去重
+}
+
+struct LockCouponResp {
+    1: BaseResp base,
+    2: double discount_amount,
+    3: double pay_amount,
+    4: list<CouponContributionInfo> coupons
+}
+
+// 订单支付成功后核销优惠券
+struct ConsumeCouponReq {
+    1: i64 order_id
+}
+
+struct ConsumeCouponResp {
+    1: BaseResp base,
+    2: bool consumed  // 本次是否有优惠券被核销
+}
+
+// 订单取消或全额退款后释放优惠券
+struct ReleaseCouponReq {
+    1: i64 order_id
+}
+
+struct ReleaseCouponResp {
+    1: BaseResp base,
+    2: i32 released  // 被释放的优惠券数量
+}
+
+// 批量生成兑换码，生成时按数量预占优惠券库存
+struct GenerateCodesReq {
+    1: i64 coupon_id,
+    2: i32 count,
+    3: string batch_name
+}
+
+struct GenerateCodesResp {
+    1: BaseResp base,
+    2: i64 batch_id
+}
+
+// 兑换码兑换为用户优惠券
+struct RedeemCodeReq {
+    1: i64 user_id,
+    2: string code
+}
+
+struct RedeemCodeResp {
+    1: BaseResp base,
+    2: i64 user_coupon_id
+}
+
+// 导出批次兑换码CSV
+struct ExportCodeBatchReq {
+    1: i64 batch_id
+}
+
+struct ExportCodeBatchResp {
+    1: BaseResp base,
+    2: string file_name,
+    3: binary csv_content
+}
+
+// 作废批次内未兑换的兑换码，并归还预占库存
+struct RevokeCodeBatchReq {
+    1: i64 batch_id
+}
+
+struct RevokeCodeBatchResp {
+    1: BaseResp base,
+    2: i32 revoked_count
+}
+
+// 创建用户分群，rule 为分群规则JSON（见 common/segment）
+struct CreateSegmentReq {
+    1: string segment_name,
+    2: string rule,
+    3: string remark
+}
+
+struct CreateSegmentResp {
+    1: BaseResp base,
+    2: i64 segment_id,
+    3: i64 estimated_users  // 当前命中的用户数
+}
+
+// 向分群用户定向发券，异步执行
+struct DistributeCouponReq {
+    1: i64 coupon_id,
+    2: i64 segment_id,
+    3: string operator
+}
+
+struct DistributeCouponResp {
+    1: BaseResp base,
+    2: i64 distribution_id,
+    3: i64 estimated_users
+}
+
+// 定向发券任务进度
+struct DistributionInfo {
+    1: i64 id,
+    2: i64 coupon_id,
+    3: i64 segment_id,
+    4: string dist_status,  // PENDING/RUNNING/COMPLETED/STOCK_OUT/FAILED
+    5: i64 estimated_users,
+    6: i64 matched_count,
+    7: i64 issued_count,
+    8: i64 skipped_count,
+    9: i64 last_user_id,
+    10: string last_error,
+    11: i64 created_at,
+    12: i64 finished_at
+}
+
+struct GetDistributionReq {
+    1: i64 distribution_id
+}
+
+struct GetDistributionResp {
+    1: BaseResp base,
+    2: DistributionInfo distribution
+}
+
+// 恢复失败的发券任务，从已处理的游标处继续
+struct ResumeDistributionReq {
+    1: i64 distribution_id
+}
+
+struct ResumeDistributionResp {
+    1: BaseResp base
+}
+
+// 查询优惠券活动统计，日期格式 2006-01-02，默认最近30天
+struct GetCouponStatsReq {
+    1: i64 coupon_id,
+    2: string start_date,
+    3: string end_date,
+    4: i64 spot_id,              // 仅看指定景点，0=全部
+    5: i64 operator_merchant_id  // 商家查询时传入，只能查看本商家的券，0=平台
+}
+
+// 优惠券累计统计，领用状态为统计刷新时的快照
+struct CouponStatsSummary {
+    1: i64 coupon_id,
+    2: string coupon_name,
+    3: i64 remaining_stock,     // 当前剩余库存（实时）
+    4: i64 issued_count,        // 累计发放张数（领取、兑换、定向发放），转赠不重复计算
+    5: i64 unused_count,
+    6: i64 locked_count,
+    7: i64 used_count,
+    8: i64 expired_count,
+    9: double redemption_rate,  // 核销率 = used_count / issued_count
+    10: double discount_amount, // 累计优惠金额
+    11: double gmv_amount,      // 使用该券的订单总金额
+    12: i64 refreshed_at        // 统计刷新时间
+}
+
+// 按天、按景点的统计，spot_id=0 的行记录发放与过期（与景点无关）
+struct CouponDailyStat {
+    1: string stat_date,
+    2: i64 spot_id,
+    3: i64 claimed_count,
+    4: i64 used_count,
+    5: i64 expired_count,
+    6: double discount_amount,
+    7: double gmv_amount
+}
+
+// 查询区间内按景点汇总的使用统计
+struct CouponSpotStat {
+    1: i64 spot_id,
+    2: string spot_name,
+    3: i64 used_count,
+    4: double discount_amount,
+    5: double gmv_amount
+}
+
+struct GetCouponStatsResp {
+    1: BaseResp base,
+    2: CouponStatsSummary summary,
+    3: list<CouponDailyStat> daily,
+    4: list<CouponSpotStat> spots
+}
+
+// 创建转赠链接，仅未使用且规则允许转赠的券可转赠；同一张券重新创建会使之前的链接失效
+struct CreateCouponTransferReq {
+    1: i64 user_id,
+    2: i64 user_coupon_id
+}
+
+struct CreateCouponTransferResp {
+    1: BaseResp base,
+    2: string token,
+    3: i64 expire_at
+}
+
+// 受赠人通过转赠链接领取
+struct AcceptCouponTransferReq {
+    1: i64 user_id,
+    2: string token
+}
+
+struct AcceptCouponTransferResp {
+    1: BaseResp base,
+    2: i64 user_coupon_id
+}
+
+// 转赠人取消未被领取的转赠链接
+struct CancelCouponTransferReq {
+    1: i64 user_id,
+    2: string token
+}
+
+struct CancelCouponTransferResp {
+    1: BaseResp base
+}
+
+// 转赠记录
+struct CouponTransferInfo {
+    1: i64 id,
+    2: i64 user_coupon_id,
+    3: i64 coupon_id,
+    4: i64 from_user_id,
+    5: i64 to_user_id,
+    6: i64 received_user_coupon_id,  // 受赠人获得的用户优惠券ID
+    7: string transfer_status,  // PENDING/CLAIMED/CANCELLED/EXPIRED
+    8: i64 expire_at,
+    9: i64 claimed_at,
+    10: i64 cancelled_at,
+    11: i64 created_at
+}
+
+// 查询用户转出与收到的转赠记录，指定 user_coupon_id 时只返回该券转出及转入的记录
+struct ListCouponTransfersReq {
+    1: i64 user_id,
+    2: i64 user_coupon_id,
+    3: i32 page,
+    4: i32 page_size
+}
+
+struct ListCouponTransfersResp {
+    1: BaseResp base,
+    2: list<CouponTransferInfo> transfers,
+    3: i64 total
 }
 
-// Kitex必须的Service定义（仅占位，无业务逻辑）
 service CouponService {
-    BaseResp Test(1: EmptyReq req)
-}
\ No newline at end of file
+    CreateCouponResp CreateCoupon(1: CreateCouponReq req)
+    UpdateCouponResp UpdateCoupon(1: UpdateCouponReq req)
+    GetCouponResp GetCoupon(1: GetCouponReq req)
+    ListCouponsResp ListCoupons(1: ListCouponsReq req)
+    InvalidateCouponResp InvalidateCoupon(1: InvalidateCouponReq req)
+    ClaimCouponResp ClaimCoupon(1: ClaimCouponReq req)
+    GetClaimStatusResp GetClaimStatus(1: GetClaimStatusReq req)
+    PreviewOrderPriceResp PreviewOrderPrice(1: PreviewOrderPriceReq req)
+    RecommendCouponsResp RecommendCoupons(1: RecommendCouponsReq req)
+    LockCouponResp LockCoupon(1: LockCouponReq req)
+    ConsumeCouponResp ConsumeCoupon(1: ConsumeCouponReq req)
+    ReleaseCouponResp ReleaseCoupon(1: ReleaseCouponReq req)
+    GenerateCodesResp GenerateCodes(1: GenerateCodesReq req)
+    RedeemCodeResp RedeemCode(1: RedeemCodeReq req)
+    ExportCodeBatchResp ExportCodeBatch(1: ExportCodeBatchReq req)
+    RevokeCodeBatchResp RevokeCodeBatch(1: RevokeCodeBatchReq req)
+    ListCouponsForSpotResp ListCouponsForSpot(1: ListCouponsForSpotReq req)
+    CreateSegmentResp CreateSegment(1: CreateSegmentReq req)
+    DistributeCouponResp DistributeCoupon(1: DistributeCouponReq req)
+    GetDistributionResp GetDistribution(1: GetDistributionReq req)
+    ResumeDistributionResp ResumeDistribution(1: ResumeDistributionReq req)
+    GetCouponStatsResp GetCouponStats(1: GetCouponStatsReq req)
+    CreateCouponTransferResp CreateCouponTransfer(1: CreateCouponTransferReq req)
+    AcceptCouponTransferResp AcceptCouponTransfer(1: AcceptCouponTransferReq req)
+    CancelCouponTransferResp CancelCouponTransfer(1: CancelCouponTransferReq req)
+    ListCouponTransfersResp ListCouponTransfers(1: ListCouponTransfersReq req)
+}
@@ -0,0 +1,182 @@
+namespace go order
+
+// 通用响应体
+struct BaseResp {
+    1: i32 code,
+    2: string msg
+}
+
+// 下单门票：一种门票及其出行人，每位出行人一张票
+struct OrderTicketReq {
+    1: i64 ticket_type_id,
+    2: list<i64> traveler_ids
+}
+
+// 创建订单，门票需属于同一景点
+struct CreateOrderReq {
+    1: i64 user_id,
+    2: list<OrderTicketReq> tickets,
+    3: list<i64> user_coupon_ids,  // 下单同时锁定的用户优惠券，可为空
+    4: string pay_type,            // 预选支付方式：WECHAT/ALIPAY，使用限定支付方式的优惠券时必填
+    5: string visit_date           // 游玩日期，格式 2006-01-02，为空表示门票有效期内任意一天
+}
+
+// 订单明细，每位出行人一条
+struct OrderItemInfo {
+    1: i64 id,
+    2: i64 ticket_type_id,
+    3: i64 traveler_id,
+    4: string ticket_name,
+    5: double single_price,
+    6: i32 ticket_num,
+    7: double pay_amount,     // 实付小计（已分摊优惠）
+    8: string item_status,    // UNUSED/VERIFIED/REFUNDED
+    9: i64 verify_time,
+    10: double refund_amount,
+    11: i64 refund_time
+}
+
+// 订单详情，时间字段均为秒级时间戳
+struct OrderInfo {
+    1: i64 id,
+    2: string order_no,
+    3: i64 user_id,
+    4: i64 merchant_id,
+    5: i64 spot_id,
+    6: double total_amount,
+    7: double pay_amount,
+    8: i64 coupon_id,
+    9: string order_status,
+    10: string pay_type,
+    11: i64 created_at,
+    12: list<OrderItemInfo> items,
+    13: i64 pay_time,
+    14: i64 cancel_time,
+    15: i64 verify_time,
+    16: i64 refund_time,
+    17: string visit_date,
+    18: i32 reschedule_count
+}
+
+// 订单状态流转记录
+struct OrderStatusLogInfo {
+    1: string from_status,
+    2: string to_status,
+    3: string operator,
+    4: string reason,
+    5: i64 created_at
+}
+
+struct CreateOrderResp {
+    1: BaseResp base,
+    2: OrderInfo order
+}
+
+struct GetOrderReq {
+    1: i64 user_id,
+    2: i64 order_id
+}
+
+struct GetOrderResp {
+    1: BaseResp base,
+    2: OrderInfo order,
+    3: list<OrderStatusLogInfo> status_logs
+}
+
+// 支付回调：订单由待支付变为已支付，重复回调幂等
+struct PayOrderReq {
+    1: i64 order_id,
+    2: string pay_type,
+    3: double pay_amount,
+    4: string platform_trade_no
+}
+
+struct PayOrderResp {
+    1: BaseResp base
+}
+
+// 用户取消待支付订单，回补库存并释放优惠券
+struct CancelOrderReq {
+    1: i64 user_id,
+    2: i64 order_id,
+    3: string reason
+}
+
+struct CancelOrderResp {
+    1: BaseResp base
+}
+
+// 申请退款：按门票退改规则计算手续费，item_ids 为空时退订单内全部待核销出行人，退款原路退回
+struct ApplyRefundReq {
+    1: i64 user_id,
+    2: i64 order_id,
+    3: list<i64> item_ids,
+    4: string reason
+}
+
+// 单个出行人的退款计算
+struct RefundItemInfo {
+    1: i64 item_id,
+    2: double pay_amount,     // 实付小计
+    3: i32 fee_percent,       // 手续费比例
+    4: double fee_amount,
+    5: double refund_amount
+}
+
+struct ApplyRefundResp {
+    1: BaseResp base,
+    2: double refund_amount,
+    3: double fee_amount,
+    4: string refund_no,
+    5: list<RefundItemInfo> items,
+    6: string order_status     // 退款后的订单状态
+}
+
+// 改期的门票变更：订单内该门票的待核销出行人全部改为新门票
+struct RescheduleTicket {
+    1: i64 from_ticket_type_id,
+    2: i64 to_ticket_type_id
+}
+
+// 改期：变更游玩日期和/或同景点的门票，次数受门票退改规则限制
+// 需补差价时生成待补差价的改期记录，由 PayReschedule 支付回调完成改期；差价为负时原路退回
+struct RescheduleOrderReq {
+    1: i64 user_id,
+    2: i64 order_id,
+    3: string visit_date,                 // 新游玩日期，格式 2006-01-02，为空表示不变
+    4: list<RescheduleTicket> tickets,    // 门票变更，可为空
+    5: bool preview                       // 仅计算差价，不执行改期
+}
+
+struct RescheduleOrderResp {
+    1: BaseResp base,
+    2: double price_diff,      // 实付差价：正数需补款，负数为退款
+    3: string refund_no,       // 差价退款单号
+    4: OrderInfo order,
+    5: i32 reschedule_left,    // 剩余可改期次数
+    6: i64 reschedule_id,      // 待补差价的改期记录ID，支付差价时使用
+    7: bool pending_pay        // 是否待补差价，补款到账后才完成改期
+}
+
+// 改期补差价支付回调：校验金额后按改期记录执行改期，同一流水重复回调幂等
+struct PayRescheduleReq {
+    1: i64 reschedule_id,
+    2: string pay_type,
+    3: double pay_amount,
+    4: string platform_trade_no
+}
+
+struct PayRescheduleResp {
+    1: BaseResp base,
+    2: OrderInfo order
+}
+
+service OrderService {
+    CreateOrderResp CreateOrder(1: CreateOrderReq req)
+    GetOrderResp GetOrder(1: GetOrderReq req)
+    PayOrderResp PayOrder(1: PayOrderReq req)
+    CancelOrderResp CancelOrder(1: CancelOrderReq req)
+    ApplyRefundResp ApplyRefund(1: ApplyRefundReq req)
+    RescheduleOrderResp RescheduleOrder(1: RescheduleOrderReq req)
+    PayRescheduleResp PayReschedule(1: PayRescheduleReq req)
+}
@@ -0,0 +1,158 @@
+namespace go verify
+
+// 通用响应体
+struct BaseResp {
+    1: i32 code,
+    2: string msg
+}
+
+// 用户获取已支付订单的核销二维码，过期后需重新获取
+// 指定 item_id 时为该出行人的二维码，仅核销该出行人；否则核销订单内全部待核销出行人
+struct GetVerifyQRCodeReq {
+    1: i64 user_id,
+    2: i64 order_id,
+    3: i64 item_id
+}
+
+struct GetVerifyQRCodeResp {
+    1: BaseResp base,
+    2: string qr_content,  // 二维码内容，含签名与过期时间
+    3: i64 expire_at       // 过期时间，秒级时间戳
+}
+
+// 闸机扫码核销，每位出行人只能核销一次，全部核销后订单变为已核销
+// 闸机请求均需携带签名，算法见 common/gatesign，核销时签名内容为 qr_content
+struct VerifyTicketReq {
+    1: string device_no,
+    2: string qr_content,
+    3: i64 timestamp,    // 请求时间，秒级时间戳
+    4: string signature  // 设备密钥签名
+}
+
+// 核销的门票明细
+struct VerifiedItem {
+    1: i64 item_id,
+    2: string ticket_name,
+    3: i64 traveler_id,
+    4: string traveler_name  // 脱敏后的出行人姓名，供人工核对
+}
+
+struct VerifyTicketResp {
+    1: BaseResp base,
+    2: i64 order_id,
+    3: string order_no,
+    4: i64 verify_time,
+    5: list<VerifiedItem> items,  // 本次核销的出行人
+    6: i32 remaining              // 订单内剩余待核销人数
+}
+
+// 商家登记检票闸机，闸机只能核销所在景点的门票
+struct RegisterGateDeviceReq {
+    1: i64 merchant_id,
+    2: i64 spot_id,
+    3: string device_no,
+    4: string device_name
+}
+
+struct RegisterGateDeviceResp {
+    1: BaseResp base,
+    2: i64 device_id,
+    3: string whitelist_public_key,  // 离线白名单验签公钥（base64），预置到闸机
+    4: string device_secret          // 设备密钥，仅在登记或重置时返回，预置到闸机用于请求签名
+}
+
+// 商家启用或停用检票闸机
+struct SetGateDeviceStatusReq {
+    1: i64 merchant_id,
+    2: string device_no,
+    3: bool enabled
+}
+
+struct SetGateDeviceStatusResp {
+    1: BaseResp base
+}
+
+// 商家重置闸机设备密钥，旧密钥立即失效，用于密钥泄露或设备更换
+struct ResetGateDeviceSecretReq {
+    1: i64 merchant_id,
+    2: string device_no
+}
+
+struct ResetGateDeviceSecretResp {
+    1: BaseResp base,
+    2: string device_secret
+}
+
+// 闸机下载所在景点指定日期的离线核销白名单
+struct GetOfflineWhitelistReq {
+    1: string device_no,
+    2: string date,       // 格式 2006-01-02，为空表示当天
+    3: i64 timestamp,     // 请求时间，秒级时间戳
+    4: string signature   // 设备密钥签名，签名内容为 date
+}
+
+struct GetOfflineWhitelistResp {
+    1: BaseResp base,
+    2: string bundle,     // 签名白名单，格式见 common/whitelist，核销码摘要密钥为设备密钥
+    3: i32 entry_count,
+    4: i64 generated_at
+}
+
+// 闸机离线核销记录
+struct OfflineVerifyRecord {
+    1: string verify_code,
+    2: i64 verified_at  // 闸机本地核销时间，秒级时间戳
+}
+
+// 闸机恢复网络后批量上传离线核销记录，同一记录重复上传幂等
+struct UploadOfflineVerificationsReq {
+    1: string device_no,
+    2: list<OfflineVerifyRecord> records,
+    3: i64 timestamp,     // 请求时间，秒级时间戳
+    4: string signature   // 设备密钥签名，签名内容为按上传顺序以逗号连接的“核销码:核销时间戳”
+}
+
+// 对账异常：冲突或无效的离线核销
+struct OfflineVerifyException {
+    1: string verify_code,
+    2: i64 verified_at,
+    3: string device_no,
+    4: i64 order_id,
+    5: string outcome,  // 对账结果：CONFLICT/REJECTED
+    6: string reason
+}
+
+struct UploadOfflineVerificationsResp {
+    1: BaseResp base,
+    2: i32 applied,
+    3: i32 duplicated,
+    4: list<OfflineVerifyException> exceptions
+}
+
+// 商家查询景点离线核销对账异常报告
+struct GetOfflineVerifyReportReq {
+    1: i64 merchant_id,
+    2: i64 spot_id,
+    3: string start_date,  // 格式 2006-01-02，为空表示当天
+    4: string end_date
+}
+
+struct GetOfflineVerifyReportResp {
+    1: BaseResp base,
+    2: i32 applied,
+    3: i32 duplicated,
+    4: i32 conflicts,
+    5: i32 rejected,
+    6: list<OfflineVerifyException> exceptions  // 冲突与无效记录，按核销时间排序
+}
+
+service VerifyService {
+    GetVerifyQRCodeResp GetVerifyQRCode(1: GetVerifyQRCodeReq req)
+    VerifyTicketResp VerifyTicket(1: VerifyTicketReq req)
+    RegisterGateDeviceResp RegisterGateDevice(1: RegisterGateDeviceReq req)
+    SetGateDeviceStatusResp SetGateDeviceStatus(1: SetGateDeviceStatusReq req)
+    ResetGateDeviceSecretResp ResetGateDeviceSecret(1: ResetGateDeviceSecretReq req)
+    GetOfflineWhitelistResp GetOfflineWhitelist(1: GetOfflineWhitelistReq req)
+    UploadOfflineVerificationsResp UploadOfflineVerifications(1: UploadOfflineVerificationsReq req)
+    GetOfflineVerifyReportResp GetOfflineVerifyReport(1: GetOfflineVerifyReportReq req)
+}
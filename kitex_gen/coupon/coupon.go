@@ -45,111 +45,5949 @@ var fieldIDToName_BaseResp = map[int16]string{
 	2: "msg",
 }
 
-type EmptyReq struct {
-	Id int64 `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+type CouponScopeInfo struct {
+	ScopeType    string `thrift:"scope_type,1" frugal:"1,default,string" json:"scope_type"`
+	SpotId       int64  `thrift:"spot_id,2" frugal:"2,default,i64" json:"spot_id"`
+	TicketTypeId int64  `thrift:"ticket_type_id,3" frugal:"3,default,i64" json:"ticket_type_id"`
+	Province     string `thrift:"province,4" frugal:"4,default,string" json:"province"`
+	City         string `thrift:"city,5" frugal:"5,default,string" json:"city"`
 }
 
-func NewEmptyReq() *EmptyReq {
-	return &EmptyReq{}
+func NewCouponScopeInfo() *CouponScopeInfo {
+	return &CouponScopeInfo{}
 }
 
-func (p *EmptyReq) InitDefault() {
+func (p *CouponScopeInfo) InitDefault() {
 }
 
-func (p *EmptyReq) GetId() (v int64) {
+func (p *CouponScopeInfo) GetScopeType() (v string) {
+	return p.ScopeType
+}
+
+func (p *CouponScopeInfo) GetSpotId() (v int64) {
+	return p.SpotId
+}
+
+func (p *CouponScopeInfo) GetTicketTypeId() (v int64) {
+	return p.TicketTypeId
+}
+
+func (p *CouponScopeInfo) GetProvince() (v string) {
+	return p.Province
+}
+
+func (p *CouponScopeInfo) GetCity() (v string) {
+	return p.City
+}
+func (p *CouponScopeInfo) SetScopeType(val string) {
+	p.ScopeType = val
+}
+func (p *CouponScopeInfo) SetSpotId(val int64) {
+	p.SpotId = val
+}
+func (p *CouponScopeInfo) SetTicketTypeId(val int64) {
+	p.TicketTypeId = val
+}
+func (p *CouponScopeInfo) SetProvince(val string) {
+	p.Province = val
+}
+func (p *CouponScopeInfo) SetCity(val string) {
+	p.City = val
+}
+
+func (p *CouponScopeInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponScopeInfo(%+v)", *p)
+}
+
+var fieldIDToName_CouponScopeInfo = map[int16]string{
+	1: "scope_type",
+	2: "spot_id",
+	3: "ticket_type_id",
+	4: "province",
+	5: "city",
+}
+
+type CouponInfo struct {
+	Id             int64              `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	CouponName     string             `thrift:"coupon_name,2" frugal:"2,default,string" json:"coupon_name"`
+	CouponType     string             `thrift:"coupon_type,3" frugal:"3,default,string" json:"coupon_type"`
+	Denomination   float64            `thrift:"denomination,4" frugal:"4,default,double" json:"denomination"`
+	MinUseAmount   float64            `thrift:"min_use_amount,5" frugal:"5,default,double" json:"min_use_amount"`
+	ValidStartTime int64              `thrift:"valid_start_time,6" frugal:"6,default,i64" json:"valid_start_time"`
+	ValidEndTime   int64              `thrift:"valid_end_time,7" frugal:"7,default,i64" json:"valid_end_time"`
+	Stock          int32              `thrift:"stock,8" frugal:"8,default,i32" json:"stock"`
+	ApplySpotIds   string             `thrift:"apply_spot_ids,9" frugal:"9,default,string" json:"apply_spot_ids"`
+	CouponStatus   string             `thrift:"coupon_status,10" frugal:"10,default,string" json:"coupon_status"`
+	ExtFields      string             `thrift:"ext_fields,11" frugal:"11,default,string" json:"ext_fields"`
+	CreatedAt      int64              `thrift:"created_at,12" frugal:"12,default,i64" json:"created_at"`
+	UpdatedAt      int64              `thrift:"updated_at,13" frugal:"13,default,i64" json:"updated_at"`
+	IssuerType     string             `thrift:"issuer_type,14" frugal:"14,default,string" json:"issuer_type"`
+	MerchantId     int64              `thrift:"merchant_id,15" frugal:"15,default,i64" json:"merchant_id"`
+	StackGroup     string             `thrift:"stack_group,16" frugal:"16,default,string" json:"stack_group"`
+	Priority       int32              `thrift:"priority,17" frugal:"17,default,i32" json:"priority"`
+	Scopes         []*CouponScopeInfo `thrift:"scopes,18" frugal:"18,default,list<CouponScopeInfo>" json:"scopes"`
+}
+
+func NewCouponInfo() *CouponInfo {
+	return &CouponInfo{}
+}
+
+func (p *CouponInfo) InitDefault() {
+}
+
+func (p *CouponInfo) GetId() (v int64) {
 	return p.Id
 }
-func (p *EmptyReq) SetId(val int64) {
+
+func (p *CouponInfo) GetCouponName() (v string) {
+	return p.CouponName
+}
+
+func (p *CouponInfo) GetCouponType() (v string) {
+	return p.CouponType
+}
+
+func (p *CouponInfo) GetDenomination() (v float64) {
+	return p.Denomination
+}
+
+func (p *CouponInfo) GetMinUseAmount() (v float64) {
+	return p.MinUseAmount
+}
+
+func (p *CouponInfo) GetValidStartTime() (v int64) {
+	return p.ValidStartTime
+}
+
+func (p *CouponInfo) GetValidEndTime() (v int64) {
+	return p.ValidEndTime
+}
+
+func (p *CouponInfo) GetStock() (v int32) {
+	return p.Stock
+}
+
+func (p *CouponInfo) GetApplySpotIds() (v string) {
+	return p.ApplySpotIds
+}
+
+func (p *CouponInfo) GetCouponStatus() (v string) {
+	return p.CouponStatus
+}
+
+func (p *CouponInfo) GetExtFields() (v string) {
+	return p.ExtFields
+}
+
+func (p *CouponInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *CouponInfo) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
+}
+
+func (p *CouponInfo) GetIssuerType() (v string) {
+	return p.IssuerType
+}
+
+func (p *CouponInfo) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *CouponInfo) GetStackGroup() (v string) {
+	return p.StackGroup
+}
+
+func (p *CouponInfo) GetPriority() (v int32) {
+	return p.Priority
+}
+
+func (p *CouponInfo) GetScopes() (v []*CouponScopeInfo) {
+	return p.Scopes
+}
+func (p *CouponInfo) SetId(val int64) {
 	p.Id = val
 }
+func (p *CouponInfo) SetCouponName(val string) {
+	p.CouponName = val
+}
+func (p *CouponInfo) SetCouponType(val string) {
+	p.CouponType = val
+}
+func (p *CouponInfo) SetDenomination(val float64) {
+	p.Denomination = val
+}
+func (p *CouponInfo) SetMinUseAmount(val float64) {
+	p.MinUseAmount = val
+}
+func (p *CouponInfo) SetValidStartTime(val int64) {
+	p.ValidStartTime = val
+}
+func (p *CouponInfo) SetValidEndTime(val int64) {
+	p.ValidEndTime = val
+}
+func (p *CouponInfo) SetStock(val int32) {
+	p.Stock = val
+}
+func (p *CouponInfo) SetApplySpotIds(val string) {
+	p.ApplySpotIds = val
+}
+func (p *CouponInfo) SetCouponStatus(val string) {
+	p.CouponStatus = val
+}
+func (p *CouponInfo) SetExtFields(val string) {
+	p.ExtFields = val
+}
+func (p *CouponInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *CouponInfo) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
+}
+func (p *CouponInfo) SetIssuerType(val string) {
+	p.IssuerType = val
+}
+func (p *CouponInfo) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *CouponInfo) SetStackGroup(val string) {
+	p.StackGroup = val
+}
+func (p *CouponInfo) SetPriority(val int32) {
+	p.Priority = val
+}
+func (p *CouponInfo) SetScopes(val []*CouponScopeInfo) {
+	p.Scopes = val
+}
 
-func (p *EmptyReq) String() string {
+func (p *CouponInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("EmptyReq(%+v)", *p)
+	return fmt.Sprintf("CouponInfo(%+v)", *p)
 }
 
-var fieldIDToName_EmptyReq = map[int16]string{
-	1: "id",
+var fieldIDToName_CouponInfo = map[int16]string{
+	1:  "id",
+	2:  "coupon_name",
+	3:  "coupon_type",
+	4:  "denomination",
+	5:  "min_use_amount",
+	6:  "valid_start_time",
+	7:  "valid_end_time",
+	8:  "stock",
+	9:  "apply_spot_ids",
+	10: "coupon_status",
+	11: "ext_fields",
+	12: "created_at",
+	13: "updated_at",
+	14: "issuer_type",
+	15: "merchant_id",
+	16: "stack_group",
+	17: "priority",
+	18: "scopes",
 }
 
-type CouponService interface {
-	Test(ctx context.Context, req *EmptyReq) (r *BaseResp, err error)
+type CreateCouponReq struct {
+	CouponName     string             `thrift:"coupon_name,1" frugal:"1,default,string" json:"coupon_name"`
+	CouponType     string             `thrift:"coupon_type,2" frugal:"2,default,string" json:"coupon_type"`
+	Denomination   float64            `thrift:"denomination,3" frugal:"3,default,double" json:"denomination"`
+	MinUseAmount   float64            `thrift:"min_use_amount,4" frugal:"4,default,double" json:"min_use_amount"`
+	ValidStartTime int64              `thrift:"valid_start_time,5" frugal:"5,default,i64" json:"valid_start_time"`
+	ValidEndTime   int64              `thrift:"valid_end_time,6" frugal:"6,default,i64" json:"valid_end_time"`
+	Stock          int32              `thrift:"stock,7" frugal:"7,default,i32" json:"stock"`
+	ApplySpotIds   string             `thrift:"apply_spot_ids,8" frugal:"8,default,string" json:"apply_spot_ids"`
+	ExtFields      string             `thrift:"ext_fields,9" frugal:"9,default,string" json:"ext_fields"`
+	IssuerType     string             `thrift:"issuer_type,10" frugal:"10,default,string" json:"issuer_type"`
+	MerchantId     int64              `thrift:"merchant_id,11" frugal:"11,default,i64" json:"merchant_id"`
+	StackGroup     string             `thrift:"stack_group,12" frugal:"12,default,string" json:"stack_group"`
+	Priority       int32              `thrift:"priority,13" frugal:"13,default,i32" json:"priority"`
+	Scopes         []*CouponScopeInfo `thrift:"scopes,14" frugal:"14,default,list<CouponScopeInfo>" json:"scopes"`
+}
+
+func NewCreateCouponReq() *CreateCouponReq {
+	return &CreateCouponReq{}
+}
+
+func (p *CreateCouponReq) InitDefault() {
+}
+
+func (p *CreateCouponReq) GetCouponName() (v string) {
+	return p.CouponName
+}
+
+func (p *CreateCouponReq) GetCouponType() (v string) {
+	return p.CouponType
+}
+
+func (p *CreateCouponReq) GetDenomination() (v float64) {
+	return p.Denomination
+}
+
+func (p *CreateCouponReq) GetMinUseAmount() (v float64) {
+	return p.MinUseAmount
+}
+
+func (p *CreateCouponReq) GetValidStartTime() (v int64) {
+	return p.ValidStartTime
+}
+
+func (p *CreateCouponReq) GetValidEndTime() (v int64) {
+	return p.ValidEndTime
+}
+
+func (p *CreateCouponReq) GetStock() (v int32) {
+	return p.Stock
+}
+
+func (p *CreateCouponReq) GetApplySpotIds() (v string) {
+	return p.ApplySpotIds
+}
+
+func (p *CreateCouponReq) GetExtFields() (v string) {
+	return p.ExtFields
+}
+
+func (p *CreateCouponReq) GetIssuerType() (v string) {
+	return p.IssuerType
+}
+
+func (p *CreateCouponReq) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *CreateCouponReq) GetStackGroup() (v string) {
+	return p.StackGroup
+}
+
+func (p *CreateCouponReq) GetPriority() (v int32) {
+	return p.Priority
+}
+
+func (p *CreateCouponReq) GetScopes() (v []*CouponScopeInfo) {
+	return p.Scopes
+}
+func (p *CreateCouponReq) SetCouponName(val string) {
+	p.CouponName = val
+}
+func (p *CreateCouponReq) SetCouponType(val string) {
+	p.CouponType = val
+}
+func (p *CreateCouponReq) SetDenomination(val float64) {
+	p.Denomination = val
+}
+func (p *CreateCouponReq) SetMinUseAmount(val float64) {
+	p.MinUseAmount = val
+}
+func (p *CreateCouponReq) SetValidStartTime(val int64) {
+	p.ValidStartTime = val
+}
+func (p *CreateCouponReq) SetValidEndTime(val int64) {
+	p.ValidEndTime = val
+}
+func (p *CreateCouponReq) SetStock(val int32) {
+	p.Stock = val
+}
+func (p *CreateCouponReq) SetApplySpotIds(val string) {
+	p.ApplySpotIds = val
+}
+func (p *CreateCouponReq) SetExtFields(val string) {
+	p.ExtFields = val
+}
+func (p *CreateCouponReq) SetIssuerType(val string) {
+	p.IssuerType = val
+}
+func (p *CreateCouponReq) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *CreateCouponReq) SetStackGroup(val string) {
+	p.StackGroup = val
+}
+func (p *CreateCouponReq) SetPriority(val int32) {
+	p.Priority = val
+}
+func (p *CreateCouponReq) SetScopes(val []*CouponScopeInfo) {
+	p.Scopes = val
+}
+
+func (p *CreateCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateCouponReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateCouponReq = map[int16]string{
+	1:  "coupon_name",
+	2:  "coupon_type",
+	3:  "denomination",
+	4:  "min_use_amount",
+	5:  "valid_start_time",
+	6:  "valid_end_time",
+	7:  "stock",
+	8:  "apply_spot_ids",
+	9:  "ext_fields",
+	10: "issuer_type",
+	11: "merchant_id",
+	12: "stack_group",
+	13: "priority",
+	14: "scopes",
+}
+
+type CreateCouponResp struct {
+	Base     *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	CouponId int64     `thrift:"coupon_id,2" frugal:"2,default,i64" json:"coupon_id"`
+}
+
+func NewCreateCouponResp() *CreateCouponResp {
+	return &CreateCouponResp{}
+}
+
+func (p *CreateCouponResp) InitDefault() {
+}
+
+var CreateCouponResp_Base_DEFAULT *BaseResp
+
+func (p *CreateCouponResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return CreateCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CreateCouponResp) GetCouponId() (v int64) {
+	return p.CouponId
+}
+func (p *CreateCouponResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *CreateCouponResp) SetCouponId(val int64) {
+	p.CouponId = val
+}
+
+func (p *CreateCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateCouponResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateCouponResp = map[int16]string{
+	1: "base",
+	2: "coupon_id",
+}
+
+type UpdateCouponReq struct {
+	CouponId           int64              `thrift:"coupon_id,1" frugal:"1,default,i64" json:"coupon_id"`
+	CouponName         *string            `thrift:"coupon_name,2,optional" frugal:"2,optional,string" json:"coupon_name,omitempty"`
+	CouponType         *string            `thrift:"coupon_type,3,optional" frugal:"3,optional,string" json:"coupon_type,omitempty"`
+	Denomination       *float64           `thrift:"denomination,4,optional" frugal:"4,optional,double" json:"denomination,omitempty"`
+	MinUseAmount       *float64           `thrift:"min_use_amount,5,optional" frugal:"5,optional,double" json:"min_use_amount,omitempty"`
+	ValidStartTime     *int64             `thrift:"valid_start_time,6,optional" frugal:"6,optional,i64" json:"valid_start_time,omitempty"`
+	ValidEndTime       *int64             `thrift:"valid_end_time,7,optional" frugal:"7,optional,i64" json:"valid_end_time,omitempty"`
+	Stock              *int32             `thrift:"stock,8,optional" frugal:"8,optional,i32" json:"stock,omitempty"`
+	ApplySpotIds       *string            `thrift:"apply_spot_ids,9,optional" frugal:"9,optional,string" json:"apply_spot_ids,omitempty"`
+	ExtFields          *string            `thrift:"ext_fields,10,optional" frugal:"10,optional,string" json:"ext_fields,omitempty"`
+	StackGroup         *string            `thrift:"stack_group,11,optional" frugal:"11,optional,string" json:"stack_group,omitempty"`
+	Priority           *int32             `thrift:"priority,12,optional" frugal:"12,optional,i32" json:"priority,omitempty"`
+	OperatorMerchantId int64              `thrift:"operator_merchant_id,13" frugal:"13,default,i64" json:"operator_merchant_id"`
+	Scopes             []*CouponScopeInfo `thrift:"scopes,14,optional" frugal:"14,optional,list<CouponScopeInfo>" json:"scopes,omitempty"`
+}
+
+func NewUpdateCouponReq() *UpdateCouponReq {
+	return &UpdateCouponReq{}
+}
+
+func (p *UpdateCouponReq) InitDefault() {
+}
+
+func (p *UpdateCouponReq) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+var UpdateCouponReq_CouponName_DEFAULT string
+
+func (p *UpdateCouponReq) GetCouponName() (v string) {
+	if !p.IsSetCouponName() {
+		return UpdateCouponReq_CouponName_DEFAULT
+	}
+	return *p.CouponName
+}
+
+var UpdateCouponReq_CouponType_DEFAULT string
+
+func (p *UpdateCouponReq) GetCouponType() (v string) {
+	if !p.IsSetCouponType() {
+		return UpdateCouponReq_CouponType_DEFAULT
+	}
+	return *p.CouponType
+}
+
+var UpdateCouponReq_Denomination_DEFAULT float64
+
+func (p *UpdateCouponReq) GetDenomination() (v float64) {
+	if !p.IsSetDenomination() {
+		return UpdateCouponReq_Denomination_DEFAULT
+	}
+	return *p.Denomination
+}
+
+var UpdateCouponReq_MinUseAmount_DEFAULT float64
+
+func (p *UpdateCouponReq) GetMinUseAmount() (v float64) {
+	if !p.IsSetMinUseAmount() {
+		return UpdateCouponReq_MinUseAmount_DEFAULT
+	}
+	return *p.MinUseAmount
+}
+
+var UpdateCouponReq_ValidStartTime_DEFAULT int64
+
+func (p *UpdateCouponReq) GetValidStartTime() (v int64) {
+	if !p.IsSetValidStartTime() {
+		return UpdateCouponReq_ValidStartTime_DEFAULT
+	}
+	return *p.ValidStartTime
+}
+
+var UpdateCouponReq_ValidEndTime_DEFAULT int64
+
+func (p *UpdateCouponReq) GetValidEndTime() (v int64) {
+	if !p.IsSetValidEndTime() {
+		return UpdateCouponReq_ValidEndTime_DEFAULT
+	}
+	return *p.ValidEndTime
+}
+
+var UpdateCouponReq_Stock_DEFAULT int32
+
+func (p *UpdateCouponReq) GetStock() (v int32) {
+	if !p.IsSetStock() {
+		return UpdateCouponReq_Stock_DEFAULT
+	}
+	return *p.Stock
+}
+
+var UpdateCouponReq_ApplySpotIds_DEFAULT string
+
+func (p *UpdateCouponReq) GetApplySpotIds() (v string) {
+	if !p.IsSetApplySpotIds() {
+		return UpdateCouponReq_ApplySpotIds_DEFAULT
+	}
+	return *p.ApplySpotIds
+}
+
+var UpdateCouponReq_ExtFields_DEFAULT string
+
+func (p *UpdateCouponReq) GetExtFields() (v string) {
+	if !p.IsSetExtFields() {
+		return UpdateCouponReq_ExtFields_DEFAULT
+	}
+	return *p.ExtFields
+}
+
+var UpdateCouponReq_StackGroup_DEFAULT string
+
+func (p *UpdateCouponReq) GetStackGroup() (v string) {
+	if !p.IsSetStackGroup() {
+		return UpdateCouponReq_StackGroup_DEFAULT
+	}
+	return *p.StackGroup
+}
+
+var UpdateCouponReq_Priority_DEFAULT int32
+
+func (p *UpdateCouponReq) GetPriority() (v int32) {
+	if !p.IsSetPriority() {
+		return UpdateCouponReq_Priority_DEFAULT
+	}
+	return *p.Priority
+}
+
+func (p *UpdateCouponReq) GetOperatorMerchantId() (v int64) {
+	return p.OperatorMerchantId
+}
+
+var UpdateCouponReq_Scopes_DEFAULT []*CouponScopeInfo
+
+func (p *UpdateCouponReq) GetScopes() (v []*CouponScopeInfo) {
+	if !p.IsSetScopes() {
+		return UpdateCouponReq_Scopes_DEFAULT
+	}
+	return p.Scopes
+}
+func (p *UpdateCouponReq) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *UpdateCouponReq) SetCouponName(val *string) {
+	p.CouponName = val
+}
+func (p *UpdateCouponReq) SetCouponType(val *string) {
+	p.CouponType = val
+}
+func (p *UpdateCouponReq) SetDenomination(val *float64) {
+	p.Denomination = val
+}
+func (p *UpdateCouponReq) SetMinUseAmount(val *float64) {
+	p.MinUseAmount = val
+}
+func (p *UpdateCouponReq) SetValidStartTime(val *int64) {
+	p.ValidStartTime = val
+}
+func (p *UpdateCouponReq) SetValidEndTime(val *int64) {
+	p.ValidEndTime = val
+}
+func (p *UpdateCouponReq) SetStock(val *int32) {
+	p.Stock = val
+}
+func (p *UpdateCouponReq) SetApplySpotIds(val *string) {
+	p.ApplySpotIds = val
+}
+func (p *UpdateCouponReq) SetExtFields(val *string) {
+	p.ExtFields = val
+}
+func (p *UpdateCouponReq) SetStackGroup(val *string) {
+	p.StackGroup = val
+}
+func (p *UpdateCouponReq) SetPriority(val *int32) {
+	p.Priority = val
+}
+func (p *UpdateCouponReq) SetOperatorMerchantId(val int64) {
+	p.OperatorMerchantId = val
+}
+func (p *UpdateCouponReq) SetScopes(val []*CouponScopeInfo) {
+	p.Scopes = val
+}
+
+func (p *UpdateCouponReq) IsSetCouponName() bool {
+	return p.CouponName != nil
+}
+
+func (p *UpdateCouponReq) IsSetCouponType() bool {
+	return p.CouponType != nil
+}
+
+func (p *UpdateCouponReq) IsSetDenomination() bool {
+	return p.Denomination != nil
+}
+
+func (p *UpdateCouponReq) IsSetMinUseAmount() bool {
+	return p.MinUseAmount != nil
+}
+
+func (p *UpdateCouponReq) IsSetValidStartTime() bool {
+	return p.ValidStartTime != nil
+}
+
+func (p *UpdateCouponReq) IsSetValidEndTime() bool {
+	return p.ValidEndTime != nil
+}
+
+func (p *UpdateCouponReq) IsSetStock() bool {
+	return p.Stock != nil
+}
+
+func (p *UpdateCouponReq) IsSetApplySpotIds() bool {
+	return p.ApplySpotIds != nil
+}
+
+func (p *UpdateCouponReq) IsSetExtFields() bool {
+	return p.ExtFields != nil
+}
+
+func (p *UpdateCouponReq) IsSetStackGroup() bool {
+	return p.StackGroup != nil
+}
+
+func (p *UpdateCouponReq) IsSetPriority() bool {
+	return p.Priority != nil
+}
+
+func (p *UpdateCouponReq) IsSetScopes() bool {
+	return p.Scopes != nil
+}
+
+func (p *UpdateCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCouponReq(%+v)", *p)
+}
+
+var fieldIDToName_UpdateCouponReq = map[int16]string{
+	1:  "coupon_id",
+	2:  "coupon_name",
+	3:  "coupon_type",
+	4:  "denomination",
+	5:  "min_use_amount",
+	6:  "valid_start_time",
+	7:  "valid_end_time",
+	8:  "stock",
+	9:  "apply_spot_ids",
+	10: "ext_fields",
+	11: "stack_group",
+	12: "priority",
+	13: "operator_merchant_id",
+	14: "scopes",
+}
+
+type UpdateCouponResp struct {
+	Base *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+}
+
+func NewUpdateCouponResp() *UpdateCouponResp {
+	return &UpdateCouponResp{}
+}
+
+func (p *UpdateCouponResp) InitDefault() {
+}
+
+var UpdateCouponResp_Base_DEFAULT *BaseResp
+
+func (p *UpdateCouponResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return UpdateCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *UpdateCouponResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+
+func (p *UpdateCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdateCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdateCouponResp(%+v)", *p)
+}
+
+var fieldIDToName_UpdateCouponResp = map[int16]string{
+	1: "base",
+}
+
+type GetCouponReq struct {
+	CouponId int64 `thrift:"coupon_id,1" frugal:"1,default,i64" json:"coupon_id"`
+}
+
+func NewGetCouponReq() *GetCouponReq {
+	return &GetCouponReq{}
+}
+
+func (p *GetCouponReq) InitDefault() {
+}
+
+func (p *GetCouponReq) GetCouponId() (v int64) {
+	return p.CouponId
+}
+func (p *GetCouponReq) SetCouponId(val int64) {
+	p.CouponId = val
+}
+
+func (p *GetCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCouponReq(%+v)", *p)
+}
+
+var fieldIDToName_GetCouponReq = map[int16]string{
+	1: "coupon_id",
+}
+
+type GetCouponResp struct {
+	Base   *BaseResp   `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Coupon *CouponInfo `thrift:"coupon,2" frugal:"2,default,CouponInfo" json:"coupon"`
+}
+
+func NewGetCouponResp() *GetCouponResp {
+	return &GetCouponResp{}
+}
+
+func (p *GetCouponResp) InitDefault() {
+}
+
+var GetCouponResp_Base_DEFAULT *BaseResp
+
+func (p *GetCouponResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return GetCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetCouponResp_Coupon_DEFAULT *CouponInfo
+
+func (p *GetCouponResp) GetCoupon() (v *CouponInfo) {
+	if !p.IsSetCoupon() {
+		return GetCouponResp_Coupon_DEFAULT
+	}
+	return p.Coupon
+}
+func (p *GetCouponResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *GetCouponResp) SetCoupon(val *CouponInfo) {
+	p.Coupon = val
+}
+
+func (p *GetCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetCouponResp) IsSetCoupon() bool {
+	return p.Coupon != nil
+}
+
+func (p *GetCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCouponResp(%+v)", *p)
+}
+
+var fieldIDToName_GetCouponResp = map[int16]string{
+	1: "base",
+	2: "coupon",
+}
+
+type ListCouponsReq struct {
+	Page         int32  `thrift:"page,1" frugal:"1,default,i32" json:"page"`
+	PageSize     int32  `thrift:"page_size,2" frugal:"2,default,i32" json:"page_size"`
+	CouponStatus string `thrift:"coupon_status,3" frugal:"3,default,string" json:"coupon_status"`
+	StartTime    int64  `thrift:"start_time,4" frugal:"4,default,i64" json:"start_time"`
+	EndTime      int64  `thrift:"end_time,5" frugal:"5,default,i64" json:"end_time"`
+	SpotId       int64  `thrift:"spot_id,6" frugal:"6,default,i64" json:"spot_id"`
+	IssuerType   string `thrift:"issuer_type,7" frugal:"7,default,string" json:"issuer_type"`
+	MerchantId   int64  `thrift:"merchant_id,8" frugal:"8,default,i64" json:"merchant_id"`
+}
+
+func NewListCouponsReq() *ListCouponsReq {
+	return &ListCouponsReq{}
+}
+
+func (p *ListCouponsReq) InitDefault() {
+}
+
+func (p *ListCouponsReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListCouponsReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+
+func (p *ListCouponsReq) GetCouponStatus() (v string) {
+	return p.CouponStatus
+}
+
+func (p *ListCouponsReq) GetStartTime() (v int64) {
+	return p.StartTime
+}
+
+func (p *ListCouponsReq) GetEndTime() (v int64) {
+	return p.EndTime
+}
+
+func (p *ListCouponsReq) GetSpotId() (v int64) {
+	return p.SpotId
+}
+
+func (p *ListCouponsReq) GetIssuerType() (v string) {
+	return p.IssuerType
+}
+
+func (p *ListCouponsReq) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+func (p *ListCouponsReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListCouponsReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+func (p *ListCouponsReq) SetCouponStatus(val string) {
+	p.CouponStatus = val
+}
+func (p *ListCouponsReq) SetStartTime(val int64) {
+	p.StartTime = val
+}
+func (p *ListCouponsReq) SetEndTime(val int64) {
+	p.EndTime = val
+}
+func (p *ListCouponsReq) SetSpotId(val int64) {
+	p.SpotId = val
+}
+func (p *ListCouponsReq) SetIssuerType(val string) {
+	p.IssuerType = val
+}
+func (p *ListCouponsReq) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+
+func (p *ListCouponsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCouponsReq(%+v)", *p)
+}
+
+var fieldIDToName_ListCouponsReq = map[int16]string{
+	1: "page",
+	2: "page_size",
+	3: "coupon_status",
+	4: "start_time",
+	5: "end_time",
+	6: "spot_id",
+	7: "issuer_type",
+	8: "merchant_id",
+}
+
+type ListCouponsResp struct {
+	Base    *BaseResp     `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Coupons []*CouponInfo `thrift:"coupons,2" frugal:"2,default,list<CouponInfo>" json:"coupons"`
+	Total   int64         `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+}
+
+func NewListCouponsResp() *ListCouponsResp {
+	return &ListCouponsResp{}
+}
+
+func (p *ListCouponsResp) InitDefault() {
+}
+
+var ListCouponsResp_Base_DEFAULT *BaseResp
+
+func (p *ListCouponsResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ListCouponsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListCouponsResp) GetCoupons() (v []*CouponInfo) {
+	return p.Coupons
+}
+
+func (p *ListCouponsResp) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *ListCouponsResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *ListCouponsResp) SetCoupons(val []*CouponInfo) {
+	p.Coupons = val
+}
+func (p *ListCouponsResp) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *ListCouponsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListCouponsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCouponsResp(%+v)", *p)
+}
+
+var fieldIDToName_ListCouponsResp = map[int16]string{
+	1: "base",
+	2: "coupons",
+	3: "total",
+}
+
+type ListCouponsForSpotReq struct {
+	SpotId       int64 `thrift:"spot_id,1" frugal:"1,default,i64" json:"spot_id"`
+	TicketTypeId int64 `thrift:"ticket_type_id,2" frugal:"2,default,i64" json:"ticket_type_id"`
+}
+
+func NewListCouponsForSpotReq() *ListCouponsForSpotReq {
+	return &ListCouponsForSpotReq{}
+}
+
+func (p *ListCouponsForSpotReq) InitDefault() {
+}
+
+func (p *ListCouponsForSpotReq) GetSpotId() (v int64) {
+	return p.SpotId
+}
+
+func (p *ListCouponsForSpotReq) GetTicketTypeId() (v int64) {
+	return p.TicketTypeId
+}
+func (p *ListCouponsForSpotReq) SetSpotId(val int64) {
+	p.SpotId = val
+}
+func (p *ListCouponsForSpotReq) SetTicketTypeId(val int64) {
+	p.TicketTypeId = val
+}
+
+func (p *ListCouponsForSpotReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCouponsForSpotReq(%+v)", *p)
+}
+
+var fieldIDToName_ListCouponsForSpotReq = map[int16]string{
+	1: "spot_id",
+	2: "ticket_type_id",
+}
+
+type ListCouponsForSpotResp struct {
+	Base    *BaseResp     `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Coupons []*CouponInfo `thrift:"coupons,2" frugal:"2,default,list<CouponInfo>" json:"coupons"`
+}
+
+func NewListCouponsForSpotResp() *ListCouponsForSpotResp {
+	return &ListCouponsForSpotResp{}
+}
+
+func (p *ListCouponsForSpotResp) InitDefault() {
+}
+
+var ListCouponsForSpotResp_Base_DEFAULT *BaseResp
+
+func (p *ListCouponsForSpotResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ListCouponsForSpotResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListCouponsForSpotResp) GetCoupons() (v []*CouponInfo) {
+	return p.Coupons
+}
+func (p *ListCouponsForSpotResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *ListCouponsForSpotResp) SetCoupons(val []*CouponInfo) {
+	p.Coupons = val
+}
+
+func (p *ListCouponsForSpotResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListCouponsForSpotResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCouponsForSpotResp(%+v)", *p)
+}
+
+var fieldIDToName_ListCouponsForSpotResp = map[int16]string{
+	1: "base",
+	2: "coupons",
+}
+
+type InvalidateCouponReq struct {
+	CouponId           int64 `thrift:"coupon_id,1" frugal:"1,default,i64" json:"coupon_id"`
+	OperatorMerchantId int64 `thrift:"operator_merchant_id,2" frugal:"2,default,i64" json:"operator_merchant_id"`
+}
+
+func NewInvalidateCouponReq() *InvalidateCouponReq {
+	return &InvalidateCouponReq{}
+}
+
+func (p *InvalidateCouponReq) InitDefault() {
+}
+
+func (p *InvalidateCouponReq) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *InvalidateCouponReq) GetOperatorMerchantId() (v int64) {
+	return p.OperatorMerchantId
+}
+func (p *InvalidateCouponReq) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *InvalidateCouponReq) SetOperatorMerchantId(val int64) {
+	p.OperatorMerchantId = val
+}
+
+func (p *InvalidateCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InvalidateCouponReq(%+v)", *p)
+}
+
+var fieldIDToName_InvalidateCouponReq = map[int16]string{
+	1: "coupon_id",
+	2: "operator_merchant_id",
+}
+
+type InvalidateCouponResp struct {
+	Base *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+}
+
+func NewInvalidateCouponResp() *InvalidateCouponResp {
+	return &InvalidateCouponResp{}
+}
+
+func (p *InvalidateCouponResp) InitDefault() {
+}
+
+var InvalidateCouponResp_Base_DEFAULT *BaseResp
+
+func (p *InvalidateCouponResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return InvalidateCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *InvalidateCouponResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+
+func (p *InvalidateCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *InvalidateCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InvalidateCouponResp(%+v)", *p)
+}
+
+var fieldIDToName_InvalidateCouponResp = map[int16]string{
+	1: "base",
+}
+
+type ClaimCouponReq struct {
+	UserId   int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	CouponId int64  `thrift:"coupon_id,2" frugal:"2,default,i64" json:"coupon_id"`
+	DeviceId string `thrift:"device_id,3" frugal:"3,default,string" json:"device_id"`
+}
+
+func NewClaimCouponReq() *ClaimCouponReq {
+	return &ClaimCouponReq{}
+}
+
+func (p *ClaimCouponReq) InitDefault() {
+}
+
+func (p *ClaimCouponReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ClaimCouponReq) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *ClaimCouponReq) GetDeviceId() (v string) {
+	return p.DeviceId
+}
+func (p *ClaimCouponReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ClaimCouponReq) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *ClaimCouponReq) SetDeviceId(val string) {
+	p.DeviceId = val
+}
+
+func (p *ClaimCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClaimCouponReq(%+v)", *p)
+}
+
+var fieldIDToName_ClaimCouponReq = map[int16]string{
+	1: "user_id",
+	2: "coupon_id",
+	3: "device_id",
+}
+
+type ClaimCouponResp struct {
+	Base         *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	UserCouponId int64     `thrift:"user_coupon_id,2" frugal:"2,default,i64" json:"user_coupon_id"`
+	ClaimToken   string    `thrift:"claim_token,3" frugal:"3,default,string" json:"claim_token"`
+}
+
+func NewClaimCouponResp() *ClaimCouponResp {
+	return &ClaimCouponResp{}
+}
+
+func (p *ClaimCouponResp) InitDefault() {
+}
+
+var ClaimCouponResp_Base_DEFAULT *BaseResp
+
+func (p *ClaimCouponResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ClaimCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ClaimCouponResp) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+
+func (p *ClaimCouponResp) GetClaimToken() (v string) {
+	return p.ClaimToken
+}
+func (p *ClaimCouponResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *ClaimCouponResp) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+func (p *ClaimCouponResp) SetClaimToken(val string) {
+	p.ClaimToken = val
+}
+
+func (p *ClaimCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ClaimCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ClaimCouponResp(%+v)", *p)
+}
+
+var fieldIDToName_ClaimCouponResp = map[int16]string{
+	1: "base",
+	2: "user_coupon_id",
+	3: "claim_token",
+}
+
+type GetClaimStatusReq struct {
+	ClaimToken string `thrift:"claim_token,1" frugal:"1,default,string" json:"claim_token"`
+}
+
+func NewGetClaimStatusReq() *GetClaimStatusReq {
+	return &GetClaimStatusReq{}
+}
+
+func (p *GetClaimStatusReq) InitDefault() {
+}
+
+func (p *GetClaimStatusReq) GetClaimToken() (v string) {
+	return p.ClaimToken
+}
+func (p *GetClaimStatusReq) SetClaimToken(val string) {
+	p.ClaimToken = val
+}
+
+func (p *GetClaimStatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetClaimStatusReq(%+v)", *p)
+}
+
+var fieldIDToName_GetClaimStatusReq = map[int16]string{
+	1: "claim_token",
+}
+
+type GetClaimStatusResp struct {
+	Base         *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	ClaimStatus  string    `thrift:"claim_status,2" frugal:"2,default,string" json:"claim_status"`
+	UserCouponId int64     `thrift:"user_coupon_id,3" frugal:"3,default,i64" json:"user_coupon_id"`
+}
+
+func NewGetClaimStatusResp() *GetClaimStatusResp {
+	return &GetClaimStatusResp{}
+}
+
+func (p *GetClaimStatusResp) InitDefault() {
+}
+
+var GetClaimStatusResp_Base_DEFAULT *BaseResp
+
+func (p *GetClaimStatusResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return GetClaimStatusResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetClaimStatusResp) GetClaimStatus() (v string) {
+	return p.ClaimStatus
+}
+
+func (p *GetClaimStatusResp) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+func (p *GetClaimStatusResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *GetClaimStatusResp) SetClaimStatus(val string) {
+	p.ClaimStatus = val
+}
+func (p *GetClaimStatusResp) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+
+func (p *GetClaimStatusResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetClaimStatusResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetClaimStatusResp(%+v)", *p)
+}
+
+var fieldIDToName_GetClaimStatusResp = map[int16]string{
+	1: "base",
+	2: "claim_status",
+	3: "user_coupon_id",
+}
+
+type OrderItemReq struct {
+	TicketTypeId int64 `thrift:"ticket_type_id,1" frugal:"1,default,i64" json:"ticket_type_id"`
+	TicketNum    int32 `thrift:"ticket_num,2" frugal:"2,default,i32" json:"ticket_num"`
+}
+
+func NewOrderItemReq() *OrderItemReq {
+	return &OrderItemReq{}
+}
+
+func (p *OrderItemReq) InitDefault() {
+}
+
+func (p *OrderItemReq) GetTicketTypeId() (v int64) {
+	return p.TicketTypeId
+}
+
+func (p *OrderItemReq) GetTicketNum() (v int32) {
+	return p.TicketNum
+}
+func (p *OrderItemReq) SetTicketTypeId(val int64) {
+	p.TicketTypeId = val
+}
+func (p *OrderItemReq) SetTicketNum(val int32) {
+	p.TicketNum = val
+}
+
+func (p *OrderItemReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderItemReq(%+v)", *p)
+}
+
+var fieldIDToName_OrderItemReq = map[int16]string{
+	1: "ticket_type_id",
+	2: "ticket_num",
+}
+
+type CouponAllocationInfo struct {
+	UserCouponId int64   `thrift:"user_coupon_id,1" frugal:"1,default,i64" json:"user_coupon_id"`
+	Discount     float64 `thrift:"discount,2" frugal:"2,default,double" json:"discount"`
+}
+
+func NewCouponAllocationInfo() *CouponAllocationInfo {
+	return &CouponAllocationInfo{}
+}
+
+func (p *CouponAllocationInfo) InitDefault() {
+}
+
+func (p *CouponAllocationInfo) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+
+func (p *CouponAllocationInfo) GetDiscount() (v float64) {
+	return p.Discount
+}
+func (p *CouponAllocationInfo) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+func (p *CouponAllocationInfo) SetDiscount(val float64) {
+	p.Discount = val
+}
+
+func (p *CouponAllocationInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponAllocationInfo(%+v)", *p)
+}
+
+var fieldIDToName_CouponAllocationInfo = map[int16]string{
+	1: "user_coupon_id",
+	2: "discount",
+}
+
+type CouponContributionInfo struct {
+	UserCouponId int64   `thrift:"user_coupon_id,1" frugal:"1,default,i64" json:"user_coupon_id"`
+	CouponId     int64   `thrift:"coupon_id,2" frugal:"2,default,i64" json:"coupon_id"`
+	CouponName   string  `thrift:"coupon_name,3" frugal:"3,default,string" json:"coupon_name"`
+	IssuerType   string  `thrift:"issuer_type,4" frugal:"4,default,string" json:"issuer_type"`
+	MerchantId   int64   `thrift:"merchant_id,5" frugal:"5,default,i64" json:"merchant_id"`
+	Discount     float64 `thrift:"discount,6" frugal:"6,default,double" json:"discount"`
+}
+
+func NewCouponContributionInfo() *CouponContributionInfo {
+	return &CouponContributionInfo{}
+}
+
+func (p *CouponContributionInfo) InitDefault() {
+}
+
+func (p *CouponContributionInfo) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+
+func (p *CouponContributionInfo) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *CouponContributionInfo) GetCouponName() (v string) {
+	return p.CouponName
+}
+
+func (p *CouponContributionInfo) GetIssuerType() (v string) {
+	return p.IssuerType
+}
+
+func (p *CouponContributionInfo) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *CouponContributionInfo) GetDiscount() (v float64) {
+	return p.Discount
+}
+func (p *CouponContributionInfo) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+func (p *CouponContributionInfo) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *CouponContributionInfo) SetCouponName(val string) {
+	p.CouponName = val
+}
+func (p *CouponContributionInfo) SetIssuerType(val string) {
+	p.IssuerType = val
+}
+func (p *CouponContributionInfo) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *CouponContributionInfo) SetDiscount(val float64) {
+	p.Discount = val
+}
+
+func (p *CouponContributionInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponContributionInfo(%+v)", *p)
+}
+
+var fieldIDToName_CouponContributionInfo = map[int16]string{
+	1: "user_coupon_id",
+	2: "coupon_id",
+	3: "coupon_name",
+	4: "issuer_type",
+	5: "merchant_id",
+	6: "discount",
+}
+
+type ItemPriceInfo struct {
+	TicketTypeId int64                   `thrift:"ticket_type_id,1" frugal:"1,default,i64" json:"ticket_type_id"`
+	TicketName   string                  `thrift:"ticket_name,2" frugal:"2,default,string" json:"ticket_name"`
+	SinglePrice  float64                 `thrift:"single_price,3" frugal:"3,default,double" json:"single_price"`
+	TicketNum    int32                   `thrift:"ticket_num,4" frugal:"4,default,i32" json:"ticket_num"`
+	Amount       float64                 `thrift:"amount,5" frugal:"5,default,double" json:"amount"`
+	Discount     float64                 `thrift:"discount,6" frugal:"6,default,double" json:"discount"`
+	PayAmount    float64                 `thrift:"pay_amount,7" frugal:"7,default,double" json:"pay_amount"`
+	Allocations  []*CouponAllocationInfo `thrift:"allocations,8" frugal:"8,default,list<CouponAllocationInfo>" json:"allocations"`
+}
+
+func NewItemPriceInfo() *ItemPriceInfo {
+	return &ItemPriceInfo{}
+}
+
+func (p *ItemPriceInfo) InitDefault() {
+}
+
+func (p *ItemPriceInfo) GetTicketTypeId() (v int64) {
+	return p.TicketTypeId
+}
+
+func (p *ItemPriceInfo) GetTicketName() (v string) {
+	return p.TicketName
+}
+
+func (p *ItemPriceInfo) GetSinglePrice() (v float64) {
+	return p.SinglePrice
+}
+
+func (p *ItemPriceInfo) GetTicketNum() (v int32) {
+	return p.TicketNum
+}
+
+func (p *ItemPriceInfo) GetAmount() (v float64) {
+	return p.Amount
+}
+
+func (p *ItemPriceInfo) GetDiscount() (v float64) {
+	return p.Discount
+}
+
+func (p *ItemPriceInfo) GetPayAmount() (v float64) {
+	return p.PayAmount
+}
+
+func (p *ItemPriceInfo) GetAllocations() (v []*CouponAllocationInfo) {
+	return p.Allocations
+}
+func (p *ItemPriceInfo) SetTicketTypeId(val int64) {
+	p.TicketTypeId = val
+}
+func (p *ItemPriceInfo) SetTicketName(val string) {
+	p.TicketName = val
+}
+func (p *ItemPriceInfo) SetSinglePrice(val float64) {
+	p.SinglePrice = val
+}
+func (p *ItemPriceInfo) SetTicketNum(val int32) {
+	p.TicketNum = val
+}
+func (p *ItemPriceInfo) SetAmount(val float64) {
+	p.Amount = val
+}
+func (p *ItemPriceInfo) SetDiscount(val float64) {
+	p.Discount = val
+}
+func (p *ItemPriceInfo) SetPayAmount(val float64) {
+	p.PayAmount = val
+}
+func (p *ItemPriceInfo) SetAllocations(val []*CouponAllocationInfo) {
+	p.Allocations = val
+}
+
+func (p *ItemPriceInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ItemPriceInfo(%+v)", *p)
+}
+
+var fieldIDToName_ItemPriceInfo = map[int16]string{
+	1: "ticket_type_id",
+	2: "ticket_name",
+	3: "single_price",
+	4: "ticket_num",
+	5: "amount",
+	6: "discount",
+	7: "pay_amount",
+	8: "allocations",
+}
+
+type PreviewOrderPriceReq struct {
+	UserId        int64           `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Items         []*OrderItemReq `thrift:"items,2" frugal:"2,default,list<OrderItemReq>" json:"items"`
+	UserCouponId  int64           `thrift:"user_coupon_id,3" frugal:"3,default,i64" json:"user_coupon_id"`
+	UserCouponIds []int64         `thrift:"user_coupon_ids,4" frugal:"4,default,list<i64>" json:"user_coupon_ids"`
+}
+
+func NewPreviewOrderPriceReq() *PreviewOrderPriceReq {
+	return &PreviewOrderPriceReq{}
+}
+
+func (p *PreviewOrderPriceReq) InitDefault() {
+}
+
+func (p *PreviewOrderPriceReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *PreviewOrderPriceReq) GetItems() (v []*OrderItemReq) {
+	return p.Items
+}
+
+func (p *PreviewOrderPriceReq) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+
+func (p *PreviewOrderPriceReq) GetUserCouponIds() (v []int64) {
+	return p.UserCouponIds
+}
+func (p *PreviewOrderPriceReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *PreviewOrderPriceReq) SetItems(val []*OrderItemReq) {
+	p.Items = val
+}
+func (p *PreviewOrderPriceReq) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+func (p *PreviewOrderPriceReq) SetUserCouponIds(val []int64) {
+	p.UserCouponIds = val
+}
+
+func (p *PreviewOrderPriceReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PreviewOrderPriceReq(%+v)", *p)
+}
+
+var fieldIDToName_PreviewOrderPriceReq = map[int16]string{
+	1: "user_id",
+	2: "items",
+	3: "user_coupon_id",
+	4: "user_coupon_ids",
+}
+
+type PreviewOrderPriceResp struct {
+	Base           *BaseResp                 `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	TotalAmount    float64                   `thrift:"total_amount,2" frugal:"2,default,double" json:"total_amount"`
+	DiscountAmount float64                   `thrift:"discount_amount,3" frugal:"3,default,double" json:"discount_amount"`
+	PayAmount      float64                   `thrift:"pay_amount,4" frugal:"4,default,double" json:"pay_amount"`
+	Items          []*ItemPriceInfo          `thrift:"items,5" frugal:"5,default,list<ItemPriceInfo>" json:"items"`
+	Coupons        []*CouponContributionInfo `thrift:"coupons,6" frugal:"6,default,list<CouponContributionInfo>" json:"coupons"`
+}
+
+func NewPreviewOrderPriceResp() *PreviewOrderPriceResp {
+	return &PreviewOrderPriceResp{}
+}
+
+func (p *PreviewOrderPriceResp) InitDefault() {
+}
+
+var PreviewOrderPriceResp_Base_DEFAULT *BaseResp
+
+func (p *PreviewOrderPriceResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return PreviewOrderPriceResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *PreviewOrderPriceResp) GetTotalAmount() (v float64) {
+	return p.TotalAmount
+}
+
+func (p *PreviewOrderPriceResp) GetDiscountAmount() (v float64) {
+	return p.DiscountAmount
+}
+
+func (p *PreviewOrderPriceResp) GetPayAmount() (v float64) {
+	return p.PayAmount
+}
+
+func (p *PreviewOrderPriceResp) GetItems() (v []*ItemPriceInfo) {
+	return p.Items
+}
+
+func (p *PreviewOrderPriceResp) GetCoupons() (v []*CouponContributionInfo) {
+	return p.Coupons
+}
+func (p *PreviewOrderPriceResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *PreviewOrderPriceResp) SetTotalAmount(val float64) {
+	p.TotalAmount = val
+}
+func (p *PreviewOrderPriceResp) SetDiscountAmount(val float64) {
+	p.DiscountAmount = val
+}
+func (p *PreviewOrderPriceResp) SetPayAmount(val float64) {
+	p.PayAmount = val
+}
+func (p *PreviewOrderPriceResp) SetItems(val []*ItemPriceInfo) {
+	p.Items = val
+}
+func (p *PreviewOrderPriceResp) SetCoupons(val []*CouponContributionInfo) {
+	p.Coupons = val
+}
+
+func (p *PreviewOrderPriceResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *PreviewOrderPriceResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PreviewOrderPriceResp(%+v)", *p)
+}
+
+var fieldIDToName_PreviewOrderPriceResp = map[int16]string{
+	1: "base",
+	2: "total_amount",
+	3: "discount_amount",
+	4: "pay_amount",
+	5: "items",
+	6: "coupons",
+}
+
+type RecommendCouponsReq struct {
+	UserId int64           `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Items  []*OrderItemReq `thrift:"items,2" frugal:"2,default,list<OrderItemReq>" json:"items"`
+}
+
+func NewRecommendCouponsReq() *RecommendCouponsReq {
+	return &RecommendCouponsReq{}
+}
+
+func (p *RecommendCouponsReq) InitDefault() {
+}
+
+func (p *RecommendCouponsReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *RecommendCouponsReq) GetItems() (v []*OrderItemReq) {
+	return p.Items
+}
+func (p *RecommendCouponsReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RecommendCouponsReq) SetItems(val []*OrderItemReq) {
+	p.Items = val
+}
+
+func (p *RecommendCouponsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendCouponsReq(%+v)", *p)
+}
+
+var fieldIDToName_RecommendCouponsReq = map[int16]string{
+	1: "user_id",
+	2: "items",
+}
+
+type CouponRecommendation struct {
+	UserCouponId   int64   `thrift:"user_coupon_id,1" frugal:"1,default,i64" json:"user_coupon_id"`
+	CouponId       int64   `thrift:"coupon_id,2" frugal:"2,default,i64" json:"coupon_id"`
+	CouponName     string  `thrift:"coupon_name,3" frugal:"3,default,string" json:"coupon_name"`
+	CouponType     string  `thrift:"coupon_type,4" frugal:"4,default,string" json:"coupon_type"`
+	Denomination   float64 `thrift:"denomination,5" frugal:"5,default,double" json:"denomination"`
+	MinUseAmount   float64 `thrift:"min_use_amount,6" frugal:"6,default,double" json:"min_use_amount"`
+	ValidStartTime int64   `thrift:"valid_start_time,7" frugal:"7,default,i64" json:"valid_start_time"`
+	ValidEndTime   int64   `thrift:"valid_end_time,8" frugal:"8,default,i64" json:"valid_end_time"`
+	Usable         bool    `thrift:"usable,9" frugal:"9,default,bool" json:"usable"`
+	DiscountAmount float64 `thrift:"discount_amount,10" frugal:"10,default,double" json:"discount_amount"`
+	PayAmount      float64 `thrift:"pay_amount,11" frugal:"11,default,double" json:"pay_amount"`
+	Reason         string  `thrift:"reason,12" frugal:"12,default,string" json:"reason"`
+}
+
+func NewCouponRecommendation() *CouponRecommendation {
+	return &CouponRecommendation{}
+}
+
+func (p *CouponRecommendation) InitDefault() {
+}
+
+func (p *CouponRecommendation) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+
+func (p *CouponRecommendation) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *CouponRecommendation) GetCouponName() (v string) {
+	return p.CouponName
+}
+
+func (p *CouponRecommendation) GetCouponType() (v string) {
+	return p.CouponType
+}
+
+func (p *CouponRecommendation) GetDenomination() (v float64) {
+	return p.Denomination
+}
+
+func (p *CouponRecommendation) GetMinUseAmount() (v float64) {
+	return p.MinUseAmount
+}
+
+func (p *CouponRecommendation) GetValidStartTime() (v int64) {
+	return p.ValidStartTime
+}
+
+func (p *CouponRecommendation) GetValidEndTime() (v int64) {
+	return p.ValidEndTime
+}
+
+func (p *CouponRecommendation) GetUsable() (v bool) {
+	return p.Usable
+}
+
+func (p *CouponRecommendation) GetDiscountAmount() (v float64) {
+	return p.DiscountAmount
+}
+
+func (p *CouponRecommendation) GetPayAmount() (v float64) {
+	return p.PayAmount
+}
+
+func (p *CouponRecommendation) GetReason() (v string) {
+	return p.Reason
+}
+func (p *CouponRecommendation) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+func (p *CouponRecommendation) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *CouponRecommendation) SetCouponName(val string) {
+	p.CouponName = val
+}
+func (p *CouponRecommendation) SetCouponType(val string) {
+	p.CouponType = val
+}
+func (p *CouponRecommendation) SetDenomination(val float64) {
+	p.Denomination = val
+}
+func (p *CouponRecommendation) SetMinUseAmount(val float64) {
+	p.MinUseAmount = val
+}
+func (p *CouponRecommendation) SetValidStartTime(val int64) {
+	p.ValidStartTime = val
+}
+func (p *CouponRecommendation) SetValidEndTime(val int64) {
+	p.ValidEndTime = val
+}
+func (p *CouponRecommendation) SetUsable(val bool) {
+	p.Usable = val
+}
+func (p *CouponRecommendation) SetDiscountAmount(val float64) {
+	p.DiscountAmount = val
+}
+func (p *CouponRecommendation) SetPayAmount(val float64) {
+	p.PayAmount = val
+}
+func (p *CouponRecommendation) SetReason(val string) {
+	p.Reason = val
+}
+
+func (p *CouponRecommendation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponRecommendation(%+v)", *p)
+}
+
+var fieldIDToName_CouponRecommendation = map[int16]string{
+	1:  "user_coupon_id",
+	2:  "coupon_id",
+	3:  "coupon_name",
+	4:  "coupon_type",
+	5:  "denomination",
+	6:  "min_use_amount",
+	7:  "valid_start_time",
+	8:  "valid_end_time",
+	9:  "usable",
+	10: "discount_amount",
+	11: "pay_amount",
+	12: "reason",
+}
+
+type RecommendCouponsResp struct {
+	Base        *BaseResp               `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	TotalAmount float64                 `thrift:"total_amount,2" frugal:"2,default,double" json:"total_amount"`
+	Coupons     []*CouponRecommendation `thrift:"coupons,3" frugal:"3,default,list<CouponRecommendation>" json:"coupons"`
+}
+
+func NewRecommendCouponsResp() *RecommendCouponsResp {
+	return &RecommendCouponsResp{}
+}
+
+func (p *RecommendCouponsResp) InitDefault() {
+}
+
+var RecommendCouponsResp_Base_DEFAULT *BaseResp
+
+func (p *RecommendCouponsResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return RecommendCouponsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *RecommendCouponsResp) GetTotalAmount() (v float64) {
+	return p.TotalAmount
+}
+
+func (p *RecommendCouponsResp) GetCoupons() (v []*CouponRecommendation) {
+	return p.Coupons
+}
+func (p *RecommendCouponsResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *RecommendCouponsResp) SetTotalAmount(val float64) {
+	p.TotalAmount = val
+}
+func (p *RecommendCouponsResp) SetCoupons(val []*CouponRecommendation) {
+	p.Coupons = val
+}
+
+func (p *RecommendCouponsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RecommendCouponsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RecommendCouponsResp(%+v)", *p)
+}
+
+var fieldIDToName_RecommendCouponsResp = map[int16]string{
+	1: "base",
+	2: "total_amount",
+	3: "coupons",
+}
+
+type LockCouponReq struct {
+	UserId        int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	UserCouponId  int64   `thrift:"user_coupon_id,2" frugal:"2,default,i64" json:"user_coupon_id"`
+	OrderId       int64   `thrift:"order_id,3" frugal:"3,default,i64" json:"order_id"`
+	UserCouponIds []int64 `thrift:"user_coupon_ids,4" frugal:"4,default,list<i64>" json:"user_coupon_ids"`
+}
+
+func NewLockCouponReq() *LockCouponReq {
+	return &LockCouponReq{}
+}
+
+func (p *LockCouponReq) InitDefault() {
+}
+
+func (p *LockCouponReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *LockCouponReq) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+
+func (p *LockCouponReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *LockCouponReq) GetUserCouponIds() (v []int64) {
+	return p.UserCouponIds
+}
+func (p *LockCouponReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *LockCouponReq) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+func (p *LockCouponReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *LockCouponReq) SetUserCouponIds(val []int64) {
+	p.UserCouponIds = val
+}
+
+func (p *LockCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LockCouponReq(%+v)", *p)
+}
+
+var fieldIDToName_LockCouponReq = map[int16]string{
+	1: "user_id",
+	2: "user_coupon_id",
+	3: "order_id",
+	4: "user_coupon_ids",
+}
+
+type LockCouponResp struct {
+	Base           *BaseResp                 `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	DiscountAmount float64                   `thrift:"discount_amount,2" frugal:"2,default,double" json:"discount_amount"`
+	PayAmount      float64                   `thrift:"pay_amount,3" frugal:"3,default,double" json:"pay_amount"`
+	Coupons        []*CouponContributionInfo `thrift:"coupons,4" frugal:"4,default,list<CouponContributionInfo>" json:"coupons"`
+}
+
+func NewLockCouponResp() *LockCouponResp {
+	return &LockCouponResp{}
+}
+
+func (p *LockCouponResp) InitDefault() {
+}
+
+var LockCouponResp_Base_DEFAULT *BaseResp
+
+func (p *LockCouponResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return LockCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *LockCouponResp) GetDiscountAmount() (v float64) {
+	return p.DiscountAmount
+}
+
+func (p *LockCouponResp) GetPayAmount() (v float64) {
+	return p.PayAmount
+}
+
+func (p *LockCouponResp) GetCoupons() (v []*CouponContributionInfo) {
+	return p.Coupons
+}
+func (p *LockCouponResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *LockCouponResp) SetDiscountAmount(val float64) {
+	p.DiscountAmount = val
+}
+func (p *LockCouponResp) SetPayAmount(val float64) {
+	p.PayAmount = val
+}
+func (p *LockCouponResp) SetCoupons(val []*CouponContributionInfo) {
+	p.Coupons = val
+}
+
+func (p *LockCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *LockCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LockCouponResp(%+v)", *p)
+}
+
+var fieldIDToName_LockCouponResp = map[int16]string{
+	1: "base",
+	2: "discount_amount",
+	3: "pay_amount",
+	4: "coupons",
+}
+
+type ConsumeCouponReq struct {
+	OrderId int64 `thrift:"order_id,1" frugal:"1,default,i64" json:"order_id"`
+}
+
+func NewConsumeCouponReq() *ConsumeCouponReq {
+	return &ConsumeCouponReq{}
+}
+
+func (p *ConsumeCouponReq) InitDefault() {
+}
+
+func (p *ConsumeCouponReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+func (p *ConsumeCouponReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+
+func (p *ConsumeCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConsumeCouponReq(%+v)", *p)
+}
+
+var fieldIDToName_ConsumeCouponReq = map[int16]string{
+	1: "order_id",
+}
+
+type ConsumeCouponResp struct {
+	Base     *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Consumed bool      `thrift:"consumed,2" frugal:"2,default,bool" json:"consumed"`
+}
+
+func NewConsumeCouponResp() *ConsumeCouponResp {
+	return &ConsumeCouponResp{}
+}
+
+func (p *ConsumeCouponResp) InitDefault() {
+}
+
+var ConsumeCouponResp_Base_DEFAULT *BaseResp
+
+func (p *ConsumeCouponResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ConsumeCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ConsumeCouponResp) GetConsumed() (v bool) {
+	return p.Consumed
+}
+func (p *ConsumeCouponResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *ConsumeCouponResp) SetConsumed(val bool) {
+	p.Consumed = val
+}
+
+func (p *ConsumeCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ConsumeCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConsumeCouponResp(%+v)", *p)
+}
+
+var fieldIDToName_ConsumeCouponResp = map[int16]string{
+	1: "base",
+	2: "consumed",
+}
+
+type ReleaseCouponReq struct {
+	OrderId int64 `thrift:"order_id,1" frugal:"1,default,i64" json:"order_id"`
+}
+
+func NewReleaseCouponReq() *ReleaseCouponReq {
+	return &ReleaseCouponReq{}
+}
+
+func (p *ReleaseCouponReq) InitDefault() {
+}
+
+func (p *ReleaseCouponReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+func (p *ReleaseCouponReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+
+func (p *ReleaseCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReleaseCouponReq(%+v)", *p)
+}
+
+var fieldIDToName_ReleaseCouponReq = map[int16]string{
+	1: "order_id",
+}
+
+type ReleaseCouponResp struct {
+	Base     *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Released int32     `thrift:"released,2" frugal:"2,default,i32" json:"released"`
+}
+
+func NewReleaseCouponResp() *ReleaseCouponResp {
+	return &ReleaseCouponResp{}
+}
+
+func (p *ReleaseCouponResp) InitDefault() {
+}
+
+var ReleaseCouponResp_Base_DEFAULT *BaseResp
+
+func (p *ReleaseCouponResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ReleaseCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ReleaseCouponResp) GetReleased() (v int32) {
+	return p.Released
+}
+func (p *ReleaseCouponResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *ReleaseCouponResp) SetReleased(val int32) {
+	p.Released = val
+}
+
+func (p *ReleaseCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ReleaseCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ReleaseCouponResp(%+v)", *p)
+}
+
+var fieldIDToName_ReleaseCouponResp = map[int16]string{
+	1: "base",
+	2: "released",
+}
+
+type GenerateCodesReq struct {
+	CouponId  int64  `thrift:"coupon_id,1" frugal:"1,default,i64" json:"coupon_id"`
+	Count     int32  `thrift:"count,2" frugal:"2,default,i32" json:"count"`
+	BatchName string `thrift:"batch_name,3" frugal:"3,default,string" json:"batch_name"`
+}
+
+func NewGenerateCodesReq() *GenerateCodesReq {
+	return &GenerateCodesReq{}
+}
+
+func (p *GenerateCodesReq) InitDefault() {
+}
+
+func (p *GenerateCodesReq) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *GenerateCodesReq) GetCount() (v int32) {
+	return p.Count
+}
+
+func (p *GenerateCodesReq) GetBatchName() (v string) {
+	return p.BatchName
+}
+func (p *GenerateCodesReq) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *GenerateCodesReq) SetCount(val int32) {
+	p.Count = val
+}
+func (p *GenerateCodesReq) SetBatchName(val string) {
+	p.BatchName = val
+}
+
+func (p *GenerateCodesReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GenerateCodesReq(%+v)", *p)
+}
+
+var fieldIDToName_GenerateCodesReq = map[int16]string{
+	1: "coupon_id",
+	2: "count",
+	3: "batch_name",
+}
+
+type GenerateCodesResp struct {
+	Base    *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	BatchId int64     `thrift:"batch_id,2" frugal:"2,default,i64" json:"batch_id"`
+}
+
+func NewGenerateCodesResp() *GenerateCodesResp {
+	return &GenerateCodesResp{}
+}
+
+func (p *GenerateCodesResp) InitDefault() {
+}
+
+var GenerateCodesResp_Base_DEFAULT *BaseResp
+
+func (p *GenerateCodesResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return GenerateCodesResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GenerateCodesResp) GetBatchId() (v int64) {
+	return p.BatchId
+}
+func (p *GenerateCodesResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *GenerateCodesResp) SetBatchId(val int64) {
+	p.BatchId = val
+}
+
+func (p *GenerateCodesResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GenerateCodesResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GenerateCodesResp(%+v)", *p)
+}
+
+var fieldIDToName_GenerateCodesResp = map[int16]string{
+	1: "base",
+	2: "batch_id",
+}
+
+type RedeemCodeReq struct {
+	UserId int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Code   string `thrift:"code,2" frugal:"2,default,string" json:"code"`
+}
+
+func NewRedeemCodeReq() *RedeemCodeReq {
+	return &RedeemCodeReq{}
+}
+
+func (p *RedeemCodeReq) InitDefault() {
+}
+
+func (p *RedeemCodeReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *RedeemCodeReq) GetCode() (v string) {
+	return p.Code
+}
+func (p *RedeemCodeReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RedeemCodeReq) SetCode(val string) {
+	p.Code = val
+}
+
+func (p *RedeemCodeReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RedeemCodeReq(%+v)", *p)
+}
+
+var fieldIDToName_RedeemCodeReq = map[int16]string{
+	1: "user_id",
+	2: "code",
+}
+
+type RedeemCodeResp struct {
+	Base         *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	UserCouponId int64     `thrift:"user_coupon_id,2" frugal:"2,default,i64" json:"user_coupon_id"`
+}
+
+func NewRedeemCodeResp() *RedeemCodeResp {
+	return &RedeemCodeResp{}
+}
+
+func (p *RedeemCodeResp) InitDefault() {
+}
+
+var RedeemCodeResp_Base_DEFAULT *BaseResp
+
+func (p *RedeemCodeResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return RedeemCodeResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *RedeemCodeResp) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+func (p *RedeemCodeResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *RedeemCodeResp) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+
+func (p *RedeemCodeResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RedeemCodeResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RedeemCodeResp(%+v)", *p)
+}
+
+var fieldIDToName_RedeemCodeResp = map[int16]string{
+	1: "base",
+	2: "user_coupon_id",
+}
+
+type ExportCodeBatchReq struct {
+	BatchId int64 `thrift:"batch_id,1" frugal:"1,default,i64" json:"batch_id"`
+}
+
+func NewExportCodeBatchReq() *ExportCodeBatchReq {
+	return &ExportCodeBatchReq{}
+}
+
+func (p *ExportCodeBatchReq) InitDefault() {
+}
+
+func (p *ExportCodeBatchReq) GetBatchId() (v int64) {
+	return p.BatchId
+}
+func (p *ExportCodeBatchReq) SetBatchId(val int64) {
+	p.BatchId = val
+}
+
+func (p *ExportCodeBatchReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportCodeBatchReq(%+v)", *p)
+}
+
+var fieldIDToName_ExportCodeBatchReq = map[int16]string{
+	1: "batch_id",
+}
+
+type ExportCodeBatchResp struct {
+	Base       *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	FileName   string    `thrift:"file_name,2" frugal:"2,default,string" json:"file_name"`
+	CsvContent []byte    `thrift:"csv_content,3" frugal:"3,default,binary" json:"csv_content"`
+}
+
+func NewExportCodeBatchResp() *ExportCodeBatchResp {
+	return &ExportCodeBatchResp{}
+}
+
+func (p *ExportCodeBatchResp) InitDefault() {
+}
+
+var ExportCodeBatchResp_Base_DEFAULT *BaseResp
+
+func (p *ExportCodeBatchResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ExportCodeBatchResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ExportCodeBatchResp) GetFileName() (v string) {
+	return p.FileName
+}
+
+func (p *ExportCodeBatchResp) GetCsvContent() (v []byte) {
+	return p.CsvContent
+}
+func (p *ExportCodeBatchResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *ExportCodeBatchResp) SetFileName(val string) {
+	p.FileName = val
+}
+func (p *ExportCodeBatchResp) SetCsvContent(val []byte) {
+	p.CsvContent = val
+}
+
+func (p *ExportCodeBatchResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ExportCodeBatchResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ExportCodeBatchResp(%+v)", *p)
+}
+
+var fieldIDToName_ExportCodeBatchResp = map[int16]string{
+	1: "base",
+	2: "file_name",
+	3: "csv_content",
+}
+
+type RevokeCodeBatchReq struct {
+	BatchId int64 `thrift:"batch_id,1" frugal:"1,default,i64" json:"batch_id"`
+}
+
+func NewRevokeCodeBatchReq() *RevokeCodeBatchReq {
+	return &RevokeCodeBatchReq{}
+}
+
+func (p *RevokeCodeBatchReq) InitDefault() {
+}
+
+func (p *RevokeCodeBatchReq) GetBatchId() (v int64) {
+	return p.BatchId
+}
+func (p *RevokeCodeBatchReq) SetBatchId(val int64) {
+	p.BatchId = val
+}
+
+func (p *RevokeCodeBatchReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeCodeBatchReq(%+v)", *p)
+}
+
+var fieldIDToName_RevokeCodeBatchReq = map[int16]string{
+	1: "batch_id",
+}
+
+type RevokeCodeBatchResp struct {
+	Base         *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	RevokedCount int32     `thrift:"revoked_count,2" frugal:"2,default,i32" json:"revoked_count"`
+}
+
+func NewRevokeCodeBatchResp() *RevokeCodeBatchResp {
+	return &RevokeCodeBatchResp{}
+}
+
+func (p *RevokeCodeBatchResp) InitDefault() {
+}
+
+var RevokeCodeBatchResp_Base_DEFAULT *BaseResp
+
+func (p *RevokeCodeBatchResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return RevokeCodeBatchResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *RevokeCodeBatchResp) GetRevokedCount() (v int32) {
+	return p.RevokedCount
+}
+func (p *RevokeCodeBatchResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *RevokeCodeBatchResp) SetRevokedCount(val int32) {
+	p.RevokedCount = val
+}
+
+func (p *RevokeCodeBatchResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RevokeCodeBatchResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeCodeBatchResp(%+v)", *p)
+}
+
+var fieldIDToName_RevokeCodeBatchResp = map[int16]string{
+	1: "base",
+	2: "revoked_count",
+}
+
+type CreateSegmentReq struct {
+	SegmentName string `thrift:"segment_name,1" frugal:"1,default,string" json:"segment_name"`
+	Rule        string `thrift:"rule,2" frugal:"2,default,string" json:"rule"`
+	Remark      string `thrift:"remark,3" frugal:"3,default,string" json:"remark"`
+}
+
+func NewCreateSegmentReq() *CreateSegmentReq {
+	return &CreateSegmentReq{}
+}
+
+func (p *CreateSegmentReq) InitDefault() {
+}
+
+func (p *CreateSegmentReq) GetSegmentName() (v string) {
+	return p.SegmentName
+}
+
+func (p *CreateSegmentReq) GetRule() (v string) {
+	return p.Rule
+}
+
+func (p *CreateSegmentReq) GetRemark() (v string) {
+	return p.Remark
+}
+func (p *CreateSegmentReq) SetSegmentName(val string) {
+	p.SegmentName = val
+}
+func (p *CreateSegmentReq) SetRule(val string) {
+	p.Rule = val
+}
+func (p *CreateSegmentReq) SetRemark(val string) {
+	p.Remark = val
+}
+
+func (p *CreateSegmentReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateSegmentReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateSegmentReq = map[int16]string{
+	1: "segment_name",
+	2: "rule",
+	3: "remark",
+}
+
+type CreateSegmentResp struct {
+	Base           *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	SegmentId      int64     `thrift:"segment_id,2" frugal:"2,default,i64" json:"segment_id"`
+	EstimatedUsers int64     `thrift:"estimated_users,3" frugal:"3,default,i64" json:"estimated_users"`
+}
+
+func NewCreateSegmentResp() *CreateSegmentResp {
+	return &CreateSegmentResp{}
+}
+
+func (p *CreateSegmentResp) InitDefault() {
+}
+
+var CreateSegmentResp_Base_DEFAULT *BaseResp
+
+func (p *CreateSegmentResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return CreateSegmentResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CreateSegmentResp) GetSegmentId() (v int64) {
+	return p.SegmentId
+}
+
+func (p *CreateSegmentResp) GetEstimatedUsers() (v int64) {
+	return p.EstimatedUsers
+}
+func (p *CreateSegmentResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *CreateSegmentResp) SetSegmentId(val int64) {
+	p.SegmentId = val
+}
+func (p *CreateSegmentResp) SetEstimatedUsers(val int64) {
+	p.EstimatedUsers = val
+}
+
+func (p *CreateSegmentResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateSegmentResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateSegmentResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateSegmentResp = map[int16]string{
+	1: "base",
+	2: "segment_id",
+	3: "estimated_users",
+}
+
+type DistributeCouponReq struct {
+	CouponId  int64  `thrift:"coupon_id,1" frugal:"1,default,i64" json:"coupon_id"`
+	SegmentId int64  `thrift:"segment_id,2" frugal:"2,default,i64" json:"segment_id"`
+	Operator  string `thrift:"operator,3" frugal:"3,default,string" json:"operator"`
+}
+
+func NewDistributeCouponReq() *DistributeCouponReq {
+	return &DistributeCouponReq{}
+}
+
+func (p *DistributeCouponReq) InitDefault() {
+}
+
+func (p *DistributeCouponReq) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *DistributeCouponReq) GetSegmentId() (v int64) {
+	return p.SegmentId
+}
+
+func (p *DistributeCouponReq) GetOperator() (v string) {
+	return p.Operator
+}
+func (p *DistributeCouponReq) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *DistributeCouponReq) SetSegmentId(val int64) {
+	p.SegmentId = val
+}
+func (p *DistributeCouponReq) SetOperator(val string) {
+	p.Operator = val
+}
+
+func (p *DistributeCouponReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DistributeCouponReq(%+v)", *p)
+}
+
+var fieldIDToName_DistributeCouponReq = map[int16]string{
+	1: "coupon_id",
+	2: "segment_id",
+	3: "operator",
+}
+
+type DistributeCouponResp struct {
+	Base           *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	DistributionId int64     `thrift:"distribution_id,2" frugal:"2,default,i64" json:"distribution_id"`
+	EstimatedUsers int64     `thrift:"estimated_users,3" frugal:"3,default,i64" json:"estimated_users"`
+}
+
+func NewDistributeCouponResp() *DistributeCouponResp {
+	return &DistributeCouponResp{}
+}
+
+func (p *DistributeCouponResp) InitDefault() {
+}
+
+var DistributeCouponResp_Base_DEFAULT *BaseResp
+
+func (p *DistributeCouponResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return DistributeCouponResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *DistributeCouponResp) GetDistributionId() (v int64) {
+	return p.DistributionId
+}
+
+func (p *DistributeCouponResp) GetEstimatedUsers() (v int64) {
+	return p.EstimatedUsers
+}
+func (p *DistributeCouponResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *DistributeCouponResp) SetDistributionId(val int64) {
+	p.DistributionId = val
+}
+func (p *DistributeCouponResp) SetEstimatedUsers(val int64) {
+	p.EstimatedUsers = val
+}
+
+func (p *DistributeCouponResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *DistributeCouponResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DistributeCouponResp(%+v)", *p)
+}
+
+var fieldIDToName_DistributeCouponResp = map[int16]string{
+	1: "base",
+	2: "distribution_id",
+	3: "estimated_users",
+}
+
+type DistributionInfo struct {
+	Id             int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	CouponId       int64  `thrift:"coupon_id,2" frugal:"2,default,i64" json:"coupon_id"`
+	SegmentId      int64  `thrift:"segment_id,3" frugal:"3,default,i64" json:"segment_id"`
+	DistStatus     string `thrift:"dist_status,4" frugal:"4,default,string" json:"dist_status"`
+	EstimatedUsers int64  `thrift:"estimated_users,5" frugal:"5,default,i64" json:"estimated_users"`
+	MatchedCount   int64  `thrift:"matched_count,6" frugal:"6,default,i64" json:"matched_count"`
+	IssuedCount    int64  `thrift:"issued_count,7" frugal:"7,default,i64" json:"issued_count"`
+	SkippedCount   int64  `thrift:"skipped_count,8" frugal:"8,default,i64" json:"skipped_count"`
+	LastUserId     int64  `thrift:"last_user_id,9" frugal:"9,default,i64" json:"last_user_id"`
+	LastError      string `thrift:"last_error,10" frugal:"10,default,string" json:"last_error"`
+	CreatedAt      int64  `thrift:"created_at,11" frugal:"11,default,i64" json:"created_at"`
+	FinishedAt     int64  `thrift:"finished_at,12" frugal:"12,default,i64" json:"finished_at"`
+}
+
+func NewDistributionInfo() *DistributionInfo {
+	return &DistributionInfo{}
+}
+
+func (p *DistributionInfo) InitDefault() {
+}
+
+func (p *DistributionInfo) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *DistributionInfo) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *DistributionInfo) GetSegmentId() (v int64) {
+	return p.SegmentId
+}
+
+func (p *DistributionInfo) GetDistStatus() (v string) {
+	return p.DistStatus
+}
+
+func (p *DistributionInfo) GetEstimatedUsers() (v int64) {
+	return p.EstimatedUsers
+}
+
+func (p *DistributionInfo) GetMatchedCount() (v int64) {
+	return p.MatchedCount
+}
+
+func (p *DistributionInfo) GetIssuedCount() (v int64) {
+	return p.IssuedCount
+}
+
+func (p *DistributionInfo) GetSkippedCount() (v int64) {
+	return p.SkippedCount
+}
+
+func (p *DistributionInfo) GetLastUserId() (v int64) {
+	return p.LastUserId
+}
+
+func (p *DistributionInfo) GetLastError() (v string) {
+	return p.LastError
+}
+
+func (p *DistributionInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *DistributionInfo) GetFinishedAt() (v int64) {
+	return p.FinishedAt
+}
+func (p *DistributionInfo) SetId(val int64) {
+	p.Id = val
+}
+func (p *DistributionInfo) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *DistributionInfo) SetSegmentId(val int64) {
+	p.SegmentId = val
+}
+func (p *DistributionInfo) SetDistStatus(val string) {
+	p.DistStatus = val
+}
+func (p *DistributionInfo) SetEstimatedUsers(val int64) {
+	p.EstimatedUsers = val
+}
+func (p *DistributionInfo) SetMatchedCount(val int64) {
+	p.MatchedCount = val
+}
+func (p *DistributionInfo) SetIssuedCount(val int64) {
+	p.IssuedCount = val
+}
+func (p *DistributionInfo) SetSkippedCount(val int64) {
+	p.SkippedCount = val
+}
+func (p *DistributionInfo) SetLastUserId(val int64) {
+	p.LastUserId = val
+}
+func (p *DistributionInfo) SetLastError(val string) {
+	p.LastError = val
+}
+func (p *DistributionInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *DistributionInfo) SetFinishedAt(val int64) {
+	p.FinishedAt = val
+}
+
+func (p *DistributionInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DistributionInfo(%+v)", *p)
+}
+
+var fieldIDToName_DistributionInfo = map[int16]string{
+	1:  "id",
+	2:  "coupon_id",
+	3:  "segment_id",
+	4:  "dist_status",
+	5:  "estimated_users",
+	6:  "matched_count",
+	7:  "issued_count",
+	8:  "skipped_count",
+	9:  "last_user_id",
+	10: "last_error",
+	11: "created_at",
+	12: "finished_at",
+}
+
+type GetDistributionReq struct {
+	DistributionId int64 `thrift:"distribution_id,1" frugal:"1,default,i64" json:"distribution_id"`
+}
+
+func NewGetDistributionReq() *GetDistributionReq {
+	return &GetDistributionReq{}
+}
+
+func (p *GetDistributionReq) InitDefault() {
+}
+
+func (p *GetDistributionReq) GetDistributionId() (v int64) {
+	return p.DistributionId
+}
+func (p *GetDistributionReq) SetDistributionId(val int64) {
+	p.DistributionId = val
+}
+
+func (p *GetDistributionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetDistributionReq(%+v)", *p)
+}
+
+var fieldIDToName_GetDistributionReq = map[int16]string{
+	1: "distribution_id",
+}
+
+type GetDistributionResp struct {
+	Base         *BaseResp         `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Distribution *DistributionInfo `thrift:"distribution,2" frugal:"2,default,DistributionInfo" json:"distribution"`
+}
+
+func NewGetDistributionResp() *GetDistributionResp {
+	return &GetDistributionResp{}
+}
+
+func (p *GetDistributionResp) InitDefault() {
+}
+
+var GetDistributionResp_Base_DEFAULT *BaseResp
+
+func (p *GetDistributionResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return GetDistributionResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetDistributionResp_Distribution_DEFAULT *DistributionInfo
+
+func (p *GetDistributionResp) GetDistribution() (v *DistributionInfo) {
+	if !p.IsSetDistribution() {
+		return GetDistributionResp_Distribution_DEFAULT
+	}
+	return p.Distribution
+}
+func (p *GetDistributionResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *GetDistributionResp) SetDistribution(val *DistributionInfo) {
+	p.Distribution = val
+}
+
+func (p *GetDistributionResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetDistributionResp) IsSetDistribution() bool {
+	return p.Distribution != nil
+}
+
+func (p *GetDistributionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetDistributionResp(%+v)", *p)
+}
+
+var fieldIDToName_GetDistributionResp = map[int16]string{
+	1: "base",
+	2: "distribution",
+}
+
+type ResumeDistributionReq struct {
+	DistributionId int64 `thrift:"distribution_id,1" frugal:"1,default,i64" json:"distribution_id"`
+}
+
+func NewResumeDistributionReq() *ResumeDistributionReq {
+	return &ResumeDistributionReq{}
+}
+
+func (p *ResumeDistributionReq) InitDefault() {
+}
+
+func (p *ResumeDistributionReq) GetDistributionId() (v int64) {
+	return p.DistributionId
+}
+func (p *ResumeDistributionReq) SetDistributionId(val int64) {
+	p.DistributionId = val
+}
+
+func (p *ResumeDistributionReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResumeDistributionReq(%+v)", *p)
+}
+
+var fieldIDToName_ResumeDistributionReq = map[int16]string{
+	1: "distribution_id",
+}
+
+type ResumeDistributionResp struct {
+	Base *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+}
+
+func NewResumeDistributionResp() *ResumeDistributionResp {
+	return &ResumeDistributionResp{}
+}
+
+func (p *ResumeDistributionResp) InitDefault() {
+}
+
+var ResumeDistributionResp_Base_DEFAULT *BaseResp
+
+func (p *ResumeDistributionResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ResumeDistributionResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *ResumeDistributionResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+
+func (p *ResumeDistributionResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ResumeDistributionResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResumeDistributionResp(%+v)", *p)
+}
+
+var fieldIDToName_ResumeDistributionResp = map[int16]string{
+	1: "base",
+}
+
+type GetCouponStatsReq struct {
+	CouponId           int64  `thrift:"coupon_id,1" frugal:"1,default,i64" json:"coupon_id"`
+	StartDate          string `thrift:"start_date,2" frugal:"2,default,string" json:"start_date"`
+	EndDate            string `thrift:"end_date,3" frugal:"3,default,string" json:"end_date"`
+	SpotId             int64  `thrift:"spot_id,4" frugal:"4,default,i64" json:"spot_id"`
+	OperatorMerchantId int64  `thrift:"operator_merchant_id,5" frugal:"5,default,i64" json:"operator_merchant_id"`
+}
+
+func NewGetCouponStatsReq() *GetCouponStatsReq {
+	return &GetCouponStatsReq{}
+}
+
+func (p *GetCouponStatsReq) InitDefault() {
+}
+
+func (p *GetCouponStatsReq) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *GetCouponStatsReq) GetStartDate() (v string) {
+	return p.StartDate
+}
+
+func (p *GetCouponStatsReq) GetEndDate() (v string) {
+	return p.EndDate
+}
+
+func (p *GetCouponStatsReq) GetSpotId() (v int64) {
+	return p.SpotId
+}
+
+func (p *GetCouponStatsReq) GetOperatorMerchantId() (v int64) {
+	return p.OperatorMerchantId
+}
+func (p *GetCouponStatsReq) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *GetCouponStatsReq) SetStartDate(val string) {
+	p.StartDate = val
+}
+func (p *GetCouponStatsReq) SetEndDate(val string) {
+	p.EndDate = val
+}
+func (p *GetCouponStatsReq) SetSpotId(val int64) {
+	p.SpotId = val
+}
+func (p *GetCouponStatsReq) SetOperatorMerchantId(val int64) {
+	p.OperatorMerchantId = val
+}
+
+func (p *GetCouponStatsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCouponStatsReq(%+v)", *p)
+}
+
+var fieldIDToName_GetCouponStatsReq = map[int16]string{
+	1: "coupon_id",
+	2: "start_date",
+	3: "end_date",
+	4: "spot_id",
+	5: "operator_merchant_id",
+}
+
+type CouponStatsSummary struct {
+	CouponId       int64   `thrift:"coupon_id,1" frugal:"1,default,i64" json:"coupon_id"`
+	CouponName     string  `thrift:"coupon_name,2" frugal:"2,default,string" json:"coupon_name"`
+	RemainingStock int64   `thrift:"remaining_stock,3" frugal:"3,default,i64" json:"remaining_stock"`
+	IssuedCount    int64   `thrift:"issued_count,4" frugal:"4,default,i64" json:"issued_count"`
+	UnusedCount    int64   `thrift:"unused_count,5" frugal:"5,default,i64" json:"unused_count"`
+	LockedCount    int64   `thrift:"locked_count,6" frugal:"6,default,i64" json:"locked_count"`
+	UsedCount      int64   `thrift:"used_count,7" frugal:"7,default,i64" json:"used_count"`
+	ExpiredCount   int64   `thrift:"expired_count,8" frugal:"8,default,i64" json:"expired_count"`
+	RedemptionRate float64 `thrift:"redemption_rate,9" frugal:"9,default,double" json:"redemption_rate"`
+	DiscountAmount float64 `thrift:"discount_amount,10" frugal:"10,default,double" json:"discount_amount"`
+	GmvAmount      float64 `thrift:"gmv_amount,11" frugal:"11,default,double" json:"gmv_amount"`
+	RefreshedAt    int64   `thrift:"refreshed_at,12" frugal:"12,default,i64" json:"refreshed_at"`
+}
+
+func NewCouponStatsSummary() *CouponStatsSummary {
+	return &CouponStatsSummary{}
+}
+
+func (p *CouponStatsSummary) InitDefault() {
+}
+
+func (p *CouponStatsSummary) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *CouponStatsSummary) GetCouponName() (v string) {
+	return p.CouponName
+}
+
+func (p *CouponStatsSummary) GetRemainingStock() (v int64) {
+	return p.RemainingStock
+}
+
+func (p *CouponStatsSummary) GetIssuedCount() (v int64) {
+	return p.IssuedCount
+}
+
+func (p *CouponStatsSummary) GetUnusedCount() (v int64) {
+	return p.UnusedCount
+}
+
+func (p *CouponStatsSummary) GetLockedCount() (v int64) {
+	return p.LockedCount
+}
+
+func (p *CouponStatsSummary) GetUsedCount() (v int64) {
+	return p.UsedCount
+}
+
+func (p *CouponStatsSummary) GetExpiredCount() (v int64) {
+	return p.ExpiredCount
+}
+
+func (p *CouponStatsSummary) GetRedemptionRate() (v float64) {
+	return p.RedemptionRate
+}
+
+func (p *CouponStatsSummary) GetDiscountAmount() (v float64) {
+	return p.DiscountAmount
+}
+
+func (p *CouponStatsSummary) GetGmvAmount() (v float64) {
+	return p.GmvAmount
+}
+
+func (p *CouponStatsSummary) GetRefreshedAt() (v int64) {
+	return p.RefreshedAt
+}
+func (p *CouponStatsSummary) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *CouponStatsSummary) SetCouponName(val string) {
+	p.CouponName = val
+}
+func (p *CouponStatsSummary) SetRemainingStock(val int64) {
+	p.RemainingStock = val
+}
+func (p *CouponStatsSummary) SetIssuedCount(val int64) {
+	p.IssuedCount = val
+}
+func (p *CouponStatsSummary) SetUnusedCount(val int64) {
+	p.UnusedCount = val
+}
+func (p *CouponStatsSummary) SetLockedCount(val int64) {
+	p.LockedCount = val
+}
+func (p *CouponStatsSummary) SetUsedCount(val int64) {
+	p.UsedCount = val
+}
+func (p *CouponStatsSummary) SetExpiredCount(val int64) {
+	p.ExpiredCount = val
+}
+func (p *CouponStatsSummary) SetRedemptionRate(val float64) {
+	p.RedemptionRate = val
+}
+func (p *CouponStatsSummary) SetDiscountAmount(val float64) {
+	p.DiscountAmount = val
+}
+func (p *CouponStatsSummary) SetGmvAmount(val float64) {
+	p.GmvAmount = val
+}
+func (p *CouponStatsSummary) SetRefreshedAt(val int64) {
+	p.RefreshedAt = val
+}
+
+func (p *CouponStatsSummary) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponStatsSummary(%+v)", *p)
+}
+
+var fieldIDToName_CouponStatsSummary = map[int16]string{
+	1:  "coupon_id",
+	2:  "coupon_name",
+	3:  "remaining_stock",
+	4:  "issued_count",
+	5:  "unused_count",
+	6:  "locked_count",
+	7:  "used_count",
+	8:  "expired_count",
+	9:  "redemption_rate",
+	10: "discount_amount",
+	11: "gmv_amount",
+	12: "refreshed_at",
+}
+
+type CouponDailyStat struct {
+	StatDate       string  `thrift:"stat_date,1" frugal:"1,default,string" json:"stat_date"`
+	SpotId         int64   `thrift:"spot_id,2" frugal:"2,default,i64" json:"spot_id"`
+	ClaimedCount   int64   `thrift:"claimed_count,3" frugal:"3,default,i64" json:"claimed_count"`
+	UsedCount      int64   `thrift:"used_count,4" frugal:"4,default,i64" json:"used_count"`
+	ExpiredCount   int64   `thrift:"expired_count,5" frugal:"5,default,i64" json:"expired_count"`
+	DiscountAmount float64 `thrift:"discount_amount,6" frugal:"6,default,double" json:"discount_amount"`
+	GmvAmount      float64 `thrift:"gmv_amount,7" frugal:"7,default,double" json:"gmv_amount"`
+}
+
+func NewCouponDailyStat() *CouponDailyStat {
+	return &CouponDailyStat{}
+}
+
+func (p *CouponDailyStat) InitDefault() {
+}
+
+func (p *CouponDailyStat) GetStatDate() (v string) {
+	return p.StatDate
+}
+
+func (p *CouponDailyStat) GetSpotId() (v int64) {
+	return p.SpotId
+}
+
+func (p *CouponDailyStat) GetClaimedCount() (v int64) {
+	return p.ClaimedCount
+}
+
+func (p *CouponDailyStat) GetUsedCount() (v int64) {
+	return p.UsedCount
+}
+
+func (p *CouponDailyStat) GetExpiredCount() (v int64) {
+	return p.ExpiredCount
+}
+
+func (p *CouponDailyStat) GetDiscountAmount() (v float64) {
+	return p.DiscountAmount
+}
+
+func (p *CouponDailyStat) GetGmvAmount() (v float64) {
+	return p.GmvAmount
+}
+func (p *CouponDailyStat) SetStatDate(val string) {
+	p.StatDate = val
+}
+func (p *CouponDailyStat) SetSpotId(val int64) {
+	p.SpotId = val
+}
+func (p *CouponDailyStat) SetClaimedCount(val int64) {
+	p.ClaimedCount = val
+}
+func (p *CouponDailyStat) SetUsedCount(val int64) {
+	p.UsedCount = val
+}
+func (p *CouponDailyStat) SetExpiredCount(val int64) {
+	p.ExpiredCount = val
+}
+func (p *CouponDailyStat) SetDiscountAmount(val float64) {
+	p.DiscountAmount = val
+}
+func (p *CouponDailyStat) SetGmvAmount(val float64) {
+	p.GmvAmount = val
+}
+
+func (p *CouponDailyStat) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponDailyStat(%+v)", *p)
+}
+
+var fieldIDToName_CouponDailyStat = map[int16]string{
+	1: "stat_date",
+	2: "spot_id",
+	3: "claimed_count",
+	4: "used_count",
+	5: "expired_count",
+	6: "discount_amount",
+	7: "gmv_amount",
+}
+
+type CouponSpotStat struct {
+	SpotId         int64   `thrift:"spot_id,1" frugal:"1,default,i64" json:"spot_id"`
+	SpotName       string  `thrift:"spot_name,2" frugal:"2,default,string" json:"spot_name"`
+	UsedCount      int64   `thrift:"used_count,3" frugal:"3,default,i64" json:"used_count"`
+	DiscountAmount float64 `thrift:"discount_amount,4" frugal:"4,default,double" json:"discount_amount"`
+	GmvAmount      float64 `thrift:"gmv_amount,5" frugal:"5,default,double" json:"gmv_amount"`
+}
+
+func NewCouponSpotStat() *CouponSpotStat {
+	return &CouponSpotStat{}
+}
+
+func (p *CouponSpotStat) InitDefault() {
+}
+
+func (p *CouponSpotStat) GetSpotId() (v int64) {
+	return p.SpotId
+}
+
+func (p *CouponSpotStat) GetSpotName() (v string) {
+	return p.SpotName
+}
+
+func (p *CouponSpotStat) GetUsedCount() (v int64) {
+	return p.UsedCount
+}
+
+func (p *CouponSpotStat) GetDiscountAmount() (v float64) {
+	return p.DiscountAmount
+}
+
+func (p *CouponSpotStat) GetGmvAmount() (v float64) {
+	return p.GmvAmount
+}
+func (p *CouponSpotStat) SetSpotId(val int64) {
+	p.SpotId = val
+}
+func (p *CouponSpotStat) SetSpotName(val string) {
+	p.SpotName = val
+}
+func (p *CouponSpotStat) SetUsedCount(val int64) {
+	p.UsedCount = val
+}
+func (p *CouponSpotStat) SetDiscountAmount(val float64) {
+	p.DiscountAmount = val
+}
+func (p *CouponSpotStat) SetGmvAmount(val float64) {
+	p.GmvAmount = val
+}
+
+func (p *CouponSpotStat) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponSpotStat(%+v)", *p)
+}
+
+var fieldIDToName_CouponSpotStat = map[int16]string{
+	1: "spot_id",
+	2: "spot_name",
+	3: "used_count",
+	4: "discount_amount",
+	5: "gmv_amount",
+}
+
+type GetCouponStatsResp struct {
+	Base    *BaseResp           `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Summary *CouponStatsSummary `thrift:"summary,2" frugal:"2,default,CouponStatsSummary" json:"summary"`
+	Daily   []*CouponDailyStat  `thrift:"daily,3" frugal:"3,default,list<CouponDailyStat>" json:"daily"`
+	Spots   []*CouponSpotStat   `thrift:"spots,4" frugal:"4,default,list<CouponSpotStat>" json:"spots"`
+}
+
+func NewGetCouponStatsResp() *GetCouponStatsResp {
+	return &GetCouponStatsResp{}
+}
+
+func (p *GetCouponStatsResp) InitDefault() {
+}
+
+var GetCouponStatsResp_Base_DEFAULT *BaseResp
+
+func (p *GetCouponStatsResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return GetCouponStatsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetCouponStatsResp_Summary_DEFAULT *CouponStatsSummary
+
+func (p *GetCouponStatsResp) GetSummary() (v *CouponStatsSummary) {
+	if !p.IsSetSummary() {
+		return GetCouponStatsResp_Summary_DEFAULT
+	}
+	return p.Summary
+}
+
+func (p *GetCouponStatsResp) GetDaily() (v []*CouponDailyStat) {
+	return p.Daily
+}
+
+func (p *GetCouponStatsResp) GetSpots() (v []*CouponSpotStat) {
+	return p.Spots
+}
+func (p *GetCouponStatsResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *GetCouponStatsResp) SetSummary(val *CouponStatsSummary) {
+	p.Summary = val
+}
+func (p *GetCouponStatsResp) SetDaily(val []*CouponDailyStat) {
+	p.Daily = val
+}
+func (p *GetCouponStatsResp) SetSpots(val []*CouponSpotStat) {
+	p.Spots = val
+}
+
+func (p *GetCouponStatsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetCouponStatsResp) IsSetSummary() bool {
+	return p.Summary != nil
+}
+
+func (p *GetCouponStatsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetCouponStatsResp(%+v)", *p)
+}
+
+var fieldIDToName_GetCouponStatsResp = map[int16]string{
+	1: "base",
+	2: "summary",
+	3: "daily",
+	4: "spots",
+}
+
+type CreateCouponTransferReq struct {
+	UserId       int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	UserCouponId int64 `thrift:"user_coupon_id,2" frugal:"2,default,i64" json:"user_coupon_id"`
+}
+
+func NewCreateCouponTransferReq() *CreateCouponTransferReq {
+	return &CreateCouponTransferReq{}
+}
+
+func (p *CreateCouponTransferReq) InitDefault() {
+}
+
+func (p *CreateCouponTransferReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CreateCouponTransferReq) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+func (p *CreateCouponTransferReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CreateCouponTransferReq) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+
+func (p *CreateCouponTransferReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateCouponTransferReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateCouponTransferReq = map[int16]string{
+	1: "user_id",
+	2: "user_coupon_id",
+}
+
+type CreateCouponTransferResp struct {
+	Base     *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Token    string    `thrift:"token,2" frugal:"2,default,string" json:"token"`
+	ExpireAt int64     `thrift:"expire_at,3" frugal:"3,default,i64" json:"expire_at"`
+}
+
+func NewCreateCouponTransferResp() *CreateCouponTransferResp {
+	return &CreateCouponTransferResp{}
+}
+
+func (p *CreateCouponTransferResp) InitDefault() {
+}
+
+var CreateCouponTransferResp_Base_DEFAULT *BaseResp
+
+func (p *CreateCouponTransferResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return CreateCouponTransferResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CreateCouponTransferResp) GetToken() (v string) {
+	return p.Token
+}
+
+func (p *CreateCouponTransferResp) GetExpireAt() (v int64) {
+	return p.ExpireAt
+}
+func (p *CreateCouponTransferResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *CreateCouponTransferResp) SetToken(val string) {
+	p.Token = val
+}
+func (p *CreateCouponTransferResp) SetExpireAt(val int64) {
+	p.ExpireAt = val
+}
+
+func (p *CreateCouponTransferResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateCouponTransferResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateCouponTransferResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateCouponTransferResp = map[int16]string{
+	1: "base",
+	2: "token",
+	3: "expire_at",
+}
+
+type AcceptCouponTransferReq struct {
+	UserId int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Token  string `thrift:"token,2" frugal:"2,default,string" json:"token"`
+}
+
+func NewAcceptCouponTransferReq() *AcceptCouponTransferReq {
+	return &AcceptCouponTransferReq{}
+}
+
+func (p *AcceptCouponTransferReq) InitDefault() {
+}
+
+func (p *AcceptCouponTransferReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *AcceptCouponTransferReq) GetToken() (v string) {
+	return p.Token
+}
+func (p *AcceptCouponTransferReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *AcceptCouponTransferReq) SetToken(val string) {
+	p.Token = val
+}
+
+func (p *AcceptCouponTransferReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AcceptCouponTransferReq(%+v)", *p)
+}
+
+var fieldIDToName_AcceptCouponTransferReq = map[int16]string{
+	1: "user_id",
+	2: "token",
+}
+
+type AcceptCouponTransferResp struct {
+	Base         *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	UserCouponId int64     `thrift:"user_coupon_id,2" frugal:"2,default,i64" json:"user_coupon_id"`
+}
+
+func NewAcceptCouponTransferResp() *AcceptCouponTransferResp {
+	return &AcceptCouponTransferResp{}
+}
+
+func (p *AcceptCouponTransferResp) InitDefault() {
+}
+
+var AcceptCouponTransferResp_Base_DEFAULT *BaseResp
+
+func (p *AcceptCouponTransferResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return AcceptCouponTransferResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *AcceptCouponTransferResp) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+func (p *AcceptCouponTransferResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *AcceptCouponTransferResp) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+
+func (p *AcceptCouponTransferResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *AcceptCouponTransferResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AcceptCouponTransferResp(%+v)", *p)
+}
+
+var fieldIDToName_AcceptCouponTransferResp = map[int16]string{
+	1: "base",
+	2: "user_coupon_id",
+}
+
+type CancelCouponTransferReq struct {
+	UserId int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Token  string `thrift:"token,2" frugal:"2,default,string" json:"token"`
+}
+
+func NewCancelCouponTransferReq() *CancelCouponTransferReq {
+	return &CancelCouponTransferReq{}
+}
+
+func (p *CancelCouponTransferReq) InitDefault() {
+}
+
+func (p *CancelCouponTransferReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CancelCouponTransferReq) GetToken() (v string) {
+	return p.Token
+}
+func (p *CancelCouponTransferReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CancelCouponTransferReq) SetToken(val string) {
+	p.Token = val
+}
+
+func (p *CancelCouponTransferReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelCouponTransferReq(%+v)", *p)
+}
+
+var fieldIDToName_CancelCouponTransferReq = map[int16]string{
+	1: "user_id",
+	2: "token",
+}
+
+type CancelCouponTransferResp struct {
+	Base *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+}
+
+func NewCancelCouponTransferResp() *CancelCouponTransferResp {
+	return &CancelCouponTransferResp{}
+}
+
+func (p *CancelCouponTransferResp) InitDefault() {
+}
+
+var CancelCouponTransferResp_Base_DEFAULT *BaseResp
+
+func (p *CancelCouponTransferResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return CancelCouponTransferResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *CancelCouponTransferResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+
+func (p *CancelCouponTransferResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CancelCouponTransferResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelCouponTransferResp(%+v)", *p)
+}
+
+var fieldIDToName_CancelCouponTransferResp = map[int16]string{
+	1: "base",
+}
+
+type CouponTransferInfo struct {
+	Id                   int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	UserCouponId         int64  `thrift:"user_coupon_id,2" frugal:"2,default,i64" json:"user_coupon_id"`
+	CouponId             int64  `thrift:"coupon_id,3" frugal:"3,default,i64" json:"coupon_id"`
+	FromUserId           int64  `thrift:"from_user_id,4" frugal:"4,default,i64" json:"from_user_id"`
+	ToUserId             int64  `thrift:"to_user_id,5" frugal:"5,default,i64" json:"to_user_id"`
+	ReceivedUserCouponId int64  `thrift:"received_user_coupon_id,6" frugal:"6,default,i64" json:"received_user_coupon_id"`
+	TransferStatus       string `thrift:"transfer_status,7" frugal:"7,default,string" json:"transfer_status"`
+	ExpireAt             int64  `thrift:"expire_at,8" frugal:"8,default,i64" json:"expire_at"`
+	ClaimedAt            int64  `thrift:"claimed_at,9" frugal:"9,default,i64" json:"claimed_at"`
+	CancelledAt          int64  `thrift:"cancelled_at,10" frugal:"10,default,i64" json:"cancelled_at"`
+	CreatedAt            int64  `thrift:"created_at,11" frugal:"11,default,i64" json:"created_at"`
+}
+
+func NewCouponTransferInfo() *CouponTransferInfo {
+	return &CouponTransferInfo{}
+}
+
+func (p *CouponTransferInfo) InitDefault() {
+}
+
+func (p *CouponTransferInfo) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *CouponTransferInfo) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+
+func (p *CouponTransferInfo) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *CouponTransferInfo) GetFromUserId() (v int64) {
+	return p.FromUserId
+}
+
+func (p *CouponTransferInfo) GetToUserId() (v int64) {
+	return p.ToUserId
+}
+
+func (p *CouponTransferInfo) GetReceivedUserCouponId() (v int64) {
+	return p.ReceivedUserCouponId
+}
+
+func (p *CouponTransferInfo) GetTransferStatus() (v string) {
+	return p.TransferStatus
+}
+
+func (p *CouponTransferInfo) GetExpireAt() (v int64) {
+	return p.ExpireAt
+}
+
+func (p *CouponTransferInfo) GetClaimedAt() (v int64) {
+	return p.ClaimedAt
+}
+
+func (p *CouponTransferInfo) GetCancelledAt() (v int64) {
+	return p.CancelledAt
+}
+
+func (p *CouponTransferInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *CouponTransferInfo) SetId(val int64) {
+	p.Id = val
+}
+func (p *CouponTransferInfo) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+func (p *CouponTransferInfo) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *CouponTransferInfo) SetFromUserId(val int64) {
+	p.FromUserId = val
+}
+func (p *CouponTransferInfo) SetToUserId(val int64) {
+	p.ToUserId = val
+}
+func (p *CouponTransferInfo) SetReceivedUserCouponId(val int64) {
+	p.ReceivedUserCouponId = val
+}
+func (p *CouponTransferInfo) SetTransferStatus(val string) {
+	p.TransferStatus = val
+}
+func (p *CouponTransferInfo) SetExpireAt(val int64) {
+	p.ExpireAt = val
+}
+func (p *CouponTransferInfo) SetClaimedAt(val int64) {
+	p.ClaimedAt = val
+}
+func (p *CouponTransferInfo) SetCancelledAt(val int64) {
+	p.CancelledAt = val
+}
+func (p *CouponTransferInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *CouponTransferInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponTransferInfo(%+v)", *p)
+}
+
+var fieldIDToName_CouponTransferInfo = map[int16]string{
+	1:  "id",
+	2:  "user_coupon_id",
+	3:  "coupon_id",
+	4:  "from_user_id",
+	5:  "to_user_id",
+	6:  "received_user_coupon_id",
+	7:  "transfer_status",
+	8:  "expire_at",
+	9:  "claimed_at",
+	10: "cancelled_at",
+	11: "created_at",
+}
+
+type ListCouponTransfersReq struct {
+	UserId       int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	UserCouponId int64 `thrift:"user_coupon_id,2" frugal:"2,default,i64" json:"user_coupon_id"`
+	Page         int32 `thrift:"page,3" frugal:"3,default,i32" json:"page"`
+	PageSize     int32 `thrift:"page_size,4" frugal:"4,default,i32" json:"page_size"`
+}
+
+func NewListCouponTransfersReq() *ListCouponTransfersReq {
+	return &ListCouponTransfersReq{}
+}
+
+func (p *ListCouponTransfersReq) InitDefault() {
+}
+
+func (p *ListCouponTransfersReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ListCouponTransfersReq) GetUserCouponId() (v int64) {
+	return p.UserCouponId
+}
+
+func (p *ListCouponTransfersReq) GetPage() (v int32) {
+	return p.Page
+}
+
+func (p *ListCouponTransfersReq) GetPageSize() (v int32) {
+	return p.PageSize
+}
+func (p *ListCouponTransfersReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ListCouponTransfersReq) SetUserCouponId(val int64) {
+	p.UserCouponId = val
+}
+func (p *ListCouponTransfersReq) SetPage(val int32) {
+	p.Page = val
+}
+func (p *ListCouponTransfersReq) SetPageSize(val int32) {
+	p.PageSize = val
+}
+
+func (p *ListCouponTransfersReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCouponTransfersReq(%+v)", *p)
+}
+
+var fieldIDToName_ListCouponTransfersReq = map[int16]string{
+	1: "user_id",
+	2: "user_coupon_id",
+	3: "page",
+	4: "page_size",
+}
+
+type ListCouponTransfersResp struct {
+	Base      *BaseResp             `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Transfers []*CouponTransferInfo `thrift:"transfers,2" frugal:"2,default,list<CouponTransferInfo>" json:"transfers"`
+	Total     int64                 `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+}
+
+func NewListCouponTransfersResp() *ListCouponTransfersResp {
+	return &ListCouponTransfersResp{}
+}
+
+func (p *ListCouponTransfersResp) InitDefault() {
+}
+
+var ListCouponTransfersResp_Base_DEFAULT *BaseResp
+
+func (p *ListCouponTransfersResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ListCouponTransfersResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListCouponTransfersResp) GetTransfers() (v []*CouponTransferInfo) {
+	return p.Transfers
+}
+
+func (p *ListCouponTransfersResp) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *ListCouponTransfersResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *ListCouponTransfersResp) SetTransfers(val []*CouponTransferInfo) {
+	p.Transfers = val
+}
+func (p *ListCouponTransfersResp) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *ListCouponTransfersResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListCouponTransfersResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListCouponTransfersResp(%+v)", *p)
+}
+
+var fieldIDToName_ListCouponTransfersResp = map[int16]string{
+	1: "base",
+	2: "transfers",
+	3: "total",
+}
+
+type CouponService interface {
+	CreateCoupon(ctx context.Context, req *CreateCouponReq) (r *CreateCouponResp, err error)
+
+	UpdateCoupon(ctx context.Context, req *UpdateCouponReq) (r *UpdateCouponResp, err error)
+
+	GetCoupon(ctx context.Context, req *GetCouponReq) (r *GetCouponResp, err error)
+
+	ListCoupons(ctx context.Context, req *ListCouponsReq) (r *ListCouponsResp, err error)
+
+	InvalidateCoupon(ctx context.Context, req *InvalidateCouponReq) (r *InvalidateCouponResp, err error)
+
+	ClaimCoupon(ctx context.Context, req *ClaimCouponReq) (r *ClaimCouponResp, err error)
+
+	GetClaimStatus(ctx context.Context, req *GetClaimStatusReq) (r *GetClaimStatusResp, err error)
+
+	PreviewOrderPrice(ctx context.Context, req *PreviewOrderPriceReq) (r *PreviewOrderPriceResp, err error)
+
+	RecommendCoupons(ctx context.Context, req *RecommendCouponsReq) (r *RecommendCouponsResp, err error)
+
+	LockCoupon(ctx context.Context, req *LockCouponReq) (r *LockCouponResp, err error)
+
+	ConsumeCoupon(ctx context.Context, req *ConsumeCouponReq) (r *ConsumeCouponResp, err error)
+
+	ReleaseCoupon(ctx context.Context, req *ReleaseCouponReq) (r *ReleaseCouponResp, err error)
+
+	GenerateCodes(ctx context.Context, req *GenerateCodesReq) (r *GenerateCodesResp, err error)
+
+	RedeemCode(ctx context.Context, req *RedeemCodeReq) (r *RedeemCodeResp, err error)
+
+	ExportCodeBatch(ctx context.Context, req *ExportCodeBatchReq) (r *ExportCodeBatchResp, err error)
+
+	RevokeCodeBatch(ctx context.Context, req *RevokeCodeBatchReq) (r *RevokeCodeBatchResp, err error)
+
+	ListCouponsForSpot(ctx context.Context, req *ListCouponsForSpotReq) (r *ListCouponsForSpotResp, err error)
+
+	CreateSegment(ctx context.Context, req *CreateSegmentReq) (r *CreateSegmentResp, err error)
+
+	DistributeCoupon(ctx context.Context, req *DistributeCouponReq) (r *DistributeCouponResp, err error)
+
+	GetDistribution(ctx context.Context, req *GetDistributionReq) (r *GetDistributionResp, err error)
+
+	ResumeDistribution(ctx context.Context, req *ResumeDistributionReq) (r *ResumeDistributionResp, err error)
+
+	GetCouponStats(ctx context.Context, req *GetCouponStatsReq) (r *GetCouponStatsResp, err error)
+
+	CreateCouponTransfer(ctx context.Context, req *CreateCouponTransferReq) (r *CreateCouponTransferResp, err error)
+
+	AcceptCouponTransfer(ctx context.Context, req *AcceptCouponTransferReq) (r *AcceptCouponTransferResp, err error)
+
+	CancelCouponTransfer(ctx context.Context, req *CancelCouponTransferReq) (r *CancelCouponTransferResp, err error)
+
+	ListCouponTransfers(ctx context.Context, req *ListCouponTransfersReq) (r *ListCouponTransfersResp, err error)
+}
+
+type CouponServiceCreateCouponArgs struct {
+	Req *CreateCouponReq `thrift:"req,1" frugal:"1,default,CreateCouponReq" json:"req"`
+}
+
+func NewCouponServiceCreateCouponArgs() *CouponServiceCreateCouponArgs {
+	return &CouponServiceCreateCouponArgs{}
+}
+
+func (p *CouponServiceCreateCouponArgs) InitDefault() {
+}
+
+var CouponServiceCreateCouponArgs_Req_DEFAULT *CreateCouponReq
+
+func (p *CouponServiceCreateCouponArgs) GetReq() (v *CreateCouponReq) {
+	if !p.IsSetReq() {
+		return CouponServiceCreateCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceCreateCouponArgs) SetReq(val *CreateCouponReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceCreateCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceCreateCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceCreateCouponArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceCreateCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceCreateCouponResult struct {
+	Success *CreateCouponResp `thrift:"success,0,optional" frugal:"0,optional,CreateCouponResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceCreateCouponResult() *CouponServiceCreateCouponResult {
+	return &CouponServiceCreateCouponResult{}
+}
+
+func (p *CouponServiceCreateCouponResult) InitDefault() {
+}
+
+var CouponServiceCreateCouponResult_Success_DEFAULT *CreateCouponResp
+
+func (p *CouponServiceCreateCouponResult) GetSuccess() (v *CreateCouponResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceCreateCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceCreateCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateCouponResp)
+}
+
+func (p *CouponServiceCreateCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceCreateCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceCreateCouponResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceCreateCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceUpdateCouponArgs struct {
+	Req *UpdateCouponReq `thrift:"req,1" frugal:"1,default,UpdateCouponReq" json:"req"`
+}
+
+func NewCouponServiceUpdateCouponArgs() *CouponServiceUpdateCouponArgs {
+	return &CouponServiceUpdateCouponArgs{}
+}
+
+func (p *CouponServiceUpdateCouponArgs) InitDefault() {
+}
+
+var CouponServiceUpdateCouponArgs_Req_DEFAULT *UpdateCouponReq
+
+func (p *CouponServiceUpdateCouponArgs) GetReq() (v *UpdateCouponReq) {
+	if !p.IsSetReq() {
+		return CouponServiceUpdateCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceUpdateCouponArgs) SetReq(val *UpdateCouponReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceUpdateCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceUpdateCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceUpdateCouponArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceUpdateCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceUpdateCouponResult struct {
+	Success *UpdateCouponResp `thrift:"success,0,optional" frugal:"0,optional,UpdateCouponResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceUpdateCouponResult() *CouponServiceUpdateCouponResult {
+	return &CouponServiceUpdateCouponResult{}
+}
+
+func (p *CouponServiceUpdateCouponResult) InitDefault() {
+}
+
+var CouponServiceUpdateCouponResult_Success_DEFAULT *UpdateCouponResp
+
+func (p *CouponServiceUpdateCouponResult) GetSuccess() (v *UpdateCouponResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceUpdateCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceUpdateCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateCouponResp)
+}
+
+func (p *CouponServiceUpdateCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceUpdateCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceUpdateCouponResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceUpdateCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceGetCouponArgs struct {
+	Req *GetCouponReq `thrift:"req,1" frugal:"1,default,GetCouponReq" json:"req"`
+}
+
+func NewCouponServiceGetCouponArgs() *CouponServiceGetCouponArgs {
+	return &CouponServiceGetCouponArgs{}
+}
+
+func (p *CouponServiceGetCouponArgs) InitDefault() {
+}
+
+var CouponServiceGetCouponArgs_Req_DEFAULT *GetCouponReq
+
+func (p *CouponServiceGetCouponArgs) GetReq() (v *GetCouponReq) {
+	if !p.IsSetReq() {
+		return CouponServiceGetCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceGetCouponArgs) SetReq(val *GetCouponReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceGetCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceGetCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGetCouponArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGetCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceGetCouponResult struct {
+	Success *GetCouponResp `thrift:"success,0,optional" frugal:"0,optional,GetCouponResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceGetCouponResult() *CouponServiceGetCouponResult {
+	return &CouponServiceGetCouponResult{}
+}
+
+func (p *CouponServiceGetCouponResult) InitDefault() {
+}
+
+var CouponServiceGetCouponResult_Success_DEFAULT *GetCouponResp
+
+func (p *CouponServiceGetCouponResult) GetSuccess() (v *GetCouponResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceGetCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceGetCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCouponResp)
+}
+
+func (p *CouponServiceGetCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceGetCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGetCouponResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGetCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceListCouponsArgs struct {
+	Req *ListCouponsReq `thrift:"req,1" frugal:"1,default,ListCouponsReq" json:"req"`
+}
+
+func NewCouponServiceListCouponsArgs() *CouponServiceListCouponsArgs {
+	return &CouponServiceListCouponsArgs{}
+}
+
+func (p *CouponServiceListCouponsArgs) InitDefault() {
+}
+
+var CouponServiceListCouponsArgs_Req_DEFAULT *ListCouponsReq
+
+func (p *CouponServiceListCouponsArgs) GetReq() (v *ListCouponsReq) {
+	if !p.IsSetReq() {
+		return CouponServiceListCouponsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceListCouponsArgs) SetReq(val *ListCouponsReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceListCouponsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceListCouponsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceListCouponsArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceListCouponsArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceListCouponsResult struct {
+	Success *ListCouponsResp `thrift:"success,0,optional" frugal:"0,optional,ListCouponsResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceListCouponsResult() *CouponServiceListCouponsResult {
+	return &CouponServiceListCouponsResult{}
+}
+
+func (p *CouponServiceListCouponsResult) InitDefault() {
+}
+
+var CouponServiceListCouponsResult_Success_DEFAULT *ListCouponsResp
+
+func (p *CouponServiceListCouponsResult) GetSuccess() (v *ListCouponsResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceListCouponsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceListCouponsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCouponsResp)
+}
+
+func (p *CouponServiceListCouponsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceListCouponsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceListCouponsResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceListCouponsResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceInvalidateCouponArgs struct {
+	Req *InvalidateCouponReq `thrift:"req,1" frugal:"1,default,InvalidateCouponReq" json:"req"`
+}
+
+func NewCouponServiceInvalidateCouponArgs() *CouponServiceInvalidateCouponArgs {
+	return &CouponServiceInvalidateCouponArgs{}
+}
+
+func (p *CouponServiceInvalidateCouponArgs) InitDefault() {
+}
+
+var CouponServiceInvalidateCouponArgs_Req_DEFAULT *InvalidateCouponReq
+
+func (p *CouponServiceInvalidateCouponArgs) GetReq() (v *InvalidateCouponReq) {
+	if !p.IsSetReq() {
+		return CouponServiceInvalidateCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceInvalidateCouponArgs) SetReq(val *InvalidateCouponReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceInvalidateCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceInvalidateCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceInvalidateCouponArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceInvalidateCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceInvalidateCouponResult struct {
+	Success *InvalidateCouponResp `thrift:"success,0,optional" frugal:"0,optional,InvalidateCouponResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceInvalidateCouponResult() *CouponServiceInvalidateCouponResult {
+	return &CouponServiceInvalidateCouponResult{}
+}
+
+func (p *CouponServiceInvalidateCouponResult) InitDefault() {
+}
+
+var CouponServiceInvalidateCouponResult_Success_DEFAULT *InvalidateCouponResp
+
+func (p *CouponServiceInvalidateCouponResult) GetSuccess() (v *InvalidateCouponResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceInvalidateCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceInvalidateCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*InvalidateCouponResp)
+}
+
+func (p *CouponServiceInvalidateCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceInvalidateCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceInvalidateCouponResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceInvalidateCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceClaimCouponArgs struct {
+	Req *ClaimCouponReq `thrift:"req,1" frugal:"1,default,ClaimCouponReq" json:"req"`
+}
+
+func NewCouponServiceClaimCouponArgs() *CouponServiceClaimCouponArgs {
+	return &CouponServiceClaimCouponArgs{}
+}
+
+func (p *CouponServiceClaimCouponArgs) InitDefault() {
+}
+
+var CouponServiceClaimCouponArgs_Req_DEFAULT *ClaimCouponReq
+
+func (p *CouponServiceClaimCouponArgs) GetReq() (v *ClaimCouponReq) {
+	if !p.IsSetReq() {
+		return CouponServiceClaimCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceClaimCouponArgs) SetReq(val *ClaimCouponReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceClaimCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceClaimCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceClaimCouponArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceClaimCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceClaimCouponResult struct {
+	Success *ClaimCouponResp `thrift:"success,0,optional" frugal:"0,optional,ClaimCouponResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceClaimCouponResult() *CouponServiceClaimCouponResult {
+	return &CouponServiceClaimCouponResult{}
+}
+
+func (p *CouponServiceClaimCouponResult) InitDefault() {
+}
+
+var CouponServiceClaimCouponResult_Success_DEFAULT *ClaimCouponResp
+
+func (p *CouponServiceClaimCouponResult) GetSuccess() (v *ClaimCouponResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceClaimCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceClaimCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ClaimCouponResp)
+}
+
+func (p *CouponServiceClaimCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceClaimCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceClaimCouponResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceClaimCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceGetClaimStatusArgs struct {
+	Req *GetClaimStatusReq `thrift:"req,1" frugal:"1,default,GetClaimStatusReq" json:"req"`
+}
+
+func NewCouponServiceGetClaimStatusArgs() *CouponServiceGetClaimStatusArgs {
+	return &CouponServiceGetClaimStatusArgs{}
+}
+
+func (p *CouponServiceGetClaimStatusArgs) InitDefault() {
+}
+
+var CouponServiceGetClaimStatusArgs_Req_DEFAULT *GetClaimStatusReq
+
+func (p *CouponServiceGetClaimStatusArgs) GetReq() (v *GetClaimStatusReq) {
+	if !p.IsSetReq() {
+		return CouponServiceGetClaimStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceGetClaimStatusArgs) SetReq(val *GetClaimStatusReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceGetClaimStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceGetClaimStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGetClaimStatusArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGetClaimStatusArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceGetClaimStatusResult struct {
+	Success *GetClaimStatusResp `thrift:"success,0,optional" frugal:"0,optional,GetClaimStatusResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceGetClaimStatusResult() *CouponServiceGetClaimStatusResult {
+	return &CouponServiceGetClaimStatusResult{}
+}
+
+func (p *CouponServiceGetClaimStatusResult) InitDefault() {
+}
+
+var CouponServiceGetClaimStatusResult_Success_DEFAULT *GetClaimStatusResp
+
+func (p *CouponServiceGetClaimStatusResult) GetSuccess() (v *GetClaimStatusResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceGetClaimStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceGetClaimStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetClaimStatusResp)
+}
+
+func (p *CouponServiceGetClaimStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceGetClaimStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGetClaimStatusResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGetClaimStatusResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServicePreviewOrderPriceArgs struct {
+	Req *PreviewOrderPriceReq `thrift:"req,1" frugal:"1,default,PreviewOrderPriceReq" json:"req"`
+}
+
+func NewCouponServicePreviewOrderPriceArgs() *CouponServicePreviewOrderPriceArgs {
+	return &CouponServicePreviewOrderPriceArgs{}
+}
+
+func (p *CouponServicePreviewOrderPriceArgs) InitDefault() {
+}
+
+var CouponServicePreviewOrderPriceArgs_Req_DEFAULT *PreviewOrderPriceReq
+
+func (p *CouponServicePreviewOrderPriceArgs) GetReq() (v *PreviewOrderPriceReq) {
+	if !p.IsSetReq() {
+		return CouponServicePreviewOrderPriceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServicePreviewOrderPriceArgs) SetReq(val *PreviewOrderPriceReq) {
+	p.Req = val
+}
+
+func (p *CouponServicePreviewOrderPriceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServicePreviewOrderPriceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServicePreviewOrderPriceArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServicePreviewOrderPriceArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServicePreviewOrderPriceResult struct {
+	Success *PreviewOrderPriceResp `thrift:"success,0,optional" frugal:"0,optional,PreviewOrderPriceResp" json:"success,omitempty"`
+}
+
+func NewCouponServicePreviewOrderPriceResult() *CouponServicePreviewOrderPriceResult {
+	return &CouponServicePreviewOrderPriceResult{}
+}
+
+func (p *CouponServicePreviewOrderPriceResult) InitDefault() {
+}
+
+var CouponServicePreviewOrderPriceResult_Success_DEFAULT *PreviewOrderPriceResp
+
+func (p *CouponServicePreviewOrderPriceResult) GetSuccess() (v *PreviewOrderPriceResp) {
+	if !p.IsSetSuccess() {
+		return CouponServicePreviewOrderPriceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServicePreviewOrderPriceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PreviewOrderPriceResp)
+}
+
+func (p *CouponServicePreviewOrderPriceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServicePreviewOrderPriceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServicePreviewOrderPriceResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServicePreviewOrderPriceResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceRecommendCouponsArgs struct {
+	Req *RecommendCouponsReq `thrift:"req,1" frugal:"1,default,RecommendCouponsReq" json:"req"`
+}
+
+func NewCouponServiceRecommendCouponsArgs() *CouponServiceRecommendCouponsArgs {
+	return &CouponServiceRecommendCouponsArgs{}
+}
+
+func (p *CouponServiceRecommendCouponsArgs) InitDefault() {
+}
+
+var CouponServiceRecommendCouponsArgs_Req_DEFAULT *RecommendCouponsReq
+
+func (p *CouponServiceRecommendCouponsArgs) GetReq() (v *RecommendCouponsReq) {
+	if !p.IsSetReq() {
+		return CouponServiceRecommendCouponsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceRecommendCouponsArgs) SetReq(val *RecommendCouponsReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceRecommendCouponsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceRecommendCouponsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceRecommendCouponsArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceRecommendCouponsArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceRecommendCouponsResult struct {
+	Success *RecommendCouponsResp `thrift:"success,0,optional" frugal:"0,optional,RecommendCouponsResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceRecommendCouponsResult() *CouponServiceRecommendCouponsResult {
+	return &CouponServiceRecommendCouponsResult{}
+}
+
+func (p *CouponServiceRecommendCouponsResult) InitDefault() {
+}
+
+var CouponServiceRecommendCouponsResult_Success_DEFAULT *RecommendCouponsResp
+
+func (p *CouponServiceRecommendCouponsResult) GetSuccess() (v *RecommendCouponsResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceRecommendCouponsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceRecommendCouponsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RecommendCouponsResp)
+}
+
+func (p *CouponServiceRecommendCouponsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceRecommendCouponsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceRecommendCouponsResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceRecommendCouponsResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceLockCouponArgs struct {
+	Req *LockCouponReq `thrift:"req,1" frugal:"1,default,LockCouponReq" json:"req"`
+}
+
+func NewCouponServiceLockCouponArgs() *CouponServiceLockCouponArgs {
+	return &CouponServiceLockCouponArgs{}
+}
+
+func (p *CouponServiceLockCouponArgs) InitDefault() {
+}
+
+var CouponServiceLockCouponArgs_Req_DEFAULT *LockCouponReq
+
+func (p *CouponServiceLockCouponArgs) GetReq() (v *LockCouponReq) {
+	if !p.IsSetReq() {
+		return CouponServiceLockCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceLockCouponArgs) SetReq(val *LockCouponReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceLockCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceLockCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceLockCouponArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceLockCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceLockCouponResult struct {
+	Success *LockCouponResp `thrift:"success,0,optional" frugal:"0,optional,LockCouponResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceLockCouponResult() *CouponServiceLockCouponResult {
+	return &CouponServiceLockCouponResult{}
+}
+
+func (p *CouponServiceLockCouponResult) InitDefault() {
+}
+
+var CouponServiceLockCouponResult_Success_DEFAULT *LockCouponResp
+
+func (p *CouponServiceLockCouponResult) GetSuccess() (v *LockCouponResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceLockCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceLockCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LockCouponResp)
+}
+
+func (p *CouponServiceLockCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceLockCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceLockCouponResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceLockCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceConsumeCouponArgs struct {
+	Req *ConsumeCouponReq `thrift:"req,1" frugal:"1,default,ConsumeCouponReq" json:"req"`
+}
+
+func NewCouponServiceConsumeCouponArgs() *CouponServiceConsumeCouponArgs {
+	return &CouponServiceConsumeCouponArgs{}
+}
+
+func (p *CouponServiceConsumeCouponArgs) InitDefault() {
+}
+
+var CouponServiceConsumeCouponArgs_Req_DEFAULT *ConsumeCouponReq
+
+func (p *CouponServiceConsumeCouponArgs) GetReq() (v *ConsumeCouponReq) {
+	if !p.IsSetReq() {
+		return CouponServiceConsumeCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceConsumeCouponArgs) SetReq(val *ConsumeCouponReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceConsumeCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceConsumeCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceConsumeCouponArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceConsumeCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceConsumeCouponResult struct {
+	Success *ConsumeCouponResp `thrift:"success,0,optional" frugal:"0,optional,ConsumeCouponResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceConsumeCouponResult() *CouponServiceConsumeCouponResult {
+	return &CouponServiceConsumeCouponResult{}
+}
+
+func (p *CouponServiceConsumeCouponResult) InitDefault() {
+}
+
+var CouponServiceConsumeCouponResult_Success_DEFAULT *ConsumeCouponResp
+
+func (p *CouponServiceConsumeCouponResult) GetSuccess() (v *ConsumeCouponResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceConsumeCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceConsumeCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ConsumeCouponResp)
+}
+
+func (p *CouponServiceConsumeCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceConsumeCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceConsumeCouponResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceConsumeCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceReleaseCouponArgs struct {
+	Req *ReleaseCouponReq `thrift:"req,1" frugal:"1,default,ReleaseCouponReq" json:"req"`
+}
+
+func NewCouponServiceReleaseCouponArgs() *CouponServiceReleaseCouponArgs {
+	return &CouponServiceReleaseCouponArgs{}
+}
+
+func (p *CouponServiceReleaseCouponArgs) InitDefault() {
+}
+
+var CouponServiceReleaseCouponArgs_Req_DEFAULT *ReleaseCouponReq
+
+func (p *CouponServiceReleaseCouponArgs) GetReq() (v *ReleaseCouponReq) {
+	if !p.IsSetReq() {
+		return CouponServiceReleaseCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceReleaseCouponArgs) SetReq(val *ReleaseCouponReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceReleaseCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceReleaseCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceReleaseCouponArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceReleaseCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceReleaseCouponResult struct {
+	Success *ReleaseCouponResp `thrift:"success,0,optional" frugal:"0,optional,ReleaseCouponResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceReleaseCouponResult() *CouponServiceReleaseCouponResult {
+	return &CouponServiceReleaseCouponResult{}
+}
+
+func (p *CouponServiceReleaseCouponResult) InitDefault() {
+}
+
+var CouponServiceReleaseCouponResult_Success_DEFAULT *ReleaseCouponResp
+
+func (p *CouponServiceReleaseCouponResult) GetSuccess() (v *ReleaseCouponResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceReleaseCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceReleaseCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ReleaseCouponResp)
+}
+
+func (p *CouponServiceReleaseCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceReleaseCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceReleaseCouponResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceReleaseCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceGenerateCodesArgs struct {
+	Req *GenerateCodesReq `thrift:"req,1" frugal:"1,default,GenerateCodesReq" json:"req"`
+}
+
+func NewCouponServiceGenerateCodesArgs() *CouponServiceGenerateCodesArgs {
+	return &CouponServiceGenerateCodesArgs{}
+}
+
+func (p *CouponServiceGenerateCodesArgs) InitDefault() {
+}
+
+var CouponServiceGenerateCodesArgs_Req_DEFAULT *GenerateCodesReq
+
+func (p *CouponServiceGenerateCodesArgs) GetReq() (v *GenerateCodesReq) {
+	if !p.IsSetReq() {
+		return CouponServiceGenerateCodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceGenerateCodesArgs) SetReq(val *GenerateCodesReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceGenerateCodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceGenerateCodesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGenerateCodesArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGenerateCodesArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceGenerateCodesResult struct {
+	Success *GenerateCodesResp `thrift:"success,0,optional" frugal:"0,optional,GenerateCodesResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceGenerateCodesResult() *CouponServiceGenerateCodesResult {
+	return &CouponServiceGenerateCodesResult{}
+}
+
+func (p *CouponServiceGenerateCodesResult) InitDefault() {
+}
+
+var CouponServiceGenerateCodesResult_Success_DEFAULT *GenerateCodesResp
+
+func (p *CouponServiceGenerateCodesResult) GetSuccess() (v *GenerateCodesResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceGenerateCodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceGenerateCodesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GenerateCodesResp)
+}
+
+func (p *CouponServiceGenerateCodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceGenerateCodesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGenerateCodesResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGenerateCodesResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceRedeemCodeArgs struct {
+	Req *RedeemCodeReq `thrift:"req,1" frugal:"1,default,RedeemCodeReq" json:"req"`
+}
+
+func NewCouponServiceRedeemCodeArgs() *CouponServiceRedeemCodeArgs {
+	return &CouponServiceRedeemCodeArgs{}
+}
+
+func (p *CouponServiceRedeemCodeArgs) InitDefault() {
+}
+
+var CouponServiceRedeemCodeArgs_Req_DEFAULT *RedeemCodeReq
+
+func (p *CouponServiceRedeemCodeArgs) GetReq() (v *RedeemCodeReq) {
+	if !p.IsSetReq() {
+		return CouponServiceRedeemCodeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceRedeemCodeArgs) SetReq(val *RedeemCodeReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceRedeemCodeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceRedeemCodeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceRedeemCodeArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceRedeemCodeArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceRedeemCodeResult struct {
+	Success *RedeemCodeResp `thrift:"success,0,optional" frugal:"0,optional,RedeemCodeResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceRedeemCodeResult() *CouponServiceRedeemCodeResult {
+	return &CouponServiceRedeemCodeResult{}
+}
+
+func (p *CouponServiceRedeemCodeResult) InitDefault() {
+}
+
+var CouponServiceRedeemCodeResult_Success_DEFAULT *RedeemCodeResp
+
+func (p *CouponServiceRedeemCodeResult) GetSuccess() (v *RedeemCodeResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceRedeemCodeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceRedeemCodeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RedeemCodeResp)
+}
+
+func (p *CouponServiceRedeemCodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceRedeemCodeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceRedeemCodeResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceRedeemCodeResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceExportCodeBatchArgs struct {
+	Req *ExportCodeBatchReq `thrift:"req,1" frugal:"1,default,ExportCodeBatchReq" json:"req"`
+}
+
+func NewCouponServiceExportCodeBatchArgs() *CouponServiceExportCodeBatchArgs {
+	return &CouponServiceExportCodeBatchArgs{}
+}
+
+func (p *CouponServiceExportCodeBatchArgs) InitDefault() {
+}
+
+var CouponServiceExportCodeBatchArgs_Req_DEFAULT *ExportCodeBatchReq
+
+func (p *CouponServiceExportCodeBatchArgs) GetReq() (v *ExportCodeBatchReq) {
+	if !p.IsSetReq() {
+		return CouponServiceExportCodeBatchArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceExportCodeBatchArgs) SetReq(val *ExportCodeBatchReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceExportCodeBatchArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceExportCodeBatchArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceExportCodeBatchArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceExportCodeBatchArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceExportCodeBatchResult struct {
+	Success *ExportCodeBatchResp `thrift:"success,0,optional" frugal:"0,optional,ExportCodeBatchResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceExportCodeBatchResult() *CouponServiceExportCodeBatchResult {
+	return &CouponServiceExportCodeBatchResult{}
+}
+
+func (p *CouponServiceExportCodeBatchResult) InitDefault() {
+}
+
+var CouponServiceExportCodeBatchResult_Success_DEFAULT *ExportCodeBatchResp
+
+func (p *CouponServiceExportCodeBatchResult) GetSuccess() (v *ExportCodeBatchResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceExportCodeBatchResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceExportCodeBatchResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ExportCodeBatchResp)
+}
+
+func (p *CouponServiceExportCodeBatchResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceExportCodeBatchResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceExportCodeBatchResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceExportCodeBatchResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceRevokeCodeBatchArgs struct {
+	Req *RevokeCodeBatchReq `thrift:"req,1" frugal:"1,default,RevokeCodeBatchReq" json:"req"`
+}
+
+func NewCouponServiceRevokeCodeBatchArgs() *CouponServiceRevokeCodeBatchArgs {
+	return &CouponServiceRevokeCodeBatchArgs{}
+}
+
+func (p *CouponServiceRevokeCodeBatchArgs) InitDefault() {
+}
+
+var CouponServiceRevokeCodeBatchArgs_Req_DEFAULT *RevokeCodeBatchReq
+
+func (p *CouponServiceRevokeCodeBatchArgs) GetReq() (v *RevokeCodeBatchReq) {
+	if !p.IsSetReq() {
+		return CouponServiceRevokeCodeBatchArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceRevokeCodeBatchArgs) SetReq(val *RevokeCodeBatchReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceRevokeCodeBatchArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceRevokeCodeBatchArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceRevokeCodeBatchArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceRevokeCodeBatchArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceRevokeCodeBatchResult struct {
+	Success *RevokeCodeBatchResp `thrift:"success,0,optional" frugal:"0,optional,RevokeCodeBatchResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceRevokeCodeBatchResult() *CouponServiceRevokeCodeBatchResult {
+	return &CouponServiceRevokeCodeBatchResult{}
+}
+
+func (p *CouponServiceRevokeCodeBatchResult) InitDefault() {
+}
+
+var CouponServiceRevokeCodeBatchResult_Success_DEFAULT *RevokeCodeBatchResp
+
+func (p *CouponServiceRevokeCodeBatchResult) GetSuccess() (v *RevokeCodeBatchResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceRevokeCodeBatchResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceRevokeCodeBatchResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RevokeCodeBatchResp)
+}
+
+func (p *CouponServiceRevokeCodeBatchResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceRevokeCodeBatchResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceRevokeCodeBatchResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceRevokeCodeBatchResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceListCouponsForSpotArgs struct {
+	Req *ListCouponsForSpotReq `thrift:"req,1" frugal:"1,default,ListCouponsForSpotReq" json:"req"`
+}
+
+func NewCouponServiceListCouponsForSpotArgs() *CouponServiceListCouponsForSpotArgs {
+	return &CouponServiceListCouponsForSpotArgs{}
+}
+
+func (p *CouponServiceListCouponsForSpotArgs) InitDefault() {
+}
+
+var CouponServiceListCouponsForSpotArgs_Req_DEFAULT *ListCouponsForSpotReq
+
+func (p *CouponServiceListCouponsForSpotArgs) GetReq() (v *ListCouponsForSpotReq) {
+	if !p.IsSetReq() {
+		return CouponServiceListCouponsForSpotArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceListCouponsForSpotArgs) SetReq(val *ListCouponsForSpotReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceListCouponsForSpotArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceListCouponsForSpotArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceListCouponsForSpotArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceListCouponsForSpotArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceListCouponsForSpotResult struct {
+	Success *ListCouponsForSpotResp `thrift:"success,0,optional" frugal:"0,optional,ListCouponsForSpotResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceListCouponsForSpotResult() *CouponServiceListCouponsForSpotResult {
+	return &CouponServiceListCouponsForSpotResult{}
+}
+
+func (p *CouponServiceListCouponsForSpotResult) InitDefault() {
+}
+
+var CouponServiceListCouponsForSpotResult_Success_DEFAULT *ListCouponsForSpotResp
+
+func (p *CouponServiceListCouponsForSpotResult) GetSuccess() (v *ListCouponsForSpotResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceListCouponsForSpotResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceListCouponsForSpotResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCouponsForSpotResp)
+}
+
+func (p *CouponServiceListCouponsForSpotResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceListCouponsForSpotResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceListCouponsForSpotResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceListCouponsForSpotResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceCreateSegmentArgs struct {
+	Req *CreateSegmentReq `thrift:"req,1" frugal:"1,default,CreateSegmentReq" json:"req"`
+}
+
+func NewCouponServiceCreateSegmentArgs() *CouponServiceCreateSegmentArgs {
+	return &CouponServiceCreateSegmentArgs{}
+}
+
+func (p *CouponServiceCreateSegmentArgs) InitDefault() {
+}
+
+var CouponServiceCreateSegmentArgs_Req_DEFAULT *CreateSegmentReq
+
+func (p *CouponServiceCreateSegmentArgs) GetReq() (v *CreateSegmentReq) {
+	if !p.IsSetReq() {
+		return CouponServiceCreateSegmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceCreateSegmentArgs) SetReq(val *CreateSegmentReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceCreateSegmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceCreateSegmentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceCreateSegmentArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceCreateSegmentArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceCreateSegmentResult struct {
+	Success *CreateSegmentResp `thrift:"success,0,optional" frugal:"0,optional,CreateSegmentResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceCreateSegmentResult() *CouponServiceCreateSegmentResult {
+	return &CouponServiceCreateSegmentResult{}
+}
+
+func (p *CouponServiceCreateSegmentResult) InitDefault() {
+}
+
+var CouponServiceCreateSegmentResult_Success_DEFAULT *CreateSegmentResp
+
+func (p *CouponServiceCreateSegmentResult) GetSuccess() (v *CreateSegmentResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceCreateSegmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceCreateSegmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateSegmentResp)
+}
+
+func (p *CouponServiceCreateSegmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceCreateSegmentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceCreateSegmentResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceCreateSegmentResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceDistributeCouponArgs struct {
+	Req *DistributeCouponReq `thrift:"req,1" frugal:"1,default,DistributeCouponReq" json:"req"`
+}
+
+func NewCouponServiceDistributeCouponArgs() *CouponServiceDistributeCouponArgs {
+	return &CouponServiceDistributeCouponArgs{}
+}
+
+func (p *CouponServiceDistributeCouponArgs) InitDefault() {
+}
+
+var CouponServiceDistributeCouponArgs_Req_DEFAULT *DistributeCouponReq
+
+func (p *CouponServiceDistributeCouponArgs) GetReq() (v *DistributeCouponReq) {
+	if !p.IsSetReq() {
+		return CouponServiceDistributeCouponArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceDistributeCouponArgs) SetReq(val *DistributeCouponReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceDistributeCouponArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceDistributeCouponArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceDistributeCouponArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceDistributeCouponArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceDistributeCouponResult struct {
+	Success *DistributeCouponResp `thrift:"success,0,optional" frugal:"0,optional,DistributeCouponResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceDistributeCouponResult() *CouponServiceDistributeCouponResult {
+	return &CouponServiceDistributeCouponResult{}
+}
+
+func (p *CouponServiceDistributeCouponResult) InitDefault() {
+}
+
+var CouponServiceDistributeCouponResult_Success_DEFAULT *DistributeCouponResp
+
+func (p *CouponServiceDistributeCouponResult) GetSuccess() (v *DistributeCouponResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceDistributeCouponResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceDistributeCouponResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DistributeCouponResp)
+}
+
+func (p *CouponServiceDistributeCouponResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceDistributeCouponResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceDistributeCouponResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceDistributeCouponResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceGetDistributionArgs struct {
+	Req *GetDistributionReq `thrift:"req,1" frugal:"1,default,GetDistributionReq" json:"req"`
+}
+
+func NewCouponServiceGetDistributionArgs() *CouponServiceGetDistributionArgs {
+	return &CouponServiceGetDistributionArgs{}
+}
+
+func (p *CouponServiceGetDistributionArgs) InitDefault() {
+}
+
+var CouponServiceGetDistributionArgs_Req_DEFAULT *GetDistributionReq
+
+func (p *CouponServiceGetDistributionArgs) GetReq() (v *GetDistributionReq) {
+	if !p.IsSetReq() {
+		return CouponServiceGetDistributionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceGetDistributionArgs) SetReq(val *GetDistributionReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceGetDistributionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceGetDistributionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGetDistributionArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGetDistributionArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceGetDistributionResult struct {
+	Success *GetDistributionResp `thrift:"success,0,optional" frugal:"0,optional,GetDistributionResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceGetDistributionResult() *CouponServiceGetDistributionResult {
+	return &CouponServiceGetDistributionResult{}
+}
+
+func (p *CouponServiceGetDistributionResult) InitDefault() {
+}
+
+var CouponServiceGetDistributionResult_Success_DEFAULT *GetDistributionResp
+
+func (p *CouponServiceGetDistributionResult) GetSuccess() (v *GetDistributionResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceGetDistributionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceGetDistributionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetDistributionResp)
+}
+
+func (p *CouponServiceGetDistributionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceGetDistributionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGetDistributionResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGetDistributionResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceResumeDistributionArgs struct {
+	Req *ResumeDistributionReq `thrift:"req,1" frugal:"1,default,ResumeDistributionReq" json:"req"`
+}
+
+func NewCouponServiceResumeDistributionArgs() *CouponServiceResumeDistributionArgs {
+	return &CouponServiceResumeDistributionArgs{}
+}
+
+func (p *CouponServiceResumeDistributionArgs) InitDefault() {
+}
+
+var CouponServiceResumeDistributionArgs_Req_DEFAULT *ResumeDistributionReq
+
+func (p *CouponServiceResumeDistributionArgs) GetReq() (v *ResumeDistributionReq) {
+	if !p.IsSetReq() {
+		return CouponServiceResumeDistributionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceResumeDistributionArgs) SetReq(val *ResumeDistributionReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceResumeDistributionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceResumeDistributionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceResumeDistributionArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceResumeDistributionArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceResumeDistributionResult struct {
+	Success *ResumeDistributionResp `thrift:"success,0,optional" frugal:"0,optional,ResumeDistributionResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceResumeDistributionResult() *CouponServiceResumeDistributionResult {
+	return &CouponServiceResumeDistributionResult{}
+}
+
+func (p *CouponServiceResumeDistributionResult) InitDefault() {
+}
+
+var CouponServiceResumeDistributionResult_Success_DEFAULT *ResumeDistributionResp
+
+func (p *CouponServiceResumeDistributionResult) GetSuccess() (v *ResumeDistributionResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceResumeDistributionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceResumeDistributionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ResumeDistributionResp)
+}
+
+func (p *CouponServiceResumeDistributionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceResumeDistributionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceResumeDistributionResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceResumeDistributionResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceGetCouponStatsArgs struct {
+	Req *GetCouponStatsReq `thrift:"req,1" frugal:"1,default,GetCouponStatsReq" json:"req"`
+}
+
+func NewCouponServiceGetCouponStatsArgs() *CouponServiceGetCouponStatsArgs {
+	return &CouponServiceGetCouponStatsArgs{}
+}
+
+func (p *CouponServiceGetCouponStatsArgs) InitDefault() {
+}
+
+var CouponServiceGetCouponStatsArgs_Req_DEFAULT *GetCouponStatsReq
+
+func (p *CouponServiceGetCouponStatsArgs) GetReq() (v *GetCouponStatsReq) {
+	if !p.IsSetReq() {
+		return CouponServiceGetCouponStatsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceGetCouponStatsArgs) SetReq(val *GetCouponStatsReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceGetCouponStatsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceGetCouponStatsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGetCouponStatsArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGetCouponStatsArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceGetCouponStatsResult struct {
+	Success *GetCouponStatsResp `thrift:"success,0,optional" frugal:"0,optional,GetCouponStatsResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceGetCouponStatsResult() *CouponServiceGetCouponStatsResult {
+	return &CouponServiceGetCouponStatsResult{}
+}
+
+func (p *CouponServiceGetCouponStatsResult) InitDefault() {
+}
+
+var CouponServiceGetCouponStatsResult_Success_DEFAULT *GetCouponStatsResp
+
+func (p *CouponServiceGetCouponStatsResult) GetSuccess() (v *GetCouponStatsResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceGetCouponStatsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceGetCouponStatsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetCouponStatsResp)
+}
+
+func (p *CouponServiceGetCouponStatsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceGetCouponStatsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceGetCouponStatsResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceGetCouponStatsResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceCreateCouponTransferArgs struct {
+	Req *CreateCouponTransferReq `thrift:"req,1" frugal:"1,default,CreateCouponTransferReq" json:"req"`
+}
+
+func NewCouponServiceCreateCouponTransferArgs() *CouponServiceCreateCouponTransferArgs {
+	return &CouponServiceCreateCouponTransferArgs{}
+}
+
+func (p *CouponServiceCreateCouponTransferArgs) InitDefault() {
+}
+
+var CouponServiceCreateCouponTransferArgs_Req_DEFAULT *CreateCouponTransferReq
+
+func (p *CouponServiceCreateCouponTransferArgs) GetReq() (v *CreateCouponTransferReq) {
+	if !p.IsSetReq() {
+		return CouponServiceCreateCouponTransferArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceCreateCouponTransferArgs) SetReq(val *CreateCouponTransferReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceCreateCouponTransferArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceCreateCouponTransferArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceCreateCouponTransferArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceCreateCouponTransferArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceCreateCouponTransferResult struct {
+	Success *CreateCouponTransferResp `thrift:"success,0,optional" frugal:"0,optional,CreateCouponTransferResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceCreateCouponTransferResult() *CouponServiceCreateCouponTransferResult {
+	return &CouponServiceCreateCouponTransferResult{}
+}
+
+func (p *CouponServiceCreateCouponTransferResult) InitDefault() {
+}
+
+var CouponServiceCreateCouponTransferResult_Success_DEFAULT *CreateCouponTransferResp
+
+func (p *CouponServiceCreateCouponTransferResult) GetSuccess() (v *CreateCouponTransferResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceCreateCouponTransferResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceCreateCouponTransferResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateCouponTransferResp)
+}
+
+func (p *CouponServiceCreateCouponTransferResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceCreateCouponTransferResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceCreateCouponTransferResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceCreateCouponTransferResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceAcceptCouponTransferArgs struct {
+	Req *AcceptCouponTransferReq `thrift:"req,1" frugal:"1,default,AcceptCouponTransferReq" json:"req"`
+}
+
+func NewCouponServiceAcceptCouponTransferArgs() *CouponServiceAcceptCouponTransferArgs {
+	return &CouponServiceAcceptCouponTransferArgs{}
+}
+
+func (p *CouponServiceAcceptCouponTransferArgs) InitDefault() {
+}
+
+var CouponServiceAcceptCouponTransferArgs_Req_DEFAULT *AcceptCouponTransferReq
+
+func (p *CouponServiceAcceptCouponTransferArgs) GetReq() (v *AcceptCouponTransferReq) {
+	if !p.IsSetReq() {
+		return CouponServiceAcceptCouponTransferArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceAcceptCouponTransferArgs) SetReq(val *AcceptCouponTransferReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceAcceptCouponTransferArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceAcceptCouponTransferArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceAcceptCouponTransferArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceAcceptCouponTransferArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceAcceptCouponTransferResult struct {
+	Success *AcceptCouponTransferResp `thrift:"success,0,optional" frugal:"0,optional,AcceptCouponTransferResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceAcceptCouponTransferResult() *CouponServiceAcceptCouponTransferResult {
+	return &CouponServiceAcceptCouponTransferResult{}
+}
+
+func (p *CouponServiceAcceptCouponTransferResult) InitDefault() {
+}
+
+var CouponServiceAcceptCouponTransferResult_Success_DEFAULT *AcceptCouponTransferResp
+
+func (p *CouponServiceAcceptCouponTransferResult) GetSuccess() (v *AcceptCouponTransferResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceAcceptCouponTransferResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceAcceptCouponTransferResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AcceptCouponTransferResp)
+}
+
+func (p *CouponServiceAcceptCouponTransferResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceAcceptCouponTransferResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceAcceptCouponTransferResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceAcceptCouponTransferResult = map[int16]string{
+	0: "success",
+}
+
+type CouponServiceCancelCouponTransferArgs struct {
+	Req *CancelCouponTransferReq `thrift:"req,1" frugal:"1,default,CancelCouponTransferReq" json:"req"`
+}
+
+func NewCouponServiceCancelCouponTransferArgs() *CouponServiceCancelCouponTransferArgs {
+	return &CouponServiceCancelCouponTransferArgs{}
+}
+
+func (p *CouponServiceCancelCouponTransferArgs) InitDefault() {
+}
+
+var CouponServiceCancelCouponTransferArgs_Req_DEFAULT *CancelCouponTransferReq
+
+func (p *CouponServiceCancelCouponTransferArgs) GetReq() (v *CancelCouponTransferReq) {
+	if !p.IsSetReq() {
+		return CouponServiceCancelCouponTransferArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CouponServiceCancelCouponTransferArgs) SetReq(val *CancelCouponTransferReq) {
+	p.Req = val
+}
+
+func (p *CouponServiceCancelCouponTransferArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CouponServiceCancelCouponTransferArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceCancelCouponTransferArgs(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceCancelCouponTransferArgs = map[int16]string{
+	1: "req",
+}
+
+type CouponServiceCancelCouponTransferResult struct {
+	Success *CancelCouponTransferResp `thrift:"success,0,optional" frugal:"0,optional,CancelCouponTransferResp" json:"success,omitempty"`
+}
+
+func NewCouponServiceCancelCouponTransferResult() *CouponServiceCancelCouponTransferResult {
+	return &CouponServiceCancelCouponTransferResult{}
+}
+
+func (p *CouponServiceCancelCouponTransferResult) InitDefault() {
+}
+
+var CouponServiceCancelCouponTransferResult_Success_DEFAULT *CancelCouponTransferResp
+
+func (p *CouponServiceCancelCouponTransferResult) GetSuccess() (v *CancelCouponTransferResp) {
+	if !p.IsSetSuccess() {
+		return CouponServiceCancelCouponTransferResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *CouponServiceCancelCouponTransferResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CancelCouponTransferResp)
+}
+
+func (p *CouponServiceCancelCouponTransferResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CouponServiceCancelCouponTransferResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CouponServiceCancelCouponTransferResult(%+v)", *p)
+}
+
+var fieldIDToName_CouponServiceCancelCouponTransferResult = map[int16]string{
+	0: "success",
 }
 
-type CouponServiceTestArgs struct {
-	Req *EmptyReq `thrift:"req,1" frugal:"1,default,EmptyReq" json:"req"`
+type CouponServiceListCouponTransfersArgs struct {
+	Req *ListCouponTransfersReq `thrift:"req,1" frugal:"1,default,ListCouponTransfersReq" json:"req"`
 }
 
-func NewCouponServiceTestArgs() *CouponServiceTestArgs {
-	return &CouponServiceTestArgs{}
+func NewCouponServiceListCouponTransfersArgs() *CouponServiceListCouponTransfersArgs {
+	return &CouponServiceListCouponTransfersArgs{}
 }
 
-func (p *CouponServiceTestArgs) InitDefault() {
+func (p *CouponServiceListCouponTransfersArgs) InitDefault() {
 }
 
-var CouponServiceTestArgs_Req_DEFAULT *EmptyReq
+var CouponServiceListCouponTransfersArgs_Req_DEFAULT *ListCouponTransfersReq
 
-func (p *CouponServiceTestArgs) GetReq() (v *EmptyReq) {
+func (p *CouponServiceListCouponTransfersArgs) GetReq() (v *ListCouponTransfersReq) {
 	if !p.IsSetReq() {
-		return CouponServiceTestArgs_Req_DEFAULT
+		return CouponServiceListCouponTransfersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *CouponServiceTestArgs) SetReq(val *EmptyReq) {
+func (p *CouponServiceListCouponTransfersArgs) SetReq(val *ListCouponTransfersReq) {
 	p.Req = val
 }
 
-func (p *CouponServiceTestArgs) IsSetReq() bool {
+func (p *CouponServiceListCouponTransfersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *CouponServiceTestArgs) String() string {
+func (p *CouponServiceListCouponTransfersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CouponServiceTestArgs(%+v)", *p)
+	return fmt.Sprintf("CouponServiceListCouponTransfersArgs(%+v)", *p)
 }
 
-var fieldIDToName_CouponServiceTestArgs = map[int16]string{
+var fieldIDToName_CouponServiceListCouponTransfersArgs = map[int16]string{
 	1: "req",
 }
 
-type CouponServiceTestResult struct {
-	Success *BaseResp `thrift:"success,0,optional" frugal:"0,optional,BaseResp" json:"success,omitempty"`
+type CouponServiceListCouponTransfersResult struct {
+	Success *ListCouponTransfersResp `thrift:"success,0,optional" frugal:"0,optional,ListCouponTransfersResp" json:"success,omitempty"`
 }
 
-func NewCouponServiceTestResult() *CouponServiceTestResult {
-	return &CouponServiceTestResult{}
+func NewCouponServiceListCouponTransfersResult() *CouponServiceListCouponTransfersResult {
+	return &CouponServiceListCouponTransfersResult{}
 }
 
-func (p *CouponServiceTestResult) InitDefault() {
+func (p *CouponServiceListCouponTransfersResult) InitDefault() {
 }
 
-var CouponServiceTestResult_Success_DEFAULT *BaseResp
+var CouponServiceListCouponTransfersResult_Success_DEFAULT *ListCouponTransfersResp
 
-func (p *CouponServiceTestResult) GetSuccess() (v *BaseResp) {
+func (p *CouponServiceListCouponTransfersResult) GetSuccess() (v *ListCouponTransfersResp) {
 	if !p.IsSetSuccess() {
-		return CouponServiceTestResult_Success_DEFAULT
+		return CouponServiceListCouponTransfersResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *CouponServiceTestResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BaseResp)
+func (p *CouponServiceListCouponTransfersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListCouponTransfersResp)
 }
 
-func (p *CouponServiceTestResult) IsSetSuccess() bool {
+func (p *CouponServiceListCouponTransfersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CouponServiceTestResult) String() string {
+func (p *CouponServiceListCouponTransfersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CouponServiceTestResult(%+v)", *p)
+	return fmt.Sprintf("CouponServiceListCouponTransfersResult(%+v)", *p)
 }
 
-var fieldIDToName_CouponServiceTestResult = map[int16]string{
+var fieldIDToName_CouponServiceListCouponTransfersResult = map[int16]string{
 	0: "success",
 }
@@ -11,7 +11,32 @@ import (
 
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
-	Test(ctx context.Context, req *coupon.EmptyReq, callOptions ...callopt.Option) (r *coupon.BaseResp, err error)
+	CreateCoupon(ctx context.Context, req *coupon.CreateCouponReq, callOptions ...callopt.Option) (r *coupon.CreateCouponResp, err error)
+	UpdateCoupon(ctx context.Context, req *coupon.UpdateCouponReq, callOptions ...callopt.Option) (r *coupon.UpdateCouponResp, err error)
+	GetCoupon(ctx context.Context, req *coupon.GetCouponReq, callOptions ...callopt.Option) (r *coupon.GetCouponResp, err error)
+	ListCoupons(ctx context.Context, req *coupon.ListCouponsReq, callOptions ...callopt.Option) (r *coupon.ListCouponsResp, err error)
+	InvalidateCoupon(ctx context.Context, req *coupon.InvalidateCouponReq, callOptions ...callopt.Option) (r *coupon.InvalidateCouponResp, err error)
+	ClaimCoupon(ctx context.Context, req *coupon.ClaimCouponReq, callOptions ...callopt.Option) (r *coupon.ClaimCouponResp, err error)
+	GetClaimStatus(ctx context.Context, req *coupon.GetClaimStatusReq, callOptions ...callopt.Option) (r *coupon.GetClaimStatusResp, err error)
+	PreviewOrderPrice(ctx context.Context, req *coupon.PreviewOrderPriceReq, callOptions ...callopt.Option) (r *coupon.PreviewOrderPriceResp, err error)
+	RecommendCoupons(ctx context.Context, req *coupon.RecommendCouponsReq, callOptions ...callopt.Option) (r *coupon.RecommendCouponsResp, err error)
+	LockCoupon(ctx context.Context, req *coupon.LockCouponReq, callOptions ...callopt.Option) (r *coupon.LockCouponResp, err error)
+	ConsumeCoupon(ctx context.Context, req *coupon.ConsumeCouponReq, callOptions ...callopt.Option) (r *coupon.ConsumeCouponResp, err error)
+	ReleaseCoupon(ctx context.Context, req *coupon.ReleaseCouponReq, callOptions ...callopt.Option) (r *coupon.ReleaseCouponResp, err error)
+	GenerateCodes(ctx context.Context, req *coupon.GenerateCodesReq, callOptions ...callopt.Option) (r *coupon.GenerateCodesResp, err error)
+	RedeemCode(ctx context.Context, req *coupon.RedeemCodeReq, callOptions ...callopt.Option) (r *coupon.RedeemCodeResp, err error)
+	ExportCodeBatch(ctx context.Context, req *coupon.ExportCodeBatchReq, callOptions ...callopt.Option) (r *coupon.ExportCodeBatchResp, err error)
+	RevokeCodeBatch(ctx context.Context, req *coupon.RevokeCodeBatchReq, callOptions ...callopt.Option) (r *coupon.RevokeCodeBatchResp, err error)
+	ListCouponsForSpot(ctx context.Context, req *coupon.ListCouponsForSpotReq, callOptions ...callopt.Option) (r *coupon.ListCouponsForSpotResp, err error)
+	CreateSegment(ctx context.Context, req *coupon.CreateSegmentReq, callOptions ...callopt.Option) (r *coupon.CreateSegmentResp, err error)
+	DistributeCoupon(ctx context.Context, req *coupon.DistributeCouponReq, callOptions ...callopt.Option) (r *coupon.DistributeCouponResp, err error)
+	GetDistribution(ctx context.Context, req *coupon.GetDistributionReq, callOptions ...callopt.Option) (r *coupon.GetDistributionResp, err error)
+	ResumeDistribution(ctx context.Context, req *coupon.ResumeDistributionReq, callOptions ...callopt.Option) (r *coupon.ResumeDistributionResp, err error)
+	GetCouponStats(ctx context.Context, req *coupon.GetCouponStatsReq, callOptions ...callopt.Option) (r *coupon.GetCouponStatsResp, err error)
+	CreateCouponTransfer(ctx context.Context, req *coupon.CreateCouponTransferReq, callOptions ...callopt.Option) (r *coupon.CreateCouponTransferResp, err error)
+	AcceptCouponTransfer(ctx context.Context, req *coupon.AcceptCouponTransferReq, callOptions ...callopt.Option) (r *coupon.AcceptCouponTransferResp, err error)
+	CancelCouponTransfer(ctx context.Context, req *coupon.CancelCouponTransferReq, callOptions ...callopt.Option) (r *coupon.CancelCouponTransferResp, err error)
+	ListCouponTransfers(ctx context.Context, req *coupon.ListCouponTransfersReq, callOptions ...callopt.Option) (r *coupon.ListCouponTransfersResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -43,7 +68,132 @@ type kCouponServiceClient struct {
 	*kClient
 }
 
-func (p *kCouponServiceClient) Test(ctx context.Context, req *coupon.EmptyReq, callOptions ...callopt.Option) (r *coupon.BaseResp, err error) {
+func (p *kCouponServiceClient) CreateCoupon(ctx context.Context, req *coupon.CreateCouponReq, callOptions ...callopt.Option) (r *coupon.CreateCouponResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
-	return p.kClient.Test(ctx, req)
+	return p.kClient.CreateCoupon(ctx, req)
+}
+
+func (p *kCouponServiceClient) UpdateCoupon(ctx context.Context, req *coupon.UpdateCouponReq, callOptions ...callopt.Option) (r *coupon.UpdateCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCoupon(ctx, req)
+}
+
+func (p *kCouponServiceClient) GetCoupon(ctx context.Context, req *coupon.GetCouponReq, callOptions ...callopt.Option) (r *coupon.GetCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCoupon(ctx, req)
+}
+
+func (p *kCouponServiceClient) ListCoupons(ctx context.Context, req *coupon.ListCouponsReq, callOptions ...callopt.Option) (r *coupon.ListCouponsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListCoupons(ctx, req)
+}
+
+func (p *kCouponServiceClient) InvalidateCoupon(ctx context.Context, req *coupon.InvalidateCouponReq, callOptions ...callopt.Option) (r *coupon.InvalidateCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.InvalidateCoupon(ctx, req)
+}
+
+func (p *kCouponServiceClient) ClaimCoupon(ctx context.Context, req *coupon.ClaimCouponReq, callOptions ...callopt.Option) (r *coupon.ClaimCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ClaimCoupon(ctx, req)
+}
+
+func (p *kCouponServiceClient) GetClaimStatus(ctx context.Context, req *coupon.GetClaimStatusReq, callOptions ...callopt.Option) (r *coupon.GetClaimStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetClaimStatus(ctx, req)
+}
+
+func (p *kCouponServiceClient) PreviewOrderPrice(ctx context.Context, req *coupon.PreviewOrderPriceReq, callOptions ...callopt.Option) (r *coupon.PreviewOrderPriceResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PreviewOrderPrice(ctx, req)
+}
+
+func (p *kCouponServiceClient) RecommendCoupons(ctx context.Context, req *coupon.RecommendCouponsReq, callOptions ...callopt.Option) (r *coupon.RecommendCouponsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RecommendCoupons(ctx, req)
+}
+
+func (p *kCouponServiceClient) LockCoupon(ctx context.Context, req *coupon.LockCouponReq, callOptions ...callopt.Option) (r *coupon.LockCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.LockCoupon(ctx, req)
+}
+
+func (p *kCouponServiceClient) ConsumeCoupon(ctx context.Context, req *coupon.ConsumeCouponReq, callOptions ...callopt.Option) (r *coupon.ConsumeCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConsumeCoupon(ctx, req)
+}
+
+func (p *kCouponServiceClient) ReleaseCoupon(ctx context.Context, req *coupon.ReleaseCouponReq, callOptions ...callopt.Option) (r *coupon.ReleaseCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ReleaseCoupon(ctx, req)
+}
+
+func (p *kCouponServiceClient) GenerateCodes(ctx context.Context, req *coupon.GenerateCodesReq, callOptions ...callopt.Option) (r *coupon.GenerateCodesResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GenerateCodes(ctx, req)
+}
+
+func (p *kCouponServiceClient) RedeemCode(ctx context.Context, req *coupon.RedeemCodeReq, callOptions ...callopt.Option) (r *coupon.RedeemCodeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RedeemCode(ctx, req)
+}
+
+func (p *kCouponServiceClient) ExportCodeBatch(ctx context.Context, req *coupon.ExportCodeBatchReq, callOptions ...callopt.Option) (r *coupon.ExportCodeBatchResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportCodeBatch(ctx, req)
+}
+
+func (p *kCouponServiceClient) RevokeCodeBatch(ctx context.Context, req *coupon.RevokeCodeBatchReq, callOptions ...callopt.Option) (r *coupon.RevokeCodeBatchResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RevokeCodeBatch(ctx, req)
+}
+
+func (p *kCouponServiceClient) ListCouponsForSpot(ctx context.Context, req *coupon.ListCouponsForSpotReq, callOptions ...callopt.Option) (r *coupon.ListCouponsForSpotResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListCouponsForSpot(ctx, req)
+}
+
+func (p *kCouponServiceClient) CreateSegment(ctx context.Context, req *coupon.CreateSegmentReq, callOptions ...callopt.Option) (r *coupon.CreateSegmentResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateSegment(ctx, req)
+}
+
+func (p *kCouponServiceClient) DistributeCoupon(ctx context.Context, req *coupon.DistributeCouponReq, callOptions ...callopt.Option) (r *coupon.DistributeCouponResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DistributeCoupon(ctx, req)
+}
+
+func (p *kCouponServiceClient) GetDistribution(ctx context.Context, req *coupon.GetDistributionReq, callOptions ...callopt.Option) (r *coupon.GetDistributionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDistribution(ctx, req)
+}
+
+func (p *kCouponServiceClient) ResumeDistribution(ctx context.Context, req *coupon.ResumeDistributionReq, callOptions ...callopt.Option) (r *coupon.ResumeDistributionResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResumeDistribution(ctx, req)
+}
+
+func (p *kCouponServiceClient) GetCouponStats(ctx context.Context, req *coupon.GetCouponStatsReq, callOptions ...callopt.Option) (r *coupon.GetCouponStatsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCouponStats(ctx, req)
+}
+
+func (p *kCouponServiceClient) CreateCouponTransfer(ctx context.Context, req *coupon.CreateCouponTransferReq, callOptions ...callopt.Option) (r *coupon.CreateCouponTransferResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateCouponTransfer(ctx, req)
+}
+
+func (p *kCouponServiceClient) AcceptCouponTransfer(ctx context.Context, req *coupon.AcceptCouponTransferReq, callOptions ...callopt.Option) (r *coupon.AcceptCouponTransferResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AcceptCouponTransfer(ctx, req)
+}
+
+func (p *kCouponServiceClient) CancelCouponTransfer(ctx context.Context, req *coupon.CancelCouponTransferReq, callOptions ...callopt.Option) (r *coupon.CancelCouponTransferResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelCouponTransfer(ctx, req)
+}
+
+func (p *kCouponServiceClient) ListCouponTransfers(ctx context.Context, req *coupon.ListCouponTransfersReq, callOptions ...callopt.Option) (r *coupon.ListCouponTransfersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListCouponTransfers(ctx, req)
 }
@@ -13,10 +13,185 @@ import (
 var errInvalidMessageType = errors.New("invalid message type for service method handler")
 
 var serviceMethods = map[string]kitex.MethodInfo{
-	"Test": kitex.NewMethodInfo(
-		testHandler,
-		newCouponServiceTestArgs,
-		newCouponServiceTestResult,
+	"CreateCoupon": kitex.NewMethodInfo(
+		createCouponHandler,
+		newCouponServiceCreateCouponArgs,
+		newCouponServiceCreateCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdateCoupon": kitex.NewMethodInfo(
+		updateCouponHandler,
+		newCouponServiceUpdateCouponArgs,
+		newCouponServiceUpdateCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCoupon": kitex.NewMethodInfo(
+		getCouponHandler,
+		newCouponServiceGetCouponArgs,
+		newCouponServiceGetCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListCoupons": kitex.NewMethodInfo(
+		listCouponsHandler,
+		newCouponServiceListCouponsArgs,
+		newCouponServiceListCouponsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"InvalidateCoupon": kitex.NewMethodInfo(
+		invalidateCouponHandler,
+		newCouponServiceInvalidateCouponArgs,
+		newCouponServiceInvalidateCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ClaimCoupon": kitex.NewMethodInfo(
+		claimCouponHandler,
+		newCouponServiceClaimCouponArgs,
+		newCouponServiceClaimCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetClaimStatus": kitex.NewMethodInfo(
+		getClaimStatusHandler,
+		newCouponServiceGetClaimStatusArgs,
+		newCouponServiceGetClaimStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"PreviewOrderPrice": kitex.NewMethodInfo(
+		previewOrderPriceHandler,
+		newCouponServicePreviewOrderPriceArgs,
+		newCouponServicePreviewOrderPriceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RecommendCoupons": kitex.NewMethodInfo(
+		recommendCouponsHandler,
+		newCouponServiceRecommendCouponsArgs,
+		newCouponServiceRecommendCouponsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"LockCoupon": kitex.NewMethodInfo(
+		lockCouponHandler,
+		newCouponServiceLockCouponArgs,
+		newCouponServiceLockCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ConsumeCoupon": kitex.NewMethodInfo(
+		consumeCouponHandler,
+		newCouponServiceConsumeCouponArgs,
+		newCouponServiceConsumeCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ReleaseCoupon": kitex.NewMethodInfo(
+		releaseCouponHandler,
+		newCouponServiceReleaseCouponArgs,
+		newCouponServiceReleaseCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GenerateCodes": kitex.NewMethodInfo(
+		generateCodesHandler,
+		newCouponServiceGenerateCodesArgs,
+		newCouponServiceGenerateCodesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RedeemCode": kitex.NewMethodInfo(
+		redeemCodeHandler,
+		newCouponServiceRedeemCodeArgs,
+		newCouponServiceRedeemCodeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ExportCodeBatch": kitex.NewMethodInfo(
+		exportCodeBatchHandler,
+		newCouponServiceExportCodeBatchArgs,
+		newCouponServiceExportCodeBatchResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RevokeCodeBatch": kitex.NewMethodInfo(
+		revokeCodeBatchHandler,
+		newCouponServiceRevokeCodeBatchArgs,
+		newCouponServiceRevokeCodeBatchResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListCouponsForSpot": kitex.NewMethodInfo(
+		listCouponsForSpotHandler,
+		newCouponServiceListCouponsForSpotArgs,
+		newCouponServiceListCouponsForSpotResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateSegment": kitex.NewMethodInfo(
+		createSegmentHandler,
+		newCouponServiceCreateSegmentArgs,
+		newCouponServiceCreateSegmentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DistributeCoupon": kitex.NewMethodInfo(
+		distributeCouponHandler,
+		newCouponServiceDistributeCouponArgs,
+		newCouponServiceDistributeCouponResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetDistribution": kitex.NewMethodInfo(
+		getDistributionHandler,
+		newCouponServiceGetDistributionArgs,
+		newCouponServiceGetDistributionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResumeDistribution": kitex.NewMethodInfo(
+		resumeDistributionHandler,
+		newCouponServiceResumeDistributionArgs,
+		newCouponServiceResumeDistributionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetCouponStats": kitex.NewMethodInfo(
+		getCouponStatsHandler,
+		newCouponServiceGetCouponStatsArgs,
+		newCouponServiceGetCouponStatsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateCouponTransfer": kitex.NewMethodInfo(
+		createCouponTransferHandler,
+		newCouponServiceCreateCouponTransferArgs,
+		newCouponServiceCreateCouponTransferResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AcceptCouponTransfer": kitex.NewMethodInfo(
+		acceptCouponTransferHandler,
+		newCouponServiceAcceptCouponTransferArgs,
+		newCouponServiceAcceptCouponTransferResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CancelCouponTransfer": kitex.NewMethodInfo(
+		cancelCouponTransferHandler,
+		newCouponServiceCancelCouponTransferArgs,
+		newCouponServiceCancelCouponTransferResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListCouponTransfers": kitex.NewMethodInfo(
+		listCouponTransfersHandler,
+		newCouponServiceListCouponTransfersArgs,
+		newCouponServiceListCouponTransfersResult,
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
@@ -86,22 +261,472 @@ func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreami
 	return svcInfo
 }
 
-func testHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*coupon.CouponServiceTestArgs)
-	realResult := result.(*coupon.CouponServiceTestResult)
-	success, err := handler.(coupon.CouponService).Test(ctx, realArg.Req)
+func createCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceCreateCouponArgs)
+	realResult := result.(*coupon.CouponServiceCreateCouponResult)
+	success, err := handler.(coupon.CouponService).CreateCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceCreateCouponArgs() interface{} {
+	return coupon.NewCouponServiceCreateCouponArgs()
+}
+
+func newCouponServiceCreateCouponResult() interface{} {
+	return coupon.NewCouponServiceCreateCouponResult()
+}
+
+func updateCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceUpdateCouponArgs)
+	realResult := result.(*coupon.CouponServiceUpdateCouponResult)
+	success, err := handler.(coupon.CouponService).UpdateCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceUpdateCouponArgs() interface{} {
+	return coupon.NewCouponServiceUpdateCouponArgs()
+}
+
+func newCouponServiceUpdateCouponResult() interface{} {
+	return coupon.NewCouponServiceUpdateCouponResult()
+}
+
+func getCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceGetCouponArgs)
+	realResult := result.(*coupon.CouponServiceGetCouponResult)
+	success, err := handler.(coupon.CouponService).GetCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceGetCouponArgs() interface{} {
+	return coupon.NewCouponServiceGetCouponArgs()
+}
+
+func newCouponServiceGetCouponResult() interface{} {
+	return coupon.NewCouponServiceGetCouponResult()
+}
+
+func listCouponsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceListCouponsArgs)
+	realResult := result.(*coupon.CouponServiceListCouponsResult)
+	success, err := handler.(coupon.CouponService).ListCoupons(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceListCouponsArgs() interface{} {
+	return coupon.NewCouponServiceListCouponsArgs()
+}
+
+func newCouponServiceListCouponsResult() interface{} {
+	return coupon.NewCouponServiceListCouponsResult()
+}
+
+func invalidateCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceInvalidateCouponArgs)
+	realResult := result.(*coupon.CouponServiceInvalidateCouponResult)
+	success, err := handler.(coupon.CouponService).InvalidateCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceInvalidateCouponArgs() interface{} {
+	return coupon.NewCouponServiceInvalidateCouponArgs()
+}
+
+func newCouponServiceInvalidateCouponResult() interface{} {
+	return coupon.NewCouponServiceInvalidateCouponResult()
+}
+
+func claimCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceClaimCouponArgs)
+	realResult := result.(*coupon.CouponServiceClaimCouponResult)
+	success, err := handler.(coupon.CouponService).ClaimCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceClaimCouponArgs() interface{} {
+	return coupon.NewCouponServiceClaimCouponArgs()
+}
+
+func newCouponServiceClaimCouponResult() interface{} {
+	return coupon.NewCouponServiceClaimCouponResult()
+}
+
+func getClaimStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceGetClaimStatusArgs)
+	realResult := result.(*coupon.CouponServiceGetClaimStatusResult)
+	success, err := handler.(coupon.CouponService).GetClaimStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceGetClaimStatusArgs() interface{} {
+	return coupon.NewCouponServiceGetClaimStatusArgs()
+}
+
+func newCouponServiceGetClaimStatusResult() interface{} {
+	return coupon.NewCouponServiceGetClaimStatusResult()
+}
+
+func previewOrderPriceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServicePreviewOrderPriceArgs)
+	realResult := result.(*coupon.CouponServicePreviewOrderPriceResult)
+	success, err := handler.(coupon.CouponService).PreviewOrderPrice(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServicePreviewOrderPriceArgs() interface{} {
+	return coupon.NewCouponServicePreviewOrderPriceArgs()
+}
+
+func newCouponServicePreviewOrderPriceResult() interface{} {
+	return coupon.NewCouponServicePreviewOrderPriceResult()
+}
+
+func recommendCouponsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceRecommendCouponsArgs)
+	realResult := result.(*coupon.CouponServiceRecommendCouponsResult)
+	success, err := handler.(coupon.CouponService).RecommendCoupons(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceRecommendCouponsArgs() interface{} {
+	return coupon.NewCouponServiceRecommendCouponsArgs()
+}
+
+func newCouponServiceRecommendCouponsResult() interface{} {
+	return coupon.NewCouponServiceRecommendCouponsResult()
+}
+
+func lockCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceLockCouponArgs)
+	realResult := result.(*coupon.CouponServiceLockCouponResult)
+	success, err := handler.(coupon.CouponService).LockCoupon(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newCouponServiceTestArgs() interface{} {
-	return coupon.NewCouponServiceTestArgs()
+func newCouponServiceLockCouponArgs() interface{} {
+	return coupon.NewCouponServiceLockCouponArgs()
 }
 
-func newCouponServiceTestResult() interface{} {
-	return coupon.NewCouponServiceTestResult()
+func newCouponServiceLockCouponResult() interface{} {
+	return coupon.NewCouponServiceLockCouponResult()
+}
+
+func consumeCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceConsumeCouponArgs)
+	realResult := result.(*coupon.CouponServiceConsumeCouponResult)
+	success, err := handler.(coupon.CouponService).ConsumeCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceConsumeCouponArgs() interface{} {
+	return coupon.NewCouponServiceConsumeCouponArgs()
+}
+
+func newCouponServiceConsumeCouponResult() interface{} {
+	return coupon.NewCouponServiceConsumeCouponResult()
+}
+
+func releaseCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceReleaseCouponArgs)
+	realResult := result.(*coupon.CouponServiceReleaseCouponResult)
+	success, err := handler.(coupon.CouponService).ReleaseCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceReleaseCouponArgs() interface{} {
+	return coupon.NewCouponServiceReleaseCouponArgs()
+}
+
+func newCouponServiceReleaseCouponResult() interface{} {
+	return coupon.NewCouponServiceReleaseCouponResult()
+}
+
+func generateCodesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceGenerateCodesArgs)
+	realResult := result.(*coupon.CouponServiceGenerateCodesResult)
+	success, err := handler.(coupon.CouponService).GenerateCodes(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceGenerateCodesArgs() interface{} {
+	return coupon.NewCouponServiceGenerateCodesArgs()
+}
+
+func newCouponServiceGenerateCodesResult() interface{} {
+	return coupon.NewCouponServiceGenerateCodesResult()
+}
+
+func redeemCodeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceRedeemCodeArgs)
+	realResult := result.(*coupon.CouponServiceRedeemCodeResult)
+	success, err := handler.(coupon.CouponService).RedeemCode(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceRedeemCodeArgs() interface{} {
+	return coupon.NewCouponServiceRedeemCodeArgs()
+}
+
+func newCouponServiceRedeemCodeResult() interface{} {
+	return coupon.NewCouponServiceRedeemCodeResult()
+}
+
+func exportCodeBatchHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceExportCodeBatchArgs)
+	realResult := result.(*coupon.CouponServiceExportCodeBatchResult)
+	success, err := handler.(coupon.CouponService).ExportCodeBatch(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceExportCodeBatchArgs() interface{} {
+	return coupon.NewCouponServiceExportCodeBatchArgs()
+}
+
+func newCouponServiceExportCodeBatchResult() interface{} {
+	return coupon.NewCouponServiceExportCodeBatchResult()
+}
+
+func revokeCodeBatchHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceRevokeCodeBatchArgs)
+	realResult := result.(*coupon.CouponServiceRevokeCodeBatchResult)
+	success, err := handler.(coupon.CouponService).RevokeCodeBatch(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceRevokeCodeBatchArgs() interface{} {
+	return coupon.NewCouponServiceRevokeCodeBatchArgs()
+}
+
+func newCouponServiceRevokeCodeBatchResult() interface{} {
+	return coupon.NewCouponServiceRevokeCodeBatchResult()
+}
+
+func listCouponsForSpotHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceListCouponsForSpotArgs)
+	realResult := result.(*coupon.CouponServiceListCouponsForSpotResult)
+	success, err := handler.(coupon.CouponService).ListCouponsForSpot(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceListCouponsForSpotArgs() interface{} {
+	return coupon.NewCouponServiceListCouponsForSpotArgs()
+}
+
+func newCouponServiceListCouponsForSpotResult() interface{} {
+	return coupon.NewCouponServiceListCouponsForSpotResult()
+}
+
+func createSegmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceCreateSegmentArgs)
+	realResult := result.(*coupon.CouponServiceCreateSegmentResult)
+	success, err := handler.(coupon.CouponService).CreateSegment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceCreateSegmentArgs() interface{} {
+	return coupon.NewCouponServiceCreateSegmentArgs()
+}
+
+func newCouponServiceCreateSegmentResult() interface{} {
+	return coupon.NewCouponServiceCreateSegmentResult()
+}
+
+func distributeCouponHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceDistributeCouponArgs)
+	realResult := result.(*coupon.CouponServiceDistributeCouponResult)
+	success, err := handler.(coupon.CouponService).DistributeCoupon(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceDistributeCouponArgs() interface{} {
+	return coupon.NewCouponServiceDistributeCouponArgs()
+}
+
+func newCouponServiceDistributeCouponResult() interface{} {
+	return coupon.NewCouponServiceDistributeCouponResult()
+}
+
+func getDistributionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceGetDistributionArgs)
+	realResult := result.(*coupon.CouponServiceGetDistributionResult)
+	success, err := handler.(coupon.CouponService).GetDistribution(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceGetDistributionArgs() interface{} {
+	return coupon.NewCouponServiceGetDistributionArgs()
+}
+
+func newCouponServiceGetDistributionResult() interface{} {
+	return coupon.NewCouponServiceGetDistributionResult()
+}
+
+func resumeDistributionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceResumeDistributionArgs)
+	realResult := result.(*coupon.CouponServiceResumeDistributionResult)
+	success, err := handler.(coupon.CouponService).ResumeDistribution(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceResumeDistributionArgs() interface{} {
+	return coupon.NewCouponServiceResumeDistributionArgs()
+}
+
+func newCouponServiceResumeDistributionResult() interface{} {
+	return coupon.NewCouponServiceResumeDistributionResult()
+}
+
+func getCouponStatsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceGetCouponStatsArgs)
+	realResult := result.(*coupon.CouponServiceGetCouponStatsResult)
+	success, err := handler.(coupon.CouponService).GetCouponStats(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceGetCouponStatsArgs() interface{} {
+	return coupon.NewCouponServiceGetCouponStatsArgs()
+}
+
+func newCouponServiceGetCouponStatsResult() interface{} {
+	return coupon.NewCouponServiceGetCouponStatsResult()
+}
+
+func createCouponTransferHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceCreateCouponTransferArgs)
+	realResult := result.(*coupon.CouponServiceCreateCouponTransferResult)
+	success, err := handler.(coupon.CouponService).CreateCouponTransfer(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceCreateCouponTransferArgs() interface{} {
+	return coupon.NewCouponServiceCreateCouponTransferArgs()
+}
+
+func newCouponServiceCreateCouponTransferResult() interface{} {
+	return coupon.NewCouponServiceCreateCouponTransferResult()
+}
+
+func acceptCouponTransferHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceAcceptCouponTransferArgs)
+	realResult := result.(*coupon.CouponServiceAcceptCouponTransferResult)
+	success, err := handler.(coupon.CouponService).AcceptCouponTransfer(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceAcceptCouponTransferArgs() interface{} {
+	return coupon.NewCouponServiceAcceptCouponTransferArgs()
+}
+
+func newCouponServiceAcceptCouponTransferResult() interface{} {
+	return coupon.NewCouponServiceAcceptCouponTransferResult()
+}
+
+func cancelCouponTransferHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceCancelCouponTransferArgs)
+	realResult := result.(*coupon.CouponServiceCancelCouponTransferResult)
+	success, err := handler.(coupon.CouponService).CancelCouponTransfer(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceCancelCouponTransferArgs() interface{} {
+	return coupon.NewCouponServiceCancelCouponTransferArgs()
+}
+
+func newCouponServiceCancelCouponTransferResult() interface{} {
+	return coupon.NewCouponServiceCancelCouponTransferResult()
+}
+
+func listCouponTransfersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*coupon.CouponServiceListCouponTransfersArgs)
+	realResult := result.(*coupon.CouponServiceListCouponTransfersResult)
+	success, err := handler.(coupon.CouponService).ListCouponTransfers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newCouponServiceListCouponTransfersArgs() interface{} {
+	return coupon.NewCouponServiceListCouponTransfersArgs()
+}
+
+func newCouponServiceListCouponTransfersResult() interface{} {
+	return coupon.NewCouponServiceListCouponTransfersResult()
 }
 
 type kClient struct {
@@ -114,11 +739,261 @@ func newServiceClient(c client.Client) *kClient {
 	}
 }
 
-func (p *kClient) Test(ctx context.Context, req *coupon.EmptyReq) (r *coupon.BaseResp, err error) {
-	var _args coupon.CouponServiceTestArgs
+func (p *kClient) CreateCoupon(ctx context.Context, req *coupon.CreateCouponReq) (r *coupon.CreateCouponResp, err error) {
+	var _args coupon.CouponServiceCreateCouponArgs
+	_args.Req = req
+	var _result coupon.CouponServiceCreateCouponResult
+	if err = p.c.Call(ctx, "CreateCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCoupon(ctx context.Context, req *coupon.UpdateCouponReq) (r *coupon.UpdateCouponResp, err error) {
+	var _args coupon.CouponServiceUpdateCouponArgs
+	_args.Req = req
+	var _result coupon.CouponServiceUpdateCouponResult
+	if err = p.c.Call(ctx, "UpdateCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCoupon(ctx context.Context, req *coupon.GetCouponReq) (r *coupon.GetCouponResp, err error) {
+	var _args coupon.CouponServiceGetCouponArgs
+	_args.Req = req
+	var _result coupon.CouponServiceGetCouponResult
+	if err = p.c.Call(ctx, "GetCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListCoupons(ctx context.Context, req *coupon.ListCouponsReq) (r *coupon.ListCouponsResp, err error) {
+	var _args coupon.CouponServiceListCouponsArgs
+	_args.Req = req
+	var _result coupon.CouponServiceListCouponsResult
+	if err = p.c.Call(ctx, "ListCoupons", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) InvalidateCoupon(ctx context.Context, req *coupon.InvalidateCouponReq) (r *coupon.InvalidateCouponResp, err error) {
+	var _args coupon.CouponServiceInvalidateCouponArgs
+	_args.Req = req
+	var _result coupon.CouponServiceInvalidateCouponResult
+	if err = p.c.Call(ctx, "InvalidateCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ClaimCoupon(ctx context.Context, req *coupon.ClaimCouponReq) (r *coupon.ClaimCouponResp, err error) {
+	var _args coupon.CouponServiceClaimCouponArgs
+	_args.Req = req
+	var _result coupon.CouponServiceClaimCouponResult
+	if err = p.c.Call(ctx, "ClaimCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetClaimStatus(ctx context.Context, req *coupon.GetClaimStatusReq) (r *coupon.GetClaimStatusResp, err error) {
+	var _args coupon.CouponServiceGetClaimStatusArgs
+	_args.Req = req
+	var _result coupon.CouponServiceGetClaimStatusResult
+	if err = p.c.Call(ctx, "GetClaimStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PreviewOrderPrice(ctx context.Context, req *coupon.PreviewOrderPriceReq) (r *coupon.PreviewOrderPriceResp, err error) {
+	var _args coupon.CouponServicePreviewOrderPriceArgs
+	_args.Req = req
+	var _result coupon.CouponServicePreviewOrderPriceResult
+	if err = p.c.Call(ctx, "PreviewOrderPrice", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RecommendCoupons(ctx context.Context, req *coupon.RecommendCouponsReq) (r *coupon.RecommendCouponsResp, err error) {
+	var _args coupon.CouponServiceRecommendCouponsArgs
+	_args.Req = req
+	var _result coupon.CouponServiceRecommendCouponsResult
+	if err = p.c.Call(ctx, "RecommendCoupons", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) LockCoupon(ctx context.Context, req *coupon.LockCouponReq) (r *coupon.LockCouponResp, err error) {
+	var _args coupon.CouponServiceLockCouponArgs
+	_args.Req = req
+	var _result coupon.CouponServiceLockCouponResult
+	if err = p.c.Call(ctx, "LockCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConsumeCoupon(ctx context.Context, req *coupon.ConsumeCouponReq) (r *coupon.ConsumeCouponResp, err error) {
+	var _args coupon.CouponServiceConsumeCouponArgs
+	_args.Req = req
+	var _result coupon.CouponServiceConsumeCouponResult
+	if err = p.c.Call(ctx, "ConsumeCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ReleaseCoupon(ctx context.Context, req *coupon.ReleaseCouponReq) (r *coupon.ReleaseCouponResp, err error) {
+	var _args coupon.CouponServiceReleaseCouponArgs
+	_args.Req = req
+	var _result coupon.CouponServiceReleaseCouponResult
+	if err = p.c.Call(ctx, "ReleaseCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GenerateCodes(ctx context.Context, req *coupon.GenerateCodesReq) (r *coupon.GenerateCodesResp, err error) {
+	var _args coupon.CouponServiceGenerateCodesArgs
+	_args.Req = req
+	var _result coupon.CouponServiceGenerateCodesResult
+	if err = p.c.Call(ctx, "GenerateCodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RedeemCode(ctx context.Context, req *coupon.RedeemCodeReq) (r *coupon.RedeemCodeResp, err error) {
+	var _args coupon.CouponServiceRedeemCodeArgs
+	_args.Req = req
+	var _result coupon.CouponServiceRedeemCodeResult
+	if err = p.c.Call(ctx, "RedeemCode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportCodeBatch(ctx context.Context, req *coupon.ExportCodeBatchReq) (r *coupon.ExportCodeBatchResp, err error) {
+	var _args coupon.CouponServiceExportCodeBatchArgs
+	_args.Req = req
+	var _result coupon.CouponServiceExportCodeBatchResult
+	if err = p.c.Call(ctx, "ExportCodeBatch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RevokeCodeBatch(ctx context.Context, req *coupon.RevokeCodeBatchReq) (r *coupon.RevokeCodeBatchResp, err error) {
+	var _args coupon.CouponServiceRevokeCodeBatchArgs
+	_args.Req = req
+	var _result coupon.CouponServiceRevokeCodeBatchResult
+	if err = p.c.Call(ctx, "RevokeCodeBatch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListCouponsForSpot(ctx context.Context, req *coupon.ListCouponsForSpotReq) (r *coupon.ListCouponsForSpotResp, err error) {
+	var _args coupon.CouponServiceListCouponsForSpotArgs
+	_args.Req = req
+	var _result coupon.CouponServiceListCouponsForSpotResult
+	if err = p.c.Call(ctx, "ListCouponsForSpot", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateSegment(ctx context.Context, req *coupon.CreateSegmentReq) (r *coupon.CreateSegmentResp, err error) {
+	var _args coupon.CouponServiceCreateSegmentArgs
+	_args.Req = req
+	var _result coupon.CouponServiceCreateSegmentResult
+	if err = p.c.Call(ctx, "CreateSegment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DistributeCoupon(ctx context.Context, req *coupon.DistributeCouponReq) (r *coupon.DistributeCouponResp, err error) {
+	var _args coupon.CouponServiceDistributeCouponArgs
+	_args.Req = req
+	var _result coupon.CouponServiceDistributeCouponResult
+	if err = p.c.Call(ctx, "DistributeCoupon", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDistribution(ctx context.Context, req *coupon.GetDistributionReq) (r *coupon.GetDistributionResp, err error) {
+	var _args coupon.CouponServiceGetDistributionArgs
+	_args.Req = req
+	var _result coupon.CouponServiceGetDistributionResult
+	if err = p.c.Call(ctx, "GetDistribution", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResumeDistribution(ctx context.Context, req *coupon.ResumeDistributionReq) (r *coupon.ResumeDistributionResp, err error) {
+	var _args coupon.CouponServiceResumeDistributionArgs
+	_args.Req = req
+	var _result coupon.CouponServiceResumeDistributionResult
+	if err = p.c.Call(ctx, "ResumeDistribution", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCouponStats(ctx context.Context, req *coupon.GetCouponStatsReq) (r *coupon.GetCouponStatsResp, err error) {
+	var _args coupon.CouponServiceGetCouponStatsArgs
+	_args.Req = req
+	var _result coupon.CouponServiceGetCouponStatsResult
+	if err = p.c.Call(ctx, "GetCouponStats", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateCouponTransfer(ctx context.Context, req *coupon.CreateCouponTransferReq) (r *coupon.CreateCouponTransferResp, err error) {
+	var _args coupon.CouponServiceCreateCouponTransferArgs
+	_args.Req = req
+	var _result coupon.CouponServiceCreateCouponTransferResult
+	if err = p.c.Call(ctx, "CreateCouponTransfer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AcceptCouponTransfer(ctx context.Context, req *coupon.AcceptCouponTransferReq) (r *coupon.AcceptCouponTransferResp, err error) {
+	var _args coupon.CouponServiceAcceptCouponTransferArgs
+	_args.Req = req
+	var _result coupon.CouponServiceAcceptCouponTransferResult
+	if err = p.c.Call(ctx, "AcceptCouponTransfer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelCouponTransfer(ctx context.Context, req *coupon.CancelCouponTransferReq) (r *coupon.CancelCouponTransferResp, err error) {
+	var _args coupon.CouponServiceCancelCouponTransferArgs
+	_args.Req = req
+	var _result coupon.CouponServiceCancelCouponTransferResult
+	if err = p.c.Call(ctx, "CancelCouponTransfer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListCouponTransfers(ctx context.Context, req *coupon.ListCouponTransfersReq) (r *coupon.ListCouponTransfersResp, err error) {
+	var _args coupon.CouponServiceListCouponTransfersArgs
 	_args.Req = req
-	var _result coupon.CouponServiceTestResult
-	if err = p.c.Call(ctx, "Test", &_args, &_result); err != nil {
+	var _result coupon.CouponServiceListCouponTransfersResult
+	if err = p.c.Call(ctx, "ListCouponTransfers", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
@@ -0,0 +1,1921 @@
+// Code generated by thriftgo (0.4.3). DO NOT EDIT.
+
+package order
+
+import (
+	"context"
+	"fmt"
+)
+
+type BaseResp struct {
+	Code int32  `thrift:"code,1" frugal:"1,default,i32" json:"code"`
+	Msg  string `thrift:"msg,2" frugal:"2,default,string" json:"msg"`
+}
+
+func NewBaseResp() *BaseResp {
+	return &BaseResp{}
+}
+
+func (p *BaseResp) InitDefault() {
+}
+
+func (p *BaseResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *BaseResp) GetMsg() (v string) {
+	return p.Msg
+}
+func (p *BaseResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *BaseResp) SetMsg(val string) {
+	p.Msg = val
+}
+
+func (p *BaseResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BaseResp(%+v)", *p)
+}
+
+var fieldIDToName_BaseResp = map[int16]string{
+	1: "code",
+	2: "msg",
+}
+
+type OrderTicketReq struct {
+	TicketTypeId int64   `thrift:"ticket_type_id,1" frugal:"1,default,i64" json:"ticket_type_id"`
+	TravelerIds  []int64 `thrift:"traveler_ids,2" frugal:"2,default,list<i64>" json:"traveler_ids"`
+}
+
+func NewOrderTicketReq() *OrderTicketReq {
+	return &OrderTicketReq{}
+}
+
+func (p *OrderTicketReq) InitDefault() {
+}
+
+func (p *OrderTicketReq) GetTicketTypeId() (v int64) {
+	return p.TicketTypeId
+}
+
+func (p *OrderTicketReq) GetTravelerIds() (v []int64) {
+	return p.TravelerIds
+}
+func (p *OrderTicketReq) SetTicketTypeId(val int64) {
+	p.TicketTypeId = val
+}
+func (p *OrderTicketReq) SetTravelerIds(val []int64) {
+	p.TravelerIds = val
+}
+
+func (p *OrderTicketReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderTicketReq(%+v)", *p)
+}
+
+var fieldIDToName_OrderTicketReq = map[int16]string{
+	1: "ticket_type_id",
+	2: "traveler_ids",
+}
+
+type CreateOrderReq struct {
+	UserId        int64             `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	Tickets       []*OrderTicketReq `thrift:"tickets,2" frugal:"2,default,list<OrderTicketReq>" json:"tickets"`
+	UserCouponIds []int64           `thrift:"user_coupon_ids,3" frugal:"3,default,list<i64>" json:"user_coupon_ids"`
+	PayType       string            `thrift:"pay_type,4" frugal:"4,default,string" json:"pay_type"`
+	VisitDate     string            `thrift:"visit_date,5" frugal:"5,default,string" json:"visit_date"`
+}
+
+func NewCreateOrderReq() *CreateOrderReq {
+	return &CreateOrderReq{}
+}
+
+func (p *CreateOrderReq) InitDefault() {
+}
+
+func (p *CreateOrderReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CreateOrderReq) GetTickets() (v []*OrderTicketReq) {
+	return p.Tickets
+}
+
+func (p *CreateOrderReq) GetUserCouponIds() (v []int64) {
+	return p.UserCouponIds
+}
+
+func (p *CreateOrderReq) GetPayType() (v string) {
+	return p.PayType
+}
+
+func (p *CreateOrderReq) GetVisitDate() (v string) {
+	return p.VisitDate
+}
+func (p *CreateOrderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CreateOrderReq) SetTickets(val []*OrderTicketReq) {
+	p.Tickets = val
+}
+func (p *CreateOrderReq) SetUserCouponIds(val []int64) {
+	p.UserCouponIds = val
+}
+func (p *CreateOrderReq) SetPayType(val string) {
+	p.PayType = val
+}
+func (p *CreateOrderReq) SetVisitDate(val string) {
+	p.VisitDate = val
+}
+
+func (p *CreateOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateOrderReq(%+v)", *p)
+}
+
+var fieldIDToName_CreateOrderReq = map[int16]string{
+	1: "user_id",
+	2: "tickets",
+	3: "user_coupon_ids",
+	4: "pay_type",
+	5: "visit_date",
+}
+
+type OrderItemInfo struct {
+	Id           int64   `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	TicketTypeId int64   `thrift:"ticket_type_id,2" frugal:"2,default,i64" json:"ticket_type_id"`
+	TravelerId   int64   `thrift:"traveler_id,3" frugal:"3,default,i64" json:"traveler_id"`
+	TicketName   string  `thrift:"ticket_name,4" frugal:"4,default,string" json:"ticket_name"`
+	SinglePrice  float64 `thrift:"single_price,5" frugal:"5,default,double" json:"single_price"`
+	TicketNum    int32   `thrift:"ticket_num,6" frugal:"6,default,i32" json:"ticket_num"`
+	PayAmount    float64 `thrift:"pay_amount,7" frugal:"7,default,double" json:"pay_amount"`
+	ItemStatus   string  `thrift:"item_status,8" frugal:"8,default,string" json:"item_status"`
+	VerifyTime   int64   `thrift:"verify_time,9" frugal:"9,default,i64" json:"verify_time"`
+	RefundAmount float64 `thrift:"refund_amount,10" frugal:"10,default,double" json:"refund_amount"`
+	RefundTime   int64   `thrift:"refund_time,11" frugal:"11,default,i64" json:"refund_time"`
+}
+
+func NewOrderItemInfo() *OrderItemInfo {
+	return &OrderItemInfo{}
+}
+
+func (p *OrderItemInfo) InitDefault() {
+}
+
+func (p *OrderItemInfo) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *OrderItemInfo) GetTicketTypeId() (v int64) {
+	return p.TicketTypeId
+}
+
+func (p *OrderItemInfo) GetTravelerId() (v int64) {
+	return p.TravelerId
+}
+
+func (p *OrderItemInfo) GetTicketName() (v string) {
+	return p.TicketName
+}
+
+func (p *OrderItemInfo) GetSinglePrice() (v float64) {
+	return p.SinglePrice
+}
+
+func (p *OrderItemInfo) GetTicketNum() (v int32) {
+	return p.TicketNum
+}
+
+func (p *OrderItemInfo) GetPayAmount() (v float64) {
+	return p.PayAmount
+}
+
+func (p *OrderItemInfo) GetItemStatus() (v string) {
+	return p.ItemStatus
+}
+
+func (p *OrderItemInfo) GetVerifyTime() (v int64) {
+	return p.VerifyTime
+}
+
+func (p *OrderItemInfo) GetRefundAmount() (v float64) {
+	return p.RefundAmount
+}
+
+func (p *OrderItemInfo) GetRefundTime() (v int64) {
+	return p.RefundTime
+}
+func (p *OrderItemInfo) SetId(val int64) {
+	p.Id = val
+}
+func (p *OrderItemInfo) SetTicketTypeId(val int64) {
+	p.TicketTypeId = val
+}
+func (p *OrderItemInfo) SetTravelerId(val int64) {
+	p.TravelerId = val
+}
+func (p *OrderItemInfo) SetTicketName(val string) {
+	p.TicketName = val
+}
+func (p *OrderItemInfo) SetSinglePrice(val float64) {
+	p.SinglePrice = val
+}
+func (p *OrderItemInfo) SetTicketNum(val int32) {
+	p.TicketNum = val
+}
+func (p *OrderItemInfo) SetPayAmount(val float64) {
+	p.PayAmount = val
+}
+func (p *OrderItemInfo) SetItemStatus(val string) {
+	p.ItemStatus = val
+}
+func (p *OrderItemInfo) SetVerifyTime(val int64) {
+	p.VerifyTime = val
+}
+func (p *OrderItemInfo) SetRefundAmount(val float64) {
+	p.RefundAmount = val
+}
+func (p *OrderItemInfo) SetRefundTime(val int64) {
+	p.RefundTime = val
+}
+
+func (p *OrderItemInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderItemInfo(%+v)", *p)
+}
+
+var fieldIDToName_OrderItemInfo = map[int16]string{
+	1:  "id",
+	2:  "ticket_type_id",
+	3:  "traveler_id",
+	4:  "ticket_name",
+	5:  "single_price",
+	6:  "ticket_num",
+	7:  "pay_amount",
+	8:  "item_status",
+	9:  "verify_time",
+	10: "refund_amount",
+	11: "refund_time",
+}
+
+type OrderInfo struct {
+	Id              int64            `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	OrderNo         string           `thrift:"order_no,2" frugal:"2,default,string" json:"order_no"`
+	UserId          int64            `thrift:"user_id,3" frugal:"3,default,i64" json:"user_id"`
+	MerchantId      int64            `thrift:"merchant_id,4" frugal:"4,default,i64" json:"merchant_id"`
+	SpotId          int64            `thrift:"spot_id,5" frugal:"5,default,i64" json:"spot_id"`
+	TotalAmount     float64          `thrift:"total_amount,6" frugal:"6,default,double" json:"total_amount"`
+	PayAmount       float64          `thrift:"pay_amount,7" frugal:"7,default,double" json:"pay_amount"`
+	CouponId        int64            `thrift:"coupon_id,8" frugal:"8,default,i64" json:"coupon_id"`
+	OrderStatus     string           `thrift:"order_status,9" frugal:"9,default,string" json:"order_status"`
+	PayType         string           `thrift:"pay_type,10" frugal:"10,default,string" json:"pay_type"`
+	CreatedAt       int64            `thrift:"created_at,11" frugal:"11,default,i64" json:"created_at"`
+	Items           []*OrderItemInfo `thrift:"items,12" frugal:"12,default,list<OrderItemInfo>" json:"items"`
+	PayTime         int64            `thrift:"pay_time,13" frugal:"13,default,i64" json:"pay_time"`
+	CancelTime      int64            `thrift:"cancel_time,14" frugal:"14,default,i64" json:"cancel_time"`
+	VerifyTime      int64            `thrift:"verify_time,15" frugal:"15,default,i64" json:"verify_time"`
+	RefundTime      int64            `thrift:"refund_time,16" frugal:"16,default,i64" json:"refund_time"`
+	VisitDate       string           `thrift:"visit_date,17" frugal:"17,default,string" json:"visit_date"`
+	RescheduleCount int32            `thrift:"reschedule_count,18" frugal:"18,default,i32" json:"reschedule_count"`
+}
+
+func NewOrderInfo() *OrderInfo {
+	return &OrderInfo{}
+}
+
+func (p *OrderInfo) InitDefault() {
+}
+
+func (p *OrderInfo) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *OrderInfo) GetOrderNo() (v string) {
+	return p.OrderNo
+}
+
+func (p *OrderInfo) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *OrderInfo) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *OrderInfo) GetSpotId() (v int64) {
+	return p.SpotId
+}
+
+func (p *OrderInfo) GetTotalAmount() (v float64) {
+	return p.TotalAmount
+}
+
+func (p *OrderInfo) GetPayAmount() (v float64) {
+	return p.PayAmount
+}
+
+func (p *OrderInfo) GetCouponId() (v int64) {
+	return p.CouponId
+}
+
+func (p *OrderInfo) GetOrderStatus() (v string) {
+	return p.OrderStatus
+}
+
+func (p *OrderInfo) GetPayType() (v string) {
+	return p.PayType
+}
+
+func (p *OrderInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+func (p *OrderInfo) GetItems() (v []*OrderItemInfo) {
+	return p.Items
+}
+
+func (p *OrderInfo) GetPayTime() (v int64) {
+	return p.PayTime
+}
+
+func (p *OrderInfo) GetCancelTime() (v int64) {
+	return p.CancelTime
+}
+
+func (p *OrderInfo) GetVerifyTime() (v int64) {
+	return p.VerifyTime
+}
+
+func (p *OrderInfo) GetRefundTime() (v int64) {
+	return p.RefundTime
+}
+
+func (p *OrderInfo) GetVisitDate() (v string) {
+	return p.VisitDate
+}
+
+func (p *OrderInfo) GetRescheduleCount() (v int32) {
+	return p.RescheduleCount
+}
+func (p *OrderInfo) SetId(val int64) {
+	p.Id = val
+}
+func (p *OrderInfo) SetOrderNo(val string) {
+	p.OrderNo = val
+}
+func (p *OrderInfo) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *OrderInfo) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *OrderInfo) SetSpotId(val int64) {
+	p.SpotId = val
+}
+func (p *OrderInfo) SetTotalAmount(val float64) {
+	p.TotalAmount = val
+}
+func (p *OrderInfo) SetPayAmount(val float64) {
+	p.PayAmount = val
+}
+func (p *OrderInfo) SetCouponId(val int64) {
+	p.CouponId = val
+}
+func (p *OrderInfo) SetOrderStatus(val string) {
+	p.OrderStatus = val
+}
+func (p *OrderInfo) SetPayType(val string) {
+	p.PayType = val
+}
+func (p *OrderInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *OrderInfo) SetItems(val []*OrderItemInfo) {
+	p.Items = val
+}
+func (p *OrderInfo) SetPayTime(val int64) {
+	p.PayTime = val
+}
+func (p *OrderInfo) SetCancelTime(val int64) {
+	p.CancelTime = val
+}
+func (p *OrderInfo) SetVerifyTime(val int64) {
+	p.VerifyTime = val
+}
+func (p *OrderInfo) SetRefundTime(val int64) {
+	p.RefundTime = val
+}
+func (p *OrderInfo) SetVisitDate(val string) {
+	p.VisitDate = val
+}
+func (p *OrderInfo) SetRescheduleCount(val int32) {
+	p.RescheduleCount = val
+}
+
+func (p *OrderInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderInfo(%+v)", *p)
+}
+
+var fieldIDToName_OrderInfo = map[int16]string{
+	1:  "id",
+	2:  "order_no",
+	3:  "user_id",
+	4:  "merchant_id",
+	5:  "spot_id",
+	6:  "total_amount",
+	7:  "pay_amount",
+	8:  "coupon_id",
+	9:  "order_status",
+	10: "pay_type",
+	11: "created_at",
+	12: "items",
+	13: "pay_time",
+	14: "cancel_time",
+	15: "verify_time",
+	16: "refund_time",
+	17: "visit_date",
+	18: "reschedule_count",
+}
+
+type OrderStatusLogInfo struct {
+	FromStatus string `thrift:"from_status,1" frugal:"1,default,string" json:"from_status"`
+	ToStatus   string `thrift:"to_status,2" frugal:"2,default,string" json:"to_status"`
+	Operator   string `thrift:"operator,3" frugal:"3,default,string" json:"operator"`
+	Reason     string `thrift:"reason,4" frugal:"4,default,string" json:"reason"`
+	CreatedAt  int64  `thrift:"created_at,5" frugal:"5,default,i64" json:"created_at"`
+}
+
+func NewOrderStatusLogInfo() *OrderStatusLogInfo {
+	return &OrderStatusLogInfo{}
+}
+
+func (p *OrderStatusLogInfo) InitDefault() {
+}
+
+func (p *OrderStatusLogInfo) GetFromStatus() (v string) {
+	return p.FromStatus
+}
+
+func (p *OrderStatusLogInfo) GetToStatus() (v string) {
+	return p.ToStatus
+}
+
+func (p *OrderStatusLogInfo) GetOperator() (v string) {
+	return p.Operator
+}
+
+func (p *OrderStatusLogInfo) GetReason() (v string) {
+	return p.Reason
+}
+
+func (p *OrderStatusLogInfo) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *OrderStatusLogInfo) SetFromStatus(val string) {
+	p.FromStatus = val
+}
+func (p *OrderStatusLogInfo) SetToStatus(val string) {
+	p.ToStatus = val
+}
+func (p *OrderStatusLogInfo) SetOperator(val string) {
+	p.Operator = val
+}
+func (p *OrderStatusLogInfo) SetReason(val string) {
+	p.Reason = val
+}
+func (p *OrderStatusLogInfo) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *OrderStatusLogInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderStatusLogInfo(%+v)", *p)
+}
+
+var fieldIDToName_OrderStatusLogInfo = map[int16]string{
+	1: "from_status",
+	2: "to_status",
+	3: "operator",
+	4: "reason",
+	5: "created_at",
+}
+
+type CreateOrderResp struct {
+	Base  *BaseResp  `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Order *OrderInfo `thrift:"order,2" frugal:"2,default,OrderInfo" json:"order"`
+}
+
+func NewCreateOrderResp() *CreateOrderResp {
+	return &CreateOrderResp{}
+}
+
+func (p *CreateOrderResp) InitDefault() {
+}
+
+var CreateOrderResp_Base_DEFAULT *BaseResp
+
+func (p *CreateOrderResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return CreateOrderResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var CreateOrderResp_Order_DEFAULT *OrderInfo
+
+func (p *CreateOrderResp) GetOrder() (v *OrderInfo) {
+	if !p.IsSetOrder() {
+		return CreateOrderResp_Order_DEFAULT
+	}
+	return p.Order
+}
+func (p *CreateOrderResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *CreateOrderResp) SetOrder(val *OrderInfo) {
+	p.Order = val
+}
+
+func (p *CreateOrderResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateOrderResp) IsSetOrder() bool {
+	return p.Order != nil
+}
+
+func (p *CreateOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateOrderResp(%+v)", *p)
+}
+
+var fieldIDToName_CreateOrderResp = map[int16]string{
+	1: "base",
+	2: "order",
+}
+
+type GetOrderReq struct {
+	UserId  int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	OrderId int64 `thrift:"order_id,2" frugal:"2,default,i64" json:"order_id"`
+}
+
+func NewGetOrderReq() *GetOrderReq {
+	return &GetOrderReq{}
+}
+
+func (p *GetOrderReq) InitDefault() {
+}
+
+func (p *GetOrderReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *GetOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+func (p *GetOrderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *GetOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+
+func (p *GetOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOrderReq(%+v)", *p)
+}
+
+var fieldIDToName_GetOrderReq = map[int16]string{
+	1: "user_id",
+	2: "order_id",
+}
+
+type GetOrderResp struct {
+	Base       *BaseResp             `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Order      *OrderInfo            `thrift:"order,2" frugal:"2,default,OrderInfo" json:"order"`
+	StatusLogs []*OrderStatusLogInfo `thrift:"status_logs,3" frugal:"3,default,list<OrderStatusLogInfo>" json:"status_logs"`
+}
+
+func NewGetOrderResp() *GetOrderResp {
+	return &GetOrderResp{}
+}
+
+func (p *GetOrderResp) InitDefault() {
+}
+
+var GetOrderResp_Base_DEFAULT *BaseResp
+
+func (p *GetOrderResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return GetOrderResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetOrderResp_Order_DEFAULT *OrderInfo
+
+func (p *GetOrderResp) GetOrder() (v *OrderInfo) {
+	if !p.IsSetOrder() {
+		return GetOrderResp_Order_DEFAULT
+	}
+	return p.Order
+}
+
+func (p *GetOrderResp) GetStatusLogs() (v []*OrderStatusLogInfo) {
+	return p.StatusLogs
+}
+func (p *GetOrderResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *GetOrderResp) SetOrder(val *OrderInfo) {
+	p.Order = val
+}
+func (p *GetOrderResp) SetStatusLogs(val []*OrderStatusLogInfo) {
+	p.StatusLogs = val
+}
+
+func (p *GetOrderResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetOrderResp) IsSetOrder() bool {
+	return p.Order != nil
+}
+
+func (p *GetOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOrderResp(%+v)", *p)
+}
+
+var fieldIDToName_GetOrderResp = map[int16]string{
+	1: "base",
+	2: "order",
+	3: "status_logs",
+}
+
+type PayOrderReq struct {
+	OrderId         int64   `thrift:"order_id,1" frugal:"1,default,i64" json:"order_id"`
+	PayType         string  `thrift:"pay_type,2" frugal:"2,default,string" json:"pay_type"`
+	PayAmount       float64 `thrift:"pay_amount,3" frugal:"3,default,double" json:"pay_amount"`
+	PlatformTradeNo string  `thrift:"platform_trade_no,4" frugal:"4,default,string" json:"platform_trade_no"`
+}
+
+func NewPayOrderReq() *PayOrderReq {
+	return &PayOrderReq{}
+}
+
+func (p *PayOrderReq) InitDefault() {
+}
+
+func (p *PayOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *PayOrderReq) GetPayType() (v string) {
+	return p.PayType
+}
+
+func (p *PayOrderReq) GetPayAmount() (v float64) {
+	return p.PayAmount
+}
+
+func (p *PayOrderReq) GetPlatformTradeNo() (v string) {
+	return p.PlatformTradeNo
+}
+func (p *PayOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *PayOrderReq) SetPayType(val string) {
+	p.PayType = val
+}
+func (p *PayOrderReq) SetPayAmount(val float64) {
+	p.PayAmount = val
+}
+func (p *PayOrderReq) SetPlatformTradeNo(val string) {
+	p.PlatformTradeNo = val
+}
+
+func (p *PayOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PayOrderReq(%+v)", *p)
+}
+
+var fieldIDToName_PayOrderReq = map[int16]string{
+	1: "order_id",
+	2: "pay_type",
+	3: "pay_amount",
+	4: "platform_trade_no",
+}
+
+type PayOrderResp struct {
+	Base *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+}
+
+func NewPayOrderResp() *PayOrderResp {
+	return &PayOrderResp{}
+}
+
+func (p *PayOrderResp) InitDefault() {
+}
+
+var PayOrderResp_Base_DEFAULT *BaseResp
+
+func (p *PayOrderResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return PayOrderResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *PayOrderResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+
+func (p *PayOrderResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *PayOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PayOrderResp(%+v)", *p)
+}
+
+var fieldIDToName_PayOrderResp = map[int16]string{
+	1: "base",
+}
+
+type CancelOrderReq struct {
+	UserId  int64  `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	OrderId int64  `thrift:"order_id,2" frugal:"2,default,i64" json:"order_id"`
+	Reason  string `thrift:"reason,3" frugal:"3,default,string" json:"reason"`
+}
+
+func NewCancelOrderReq() *CancelOrderReq {
+	return &CancelOrderReq{}
+}
+
+func (p *CancelOrderReq) InitDefault() {
+}
+
+func (p *CancelOrderReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *CancelOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *CancelOrderReq) GetReason() (v string) {
+	return p.Reason
+}
+func (p *CancelOrderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *CancelOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *CancelOrderReq) SetReason(val string) {
+	p.Reason = val
+}
+
+func (p *CancelOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelOrderReq(%+v)", *p)
+}
+
+var fieldIDToName_CancelOrderReq = map[int16]string{
+	1: "user_id",
+	2: "order_id",
+	3: "reason",
+}
+
+type CancelOrderResp struct {
+	Base *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+}
+
+func NewCancelOrderResp() *CancelOrderResp {
+	return &CancelOrderResp{}
+}
+
+func (p *CancelOrderResp) InitDefault() {
+}
+
+var CancelOrderResp_Base_DEFAULT *BaseResp
+
+func (p *CancelOrderResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return CancelOrderResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *CancelOrderResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+
+func (p *CancelOrderResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CancelOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CancelOrderResp(%+v)", *p)
+}
+
+var fieldIDToName_CancelOrderResp = map[int16]string{
+	1: "base",
+}
+
+type ApplyRefundReq struct {
+	UserId  int64   `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	OrderId int64   `thrift:"order_id,2" frugal:"2,default,i64" json:"order_id"`
+	ItemIds []int64 `thrift:"item_ids,3" frugal:"3,default,list<i64>" json:"item_ids"`
+	Reason  string  `thrift:"reason,4" frugal:"4,default,string" json:"reason"`
+}
+
+func NewApplyRefundReq() *ApplyRefundReq {
+	return &ApplyRefundReq{}
+}
+
+func (p *ApplyRefundReq) InitDefault() {
+}
+
+func (p *ApplyRefundReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *ApplyRefundReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *ApplyRefundReq) GetItemIds() (v []int64) {
+	return p.ItemIds
+}
+
+func (p *ApplyRefundReq) GetReason() (v string) {
+	return p.Reason
+}
+func (p *ApplyRefundReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ApplyRefundReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *ApplyRefundReq) SetItemIds(val []int64) {
+	p.ItemIds = val
+}
+func (p *ApplyRefundReq) SetReason(val string) {
+	p.Reason = val
+}
+
+func (p *ApplyRefundReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApplyRefundReq(%+v)", *p)
+}
+
+var fieldIDToName_ApplyRefundReq = map[int16]string{
+	1: "user_id",
+	2: "order_id",
+	3: "item_ids",
+	4: "reason",
+}
+
+type RefundItemInfo struct {
+	ItemId       int64   `thrift:"item_id,1" frugal:"1,default,i64" json:"item_id"`
+	PayAmount    float64 `thrift:"pay_amount,2" frugal:"2,default,double" json:"pay_amount"`
+	FeePercent   int32   `thrift:"fee_percent,3" frugal:"3,default,i32" json:"fee_percent"`
+	FeeAmount    float64 `thrift:"fee_amount,4" frugal:"4,default,double" json:"fee_amount"`
+	RefundAmount float64 `thrift:"refund_amount,5" frugal:"5,default,double" json:"refund_amount"`
+}
+
+func NewRefundItemInfo() *RefundItemInfo {
+	return &RefundItemInfo{}
+}
+
+func (p *RefundItemInfo) InitDefault() {
+}
+
+func (p *RefundItemInfo) GetItemId() (v int64) {
+	return p.ItemId
+}
+
+func (p *RefundItemInfo) GetPayAmount() (v float64) {
+	return p.PayAmount
+}
+
+func (p *RefundItemInfo) GetFeePercent() (v int32) {
+	return p.FeePercent
+}
+
+func (p *RefundItemInfo) GetFeeAmount() (v float64) {
+	return p.FeeAmount
+}
+
+func (p *RefundItemInfo) GetRefundAmount() (v float64) {
+	return p.RefundAmount
+}
+func (p *RefundItemInfo) SetItemId(val int64) {
+	p.ItemId = val
+}
+func (p *RefundItemInfo) SetPayAmount(val float64) {
+	p.PayAmount = val
+}
+func (p *RefundItemInfo) SetFeePercent(val int32) {
+	p.FeePercent = val
+}
+func (p *RefundItemInfo) SetFeeAmount(val float64) {
+	p.FeeAmount = val
+}
+func (p *RefundItemInfo) SetRefundAmount(val float64) {
+	p.RefundAmount = val
+}
+
+func (p *RefundItemInfo) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RefundItemInfo(%+v)", *p)
+}
+
+var fieldIDToName_RefundItemInfo = map[int16]string{
+	1: "item_id",
+	2: "pay_amount",
+	3: "fee_percent",
+	4: "fee_amount",
+	5: "refund_amount",
+}
+
+type ApplyRefundResp struct {
+	Base         *BaseResp         `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	RefundAmount float64           `thrift:"refund_amount,2" frugal:"2,default,double" json:"refund_amount"`
+	FeeAmount    float64           `thrift:"fee_amount,3" frugal:"3,default,double" json:"fee_amount"`
+	RefundNo     string            `thrift:"refund_no,4" frugal:"4,default,string" json:"refund_no"`
+	Items        []*RefundItemInfo `thrift:"items,5" frugal:"5,default,list<RefundItemInfo>" json:"items"`
+	OrderStatus  string            `thrift:"order_status,6" frugal:"6,default,string" json:"order_status"`
+}
+
+func NewApplyRefundResp() *ApplyRefundResp {
+	return &ApplyRefundResp{}
+}
+
+func (p *ApplyRefundResp) InitDefault() {
+}
+
+var ApplyRefundResp_Base_DEFAULT *BaseResp
+
+func (p *ApplyRefundResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ApplyRefundResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ApplyRefundResp) GetRefundAmount() (v float64) {
+	return p.RefundAmount
+}
+
+func (p *ApplyRefundResp) GetFeeAmount() (v float64) {
+	return p.FeeAmount
+}
+
+func (p *ApplyRefundResp) GetRefundNo() (v string) {
+	return p.RefundNo
+}
+
+func (p *ApplyRefundResp) GetItems() (v []*RefundItemInfo) {
+	return p.Items
+}
+
+func (p *ApplyRefundResp) GetOrderStatus() (v string) {
+	return p.OrderStatus
+}
+func (p *ApplyRefundResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *ApplyRefundResp) SetRefundAmount(val float64) {
+	p.RefundAmount = val
+}
+func (p *ApplyRefundResp) SetFeeAmount(val float64) {
+	p.FeeAmount = val
+}
+func (p *ApplyRefundResp) SetRefundNo(val string) {
+	p.RefundNo = val
+}
+func (p *ApplyRefundResp) SetItems(val []*RefundItemInfo) {
+	p.Items = val
+}
+func (p *ApplyRefundResp) SetOrderStatus(val string) {
+	p.OrderStatus = val
+}
+
+func (p *ApplyRefundResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ApplyRefundResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ApplyRefundResp(%+v)", *p)
+}
+
+var fieldIDToName_ApplyRefundResp = map[int16]string{
+	1: "base",
+	2: "refund_amount",
+	3: "fee_amount",
+	4: "refund_no",
+	5: "items",
+	6: "order_status",
+}
+
+type RescheduleTicket struct {
+	FromTicketTypeId int64 `thrift:"from_ticket_type_id,1" frugal:"1,default,i64" json:"from_ticket_type_id"`
+	ToTicketTypeId   int64 `thrift:"to_ticket_type_id,2" frugal:"2,default,i64" json:"to_ticket_type_id"`
+}
+
+func NewRescheduleTicket() *RescheduleTicket {
+	return &RescheduleTicket{}
+}
+
+func (p *RescheduleTicket) InitDefault() {
+}
+
+func (p *RescheduleTicket) GetFromTicketTypeId() (v int64) {
+	return p.FromTicketTypeId
+}
+
+func (p *RescheduleTicket) GetToTicketTypeId() (v int64) {
+	return p.ToTicketTypeId
+}
+func (p *RescheduleTicket) SetFromTicketTypeId(val int64) {
+	p.FromTicketTypeId = val
+}
+func (p *RescheduleTicket) SetToTicketTypeId(val int64) {
+	p.ToTicketTypeId = val
+}
+
+func (p *RescheduleTicket) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RescheduleTicket(%+v)", *p)
+}
+
+var fieldIDToName_RescheduleTicket = map[int16]string{
+	1: "from_ticket_type_id",
+	2: "to_ticket_type_id",
+}
+
+type RescheduleOrderReq struct {
+	UserId    int64               `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	OrderId   int64               `thrift:"order_id,2" frugal:"2,default,i64" json:"order_id"`
+	VisitDate string              `thrift:"visit_date,3" frugal:"3,default,string" json:"visit_date"`
+	Tickets   []*RescheduleTicket `thrift:"tickets,4" frugal:"4,default,list<RescheduleTicket>" json:"tickets"`
+	Preview   bool                `thrift:"preview,5" frugal:"5,default,bool" json:"preview"`
+}
+
+func NewRescheduleOrderReq() *RescheduleOrderReq {
+	return &RescheduleOrderReq{}
+}
+
+func (p *RescheduleOrderReq) InitDefault() {
+}
+
+func (p *RescheduleOrderReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *RescheduleOrderReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *RescheduleOrderReq) GetVisitDate() (v string) {
+	return p.VisitDate
+}
+
+func (p *RescheduleOrderReq) GetTickets() (v []*RescheduleTicket) {
+	return p.Tickets
+}
+
+func (p *RescheduleOrderReq) GetPreview() (v bool) {
+	return p.Preview
+}
+func (p *RescheduleOrderReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *RescheduleOrderReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *RescheduleOrderReq) SetVisitDate(val string) {
+	p.VisitDate = val
+}
+func (p *RescheduleOrderReq) SetTickets(val []*RescheduleTicket) {
+	p.Tickets = val
+}
+func (p *RescheduleOrderReq) SetPreview(val bool) {
+	p.Preview = val
+}
+
+func (p *RescheduleOrderReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RescheduleOrderReq(%+v)", *p)
+}
+
+var fieldIDToName_RescheduleOrderReq = map[int16]string{
+	1: "user_id",
+	2: "order_id",
+	3: "visit_date",
+	4: "tickets",
+	5: "preview",
+}
+
+type RescheduleOrderResp struct {
+	Base           *BaseResp  `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	PriceDiff      float64    `thrift:"price_diff,2" frugal:"2,default,double" json:"price_diff"`
+	RefundNo       string     `thrift:"refund_no,3" frugal:"3,default,string" json:"refund_no"`
+	Order          *OrderInfo `thrift:"order,4" frugal:"4,default,OrderInfo" json:"order"`
+	RescheduleLeft int32      `thrift:"reschedule_left,5" frugal:"5,default,i32" json:"reschedule_left"`
+	RescheduleId   int64      `thrift:"reschedule_id,6" frugal:"6,default,i64" json:"reschedule_id"`
+	PendingPay     bool       `thrift:"pending_pay,7" frugal:"7,default,bool" json:"pending_pay"`
+}
+
+func NewRescheduleOrderResp() *RescheduleOrderResp {
+	return &RescheduleOrderResp{}
+}
+
+func (p *RescheduleOrderResp) InitDefault() {
+}
+
+var RescheduleOrderResp_Base_DEFAULT *BaseResp
+
+func (p *RescheduleOrderResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return RescheduleOrderResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *RescheduleOrderResp) GetPriceDiff() (v float64) {
+	return p.PriceDiff
+}
+
+func (p *RescheduleOrderResp) GetRefundNo() (v string) {
+	return p.RefundNo
+}
+
+var RescheduleOrderResp_Order_DEFAULT *OrderInfo
+
+func (p *RescheduleOrderResp) GetOrder() (v *OrderInfo) {
+	if !p.IsSetOrder() {
+		return RescheduleOrderResp_Order_DEFAULT
+	}
+	return p.Order
+}
+
+func (p *RescheduleOrderResp) GetRescheduleLeft() (v int32) {
+	return p.RescheduleLeft
+}
+
+func (p *RescheduleOrderResp) GetRescheduleId() (v int64) {
+	return p.RescheduleId
+}
+
+func (p *RescheduleOrderResp) GetPendingPay() (v bool) {
+	return p.PendingPay
+}
+func (p *RescheduleOrderResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *RescheduleOrderResp) SetPriceDiff(val float64) {
+	p.PriceDiff = val
+}
+func (p *RescheduleOrderResp) SetRefundNo(val string) {
+	p.RefundNo = val
+}
+func (p *RescheduleOrderResp) SetOrder(val *OrderInfo) {
+	p.Order = val
+}
+func (p *RescheduleOrderResp) SetRescheduleLeft(val int32) {
+	p.RescheduleLeft = val
+}
+func (p *RescheduleOrderResp) SetRescheduleId(val int64) {
+	p.RescheduleId = val
+}
+func (p *RescheduleOrderResp) SetPendingPay(val bool) {
+	p.PendingPay = val
+}
+
+func (p *RescheduleOrderResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RescheduleOrderResp) IsSetOrder() bool {
+	return p.Order != nil
+}
+
+func (p *RescheduleOrderResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RescheduleOrderResp(%+v)", *p)
+}
+
+var fieldIDToName_RescheduleOrderResp = map[int16]string{
+	1: "base",
+	2: "price_diff",
+	3: "refund_no",
+	4: "order",
+	5: "reschedule_left",
+	6: "reschedule_id",
+	7: "pending_pay",
+}
+
+type PayRescheduleReq struct {
+	RescheduleId    int64   `thrift:"reschedule_id,1" frugal:"1,default,i64" json:"reschedule_id"`
+	PayType         string  `thrift:"pay_type,2" frugal:"2,default,string" json:"pay_type"`
+	PayAmount       float64 `thrift:"pay_amount,3" frugal:"3,default,double" json:"pay_amount"`
+	PlatformTradeNo string  `thrift:"platform_trade_no,4" frugal:"4,default,string" json:"platform_trade_no"`
+}
+
+func NewPayRescheduleReq() *PayRescheduleReq {
+	return &PayRescheduleReq{}
+}
+
+func (p *PayRescheduleReq) InitDefault() {
+}
+
+func (p *PayRescheduleReq) GetRescheduleId() (v int64) {
+	return p.RescheduleId
+}
+
+func (p *PayRescheduleReq) GetPayType() (v string) {
+	return p.PayType
+}
+
+func (p *PayRescheduleReq) GetPayAmount() (v float64) {
+	return p.PayAmount
+}
+
+func (p *PayRescheduleReq) GetPlatformTradeNo() (v string) {
+	return p.PlatformTradeNo
+}
+func (p *PayRescheduleReq) SetRescheduleId(val int64) {
+	p.RescheduleId = val
+}
+func (p *PayRescheduleReq) SetPayType(val string) {
+	p.PayType = val
+}
+func (p *PayRescheduleReq) SetPayAmount(val float64) {
+	p.PayAmount = val
+}
+func (p *PayRescheduleReq) SetPlatformTradeNo(val string) {
+	p.PlatformTradeNo = val
+}
+
+func (p *PayRescheduleReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PayRescheduleReq(%+v)", *p)
+}
+
+var fieldIDToName_PayRescheduleReq = map[int16]string{
+	1: "reschedule_id",
+	2: "pay_type",
+	3: "pay_amount",
+	4: "platform_trade_no",
+}
+
+type PayRescheduleResp struct {
+	Base  *BaseResp  `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Order *OrderInfo `thrift:"order,2" frugal:"2,default,OrderInfo" json:"order"`
+}
+
+func NewPayRescheduleResp() *PayRescheduleResp {
+	return &PayRescheduleResp{}
+}
+
+func (p *PayRescheduleResp) InitDefault() {
+}
+
+var PayRescheduleResp_Base_DEFAULT *BaseResp
+
+func (p *PayRescheduleResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return PayRescheduleResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var PayRescheduleResp_Order_DEFAULT *OrderInfo
+
+func (p *PayRescheduleResp) GetOrder() (v *OrderInfo) {
+	if !p.IsSetOrder() {
+		return PayRescheduleResp_Order_DEFAULT
+	}
+	return p.Order
+}
+func (p *PayRescheduleResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *PayRescheduleResp) SetOrder(val *OrderInfo) {
+	p.Order = val
+}
+
+func (p *PayRescheduleResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *PayRescheduleResp) IsSetOrder() bool {
+	return p.Order != nil
+}
+
+func (p *PayRescheduleResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PayRescheduleResp(%+v)", *p)
+}
+
+var fieldIDToName_PayRescheduleResp = map[int16]string{
+	1: "base",
+	2: "order",
+}
+
+type OrderService interface {
+	CreateOrder(ctx context.Context, req *CreateOrderReq) (r *CreateOrderResp, err error)
+
+	GetOrder(ctx context.Context, req *GetOrderReq) (r *GetOrderResp, err error)
+
+	PayOrder(ctx context.Context, req *PayOrderReq) (r *PayOrderResp, err error)
+
+	CancelOrder(ctx context.Context, req *CancelOrderReq) (r *CancelOrderResp, err error)
+
+	ApplyRefund(ctx context.Context, req *ApplyRefundReq) (r *ApplyRefundResp, err error)
+
+	RescheduleOrder(ctx context.Context, req *RescheduleOrderReq) (r *RescheduleOrderResp, err error)
+
+	PayReschedule(ctx context.Context, req *PayRescheduleReq) (r *PayRescheduleResp, err error)
+}
+
+type OrderServiceCreateOrderArgs struct {
+	Req *CreateOrderReq `thrift:"req,1" frugal:"1,default,CreateOrderReq" json:"req"`
+}
+
+func NewOrderServiceCreateOrderArgs() *OrderServiceCreateOrderArgs {
+	return &OrderServiceCreateOrderArgs{}
+}
+
+func (p *OrderServiceCreateOrderArgs) InitDefault() {
+}
+
+var OrderServiceCreateOrderArgs_Req_DEFAULT *CreateOrderReq
+
+func (p *OrderServiceCreateOrderArgs) GetReq() (v *CreateOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceCreateOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceCreateOrderArgs) SetReq(val *CreateOrderReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceCreateOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceCreateOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateOrderArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCreateOrderArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceCreateOrderResult struct {
+	Success *CreateOrderResp `thrift:"success,0,optional" frugal:"0,optional,CreateOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceCreateOrderResult() *OrderServiceCreateOrderResult {
+	return &OrderServiceCreateOrderResult{}
+}
+
+func (p *OrderServiceCreateOrderResult) InitDefault() {
+}
+
+var OrderServiceCreateOrderResult_Success_DEFAULT *CreateOrderResp
+
+func (p *OrderServiceCreateOrderResult) GetSuccess() (v *CreateOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCreateOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceCreateOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateOrderResp)
+}
+
+func (p *OrderServiceCreateOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceCreateOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCreateOrderResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCreateOrderResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceGetOrderArgs struct {
+	Req *GetOrderReq `thrift:"req,1" frugal:"1,default,GetOrderReq" json:"req"`
+}
+
+func NewOrderServiceGetOrderArgs() *OrderServiceGetOrderArgs {
+	return &OrderServiceGetOrderArgs{}
+}
+
+func (p *OrderServiceGetOrderArgs) InitDefault() {
+}
+
+var OrderServiceGetOrderArgs_Req_DEFAULT *GetOrderReq
+
+func (p *OrderServiceGetOrderArgs) GetReq() (v *GetOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceGetOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceGetOrderArgs) SetReq(val *GetOrderReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceGetOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceGetOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceGetOrderArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceGetOrderArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceGetOrderResult struct {
+	Success *GetOrderResp `thrift:"success,0,optional" frugal:"0,optional,GetOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceGetOrderResult() *OrderServiceGetOrderResult {
+	return &OrderServiceGetOrderResult{}
+}
+
+func (p *OrderServiceGetOrderResult) InitDefault() {
+}
+
+var OrderServiceGetOrderResult_Success_DEFAULT *GetOrderResp
+
+func (p *OrderServiceGetOrderResult) GetSuccess() (v *GetOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceGetOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceGetOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetOrderResp)
+}
+
+func (p *OrderServiceGetOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceGetOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceGetOrderResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceGetOrderResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServicePayOrderArgs struct {
+	Req *PayOrderReq `thrift:"req,1" frugal:"1,default,PayOrderReq" json:"req"`
+}
+
+func NewOrderServicePayOrderArgs() *OrderServicePayOrderArgs {
+	return &OrderServicePayOrderArgs{}
+}
+
+func (p *OrderServicePayOrderArgs) InitDefault() {
+}
+
+var OrderServicePayOrderArgs_Req_DEFAULT *PayOrderReq
+
+func (p *OrderServicePayOrderArgs) GetReq() (v *PayOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServicePayOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServicePayOrderArgs) SetReq(val *PayOrderReq) {
+	p.Req = val
+}
+
+func (p *OrderServicePayOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServicePayOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServicePayOrderArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServicePayOrderArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServicePayOrderResult struct {
+	Success *PayOrderResp `thrift:"success,0,optional" frugal:"0,optional,PayOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServicePayOrderResult() *OrderServicePayOrderResult {
+	return &OrderServicePayOrderResult{}
+}
+
+func (p *OrderServicePayOrderResult) InitDefault() {
+}
+
+var OrderServicePayOrderResult_Success_DEFAULT *PayOrderResp
+
+func (p *OrderServicePayOrderResult) GetSuccess() (v *PayOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServicePayOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServicePayOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PayOrderResp)
+}
+
+func (p *OrderServicePayOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServicePayOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServicePayOrderResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServicePayOrderResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceCancelOrderArgs struct {
+	Req *CancelOrderReq `thrift:"req,1" frugal:"1,default,CancelOrderReq" json:"req"`
+}
+
+func NewOrderServiceCancelOrderArgs() *OrderServiceCancelOrderArgs {
+	return &OrderServiceCancelOrderArgs{}
+}
+
+func (p *OrderServiceCancelOrderArgs) InitDefault() {
+}
+
+var OrderServiceCancelOrderArgs_Req_DEFAULT *CancelOrderReq
+
+func (p *OrderServiceCancelOrderArgs) GetReq() (v *CancelOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceCancelOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceCancelOrderArgs) SetReq(val *CancelOrderReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceCancelOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceCancelOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCancelOrderArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCancelOrderArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceCancelOrderResult struct {
+	Success *CancelOrderResp `thrift:"success,0,optional" frugal:"0,optional,CancelOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceCancelOrderResult() *OrderServiceCancelOrderResult {
+	return &OrderServiceCancelOrderResult{}
+}
+
+func (p *OrderServiceCancelOrderResult) InitDefault() {
+}
+
+var OrderServiceCancelOrderResult_Success_DEFAULT *CancelOrderResp
+
+func (p *OrderServiceCancelOrderResult) GetSuccess() (v *CancelOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceCancelOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceCancelOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CancelOrderResp)
+}
+
+func (p *OrderServiceCancelOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceCancelOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceCancelOrderResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceCancelOrderResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceApplyRefundArgs struct {
+	Req *ApplyRefundReq `thrift:"req,1" frugal:"1,default,ApplyRefundReq" json:"req"`
+}
+
+func NewOrderServiceApplyRefundArgs() *OrderServiceApplyRefundArgs {
+	return &OrderServiceApplyRefundArgs{}
+}
+
+func (p *OrderServiceApplyRefundArgs) InitDefault() {
+}
+
+var OrderServiceApplyRefundArgs_Req_DEFAULT *ApplyRefundReq
+
+func (p *OrderServiceApplyRefundArgs) GetReq() (v *ApplyRefundReq) {
+	if !p.IsSetReq() {
+		return OrderServiceApplyRefundArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceApplyRefundArgs) SetReq(val *ApplyRefundReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceApplyRefundArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceApplyRefundArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceApplyRefundArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceApplyRefundArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceApplyRefundResult struct {
+	Success *ApplyRefundResp `thrift:"success,0,optional" frugal:"0,optional,ApplyRefundResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceApplyRefundResult() *OrderServiceApplyRefundResult {
+	return &OrderServiceApplyRefundResult{}
+}
+
+func (p *OrderServiceApplyRefundResult) InitDefault() {
+}
+
+var OrderServiceApplyRefundResult_Success_DEFAULT *ApplyRefundResp
+
+func (p *OrderServiceApplyRefundResult) GetSuccess() (v *ApplyRefundResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceApplyRefundResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceApplyRefundResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ApplyRefundResp)
+}
+
+func (p *OrderServiceApplyRefundResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceApplyRefundResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceApplyRefundResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceApplyRefundResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServiceRescheduleOrderArgs struct {
+	Req *RescheduleOrderReq `thrift:"req,1" frugal:"1,default,RescheduleOrderReq" json:"req"`
+}
+
+func NewOrderServiceRescheduleOrderArgs() *OrderServiceRescheduleOrderArgs {
+	return &OrderServiceRescheduleOrderArgs{}
+}
+
+func (p *OrderServiceRescheduleOrderArgs) InitDefault() {
+}
+
+var OrderServiceRescheduleOrderArgs_Req_DEFAULT *RescheduleOrderReq
+
+func (p *OrderServiceRescheduleOrderArgs) GetReq() (v *RescheduleOrderReq) {
+	if !p.IsSetReq() {
+		return OrderServiceRescheduleOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServiceRescheduleOrderArgs) SetReq(val *RescheduleOrderReq) {
+	p.Req = val
+}
+
+func (p *OrderServiceRescheduleOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServiceRescheduleOrderArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceRescheduleOrderArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceRescheduleOrderArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServiceRescheduleOrderResult struct {
+	Success *RescheduleOrderResp `thrift:"success,0,optional" frugal:"0,optional,RescheduleOrderResp" json:"success,omitempty"`
+}
+
+func NewOrderServiceRescheduleOrderResult() *OrderServiceRescheduleOrderResult {
+	return &OrderServiceRescheduleOrderResult{}
+}
+
+func (p *OrderServiceRescheduleOrderResult) InitDefault() {
+}
+
+var OrderServiceRescheduleOrderResult_Success_DEFAULT *RescheduleOrderResp
+
+func (p *OrderServiceRescheduleOrderResult) GetSuccess() (v *RescheduleOrderResp) {
+	if !p.IsSetSuccess() {
+		return OrderServiceRescheduleOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServiceRescheduleOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RescheduleOrderResp)
+}
+
+func (p *OrderServiceRescheduleOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServiceRescheduleOrderResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServiceRescheduleOrderResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServiceRescheduleOrderResult = map[int16]string{
+	0: "success",
+}
+
+type OrderServicePayRescheduleArgs struct {
+	Req *PayRescheduleReq `thrift:"req,1" frugal:"1,default,PayRescheduleReq" json:"req"`
+}
+
+func NewOrderServicePayRescheduleArgs() *OrderServicePayRescheduleArgs {
+	return &OrderServicePayRescheduleArgs{}
+}
+
+func (p *OrderServicePayRescheduleArgs) InitDefault() {
+}
+
+var OrderServicePayRescheduleArgs_Req_DEFAULT *PayRescheduleReq
+
+func (p *OrderServicePayRescheduleArgs) GetReq() (v *PayRescheduleReq) {
+	if !p.IsSetReq() {
+		return OrderServicePayRescheduleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *OrderServicePayRescheduleArgs) SetReq(val *PayRescheduleReq) {
+	p.Req = val
+}
+
+func (p *OrderServicePayRescheduleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *OrderServicePayRescheduleArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServicePayRescheduleArgs(%+v)", *p)
+}
+
+var fieldIDToName_OrderServicePayRescheduleArgs = map[int16]string{
+	1: "req",
+}
+
+type OrderServicePayRescheduleResult struct {
+	Success *PayRescheduleResp `thrift:"success,0,optional" frugal:"0,optional,PayRescheduleResp" json:"success,omitempty"`
+}
+
+func NewOrderServicePayRescheduleResult() *OrderServicePayRescheduleResult {
+	return &OrderServicePayRescheduleResult{}
+}
+
+func (p *OrderServicePayRescheduleResult) InitDefault() {
+}
+
+var OrderServicePayRescheduleResult_Success_DEFAULT *PayRescheduleResp
+
+func (p *OrderServicePayRescheduleResult) GetSuccess() (v *PayRescheduleResp) {
+	if !p.IsSetSuccess() {
+		return OrderServicePayRescheduleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *OrderServicePayRescheduleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PayRescheduleResp)
+}
+
+func (p *OrderServicePayRescheduleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *OrderServicePayRescheduleResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrderServicePayRescheduleResult(%+v)", *p)
+}
+
+var fieldIDToName_OrderServicePayRescheduleResult = map[int16]string{
+	0: "success",
+}
@@ -0,0 +1,85 @@
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
+
+package orderservice
+
+import (
+	"context"
+	order "example_shop/kitex_gen/order"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	CreateOrder(ctx context.Context, req *order.CreateOrderReq, callOptions ...callopt.Option) (r *order.CreateOrderResp, err error)
+	GetOrder(ctx context.Context, req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error)
+	PayOrder(ctx context.Context, req *order.PayOrderReq, callOptions ...callopt.Option) (r *order.PayOrderResp, err error)
+	CancelOrder(ctx context.Context, req *order.CancelOrderReq, callOptions ...callopt.Option) (r *order.CancelOrderResp, err error)
+	ApplyRefund(ctx context.Context, req *order.ApplyRefundReq, callOptions ...callopt.Option) (r *order.ApplyRefundResp, err error)
+	RescheduleOrder(ctx context.Context, req *order.RescheduleOrderReq, callOptions ...callopt.Option) (r *order.RescheduleOrderResp, err error)
+	PayReschedule(ctx context.Context, req *order.PayRescheduleReq, callOptions ...callopt.Option) (r *order.PayRescheduleResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfoForClient(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kOrderServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kOrderServiceClient struct {
+	*kClient
+}
+
+func (p *kOrderServiceClient) CreateOrder(ctx context.Context, req *order.CreateOrderReq, callOptions ...callopt.Option) (r *order.CreateOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) GetOrder(ctx context.Context, req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) PayOrder(ctx context.Context, req *order.PayOrderReq, callOptions ...callopt.Option) (r *order.PayOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PayOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) CancelOrder(ctx context.Context, req *order.CancelOrderReq, callOptions ...callopt.Option) (r *order.CancelOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) ApplyRefund(ctx context.Context, req *order.ApplyRefundReq, callOptions ...callopt.Option) (r *order.ApplyRefundResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ApplyRefund(ctx, req)
+}
+
+func (p *kOrderServiceClient) RescheduleOrder(ctx context.Context, req *order.RescheduleOrderReq, callOptions ...callopt.Option) (r *order.RescheduleOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RescheduleOrder(ctx, req)
+}
+
+func (p *kOrderServiceClient) PayReschedule(ctx context.Context, req *order.PayRescheduleReq, callOptions ...callopt.Option) (r *order.PayRescheduleResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PayReschedule(ctx, req)
+}
@@ -0,0 +1,335 @@
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
+
+package orderservice
+
+import (
+	"context"
+	"errors"
+	order "example_shop/kitex_gen/order"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"CreateOrder": kitex.NewMethodInfo(
+		createOrderHandler,
+		newOrderServiceCreateOrderArgs,
+		newOrderServiceCreateOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetOrder": kitex.NewMethodInfo(
+		getOrderHandler,
+		newOrderServiceGetOrderArgs,
+		newOrderServiceGetOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"PayOrder": kitex.NewMethodInfo(
+		payOrderHandler,
+		newOrderServicePayOrderArgs,
+		newOrderServicePayOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CancelOrder": kitex.NewMethodInfo(
+		cancelOrderHandler,
+		newOrderServiceCancelOrderArgs,
+		newOrderServiceCancelOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ApplyRefund": kitex.NewMethodInfo(
+		applyRefundHandler,
+		newOrderServiceApplyRefundArgs,
+		newOrderServiceApplyRefundResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RescheduleOrder": kitex.NewMethodInfo(
+		rescheduleOrderHandler,
+		newOrderServiceRescheduleOrderArgs,
+		newOrderServiceRescheduleOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"PayReschedule": kitex.NewMethodInfo(
+		payRescheduleHandler,
+		newOrderServicePayRescheduleArgs,
+		newOrderServicePayRescheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+}
+
+var (
+	orderServiceServiceInfo                = NewServiceInfo()
+	orderServiceServiceInfoForClient       = NewServiceInfoForClient()
+	orderServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return orderServiceServiceInfo
+}
+
+// for stream client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return orderServiceServiceInfoForStreamClient
+}
+
+// for client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return orderServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(false, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "OrderService"
+	handlerType := (*order.OrderService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "order",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Thrift,
+		KiteXGenVersion: "v0.15.4",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func createOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceCreateOrderArgs)
+	realResult := result.(*order.OrderServiceCreateOrderResult)
+	success, err := handler.(order.OrderService).CreateOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceCreateOrderArgs() interface{} {
+	return order.NewOrderServiceCreateOrderArgs()
+}
+
+func newOrderServiceCreateOrderResult() interface{} {
+	return order.NewOrderServiceCreateOrderResult()
+}
+
+func getOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceGetOrderArgs)
+	realResult := result.(*order.OrderServiceGetOrderResult)
+	success, err := handler.(order.OrderService).GetOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceGetOrderArgs() interface{} {
+	return order.NewOrderServiceGetOrderArgs()
+}
+
+func newOrderServiceGetOrderResult() interface{} {
+	return order.NewOrderServiceGetOrderResult()
+}
+
+func payOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServicePayOrderArgs)
+	realResult := result.(*order.OrderServicePayOrderResult)
+	success, err := handler.(order.OrderService).PayOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServicePayOrderArgs() interface{} {
+	return order.NewOrderServicePayOrderArgs()
+}
+
+func newOrderServicePayOrderResult() interface{} {
+	return order.NewOrderServicePayOrderResult()
+}
+
+func cancelOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceCancelOrderArgs)
+	realResult := result.(*order.OrderServiceCancelOrderResult)
+	success, err := handler.(order.OrderService).CancelOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceCancelOrderArgs() interface{} {
+	return order.NewOrderServiceCancelOrderArgs()
+}
+
+func newOrderServiceCancelOrderResult() interface{} {
+	return order.NewOrderServiceCancelOrderResult()
+}
+
+func applyRefundHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceApplyRefundArgs)
+	realResult := result.(*order.OrderServiceApplyRefundResult)
+	success, err := handler.(order.OrderService).ApplyRefund(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceApplyRefundArgs() interface{} {
+	return order.NewOrderServiceApplyRefundArgs()
+}
+
+func newOrderServiceApplyRefundResult() interface{} {
+	return order.NewOrderServiceApplyRefundResult()
+}
+
+func rescheduleOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServiceRescheduleOrderArgs)
+	realResult := result.(*order.OrderServiceRescheduleOrderResult)
+	success, err := handler.(order.OrderService).RescheduleOrder(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServiceRescheduleOrderArgs() interface{} {
+	return order.NewOrderServiceRescheduleOrderArgs()
+}
+
+func newOrderServiceRescheduleOrderResult() interface{} {
+	return order.NewOrderServiceRescheduleOrderResult()
+}
+
+func payRescheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*order.OrderServicePayRescheduleArgs)
+	realResult := result.(*order.OrderServicePayRescheduleResult)
+	success, err := handler.(order.OrderService).PayReschedule(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newOrderServicePayRescheduleArgs() interface{} {
+	return order.NewOrderServicePayRescheduleArgs()
+}
+
+func newOrderServicePayRescheduleResult() interface{} {
+	return order.NewOrderServicePayRescheduleResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) CreateOrder(ctx context.Context, req *order.CreateOrderReq) (r *order.CreateOrderResp, err error) {
+	var _args order.OrderServiceCreateOrderArgs
+	_args.Req = req
+	var _result order.OrderServiceCreateOrderResult
+	if err = p.c.Call(ctx, "CreateOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetOrder(ctx context.Context, req *order.GetOrderReq) (r *order.GetOrderResp, err error) {
+	var _args order.OrderServiceGetOrderArgs
+	_args.Req = req
+	var _result order.OrderServiceGetOrderResult
+	if err = p.c.Call(ctx, "GetOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PayOrder(ctx context.Context, req *order.PayOrderReq) (r *order.PayOrderResp, err error) {
+	var _args order.OrderServicePayOrderArgs
+	_args.Req = req
+	var _result order.OrderServicePayOrderResult
+	if err = p.c.Call(ctx, "PayOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelOrder(ctx context.Context, req *order.CancelOrderReq) (r *order.CancelOrderResp, err error) {
+	var _args order.OrderServiceCancelOrderArgs
+	_args.Req = req
+	var _result order.OrderServiceCancelOrderResult
+	if err = p.c.Call(ctx, "CancelOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ApplyRefund(ctx context.Context, req *order.ApplyRefundReq) (r *order.ApplyRefundResp, err error) {
+	var _args order.OrderServiceApplyRefundArgs
+	_args.Req = req
+	var _result order.OrderServiceApplyRefundResult
+	if err = p.c.Call(ctx, "ApplyRefund", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RescheduleOrder(ctx context.Context, req *order.RescheduleOrderReq) (r *order.RescheduleOrderResp, err error) {
+	var _args order.OrderServiceRescheduleOrderArgs
+	_args.Req = req
+	var _result order.OrderServiceRescheduleOrderResult
+	if err = p.c.Call(ctx, "RescheduleOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PayReschedule(ctx context.Context, req *order.PayRescheduleReq) (r *order.PayRescheduleResp, err error) {
+	var _args order.OrderServicePayRescheduleArgs
+	_args.Req = req
+	var _result order.OrderServicePayRescheduleResult
+	if err = p.c.Call(ctx, "PayReschedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,1754 @@
+// Code generated by thriftgo (0.4.3). DO NOT EDIT.
+
+package verify
+
+import (
+	"context"
+	"fmt"
+)
+
+type BaseResp struct {
+	Code int32  `thrift:"code,1" frugal:"1,default,i32" json:"code"`
+	Msg  string `thrift:"msg,2" frugal:"2,default,string" json:"msg"`
+}
+
+func NewBaseResp() *BaseResp {
+	return &BaseResp{}
+}
+
+func (p *BaseResp) InitDefault() {
+}
+
+func (p *BaseResp) GetCode() (v int32) {
+	return p.Code
+}
+
+func (p *BaseResp) GetMsg() (v string) {
+	return p.Msg
+}
+func (p *BaseResp) SetCode(val int32) {
+	p.Code = val
+}
+func (p *BaseResp) SetMsg(val string) {
+	p.Msg = val
+}
+
+func (p *BaseResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BaseResp(%+v)", *p)
+}
+
+var fieldIDToName_BaseResp = map[int16]string{
+	1: "code",
+	2: "msg",
+}
+
+type GetVerifyQRCodeReq struct {
+	UserId  int64 `thrift:"user_id,1" frugal:"1,default,i64" json:"user_id"`
+	OrderId int64 `thrift:"order_id,2" frugal:"2,default,i64" json:"order_id"`
+	ItemId  int64 `thrift:"item_id,3" frugal:"3,default,i64" json:"item_id"`
+}
+
+func NewGetVerifyQRCodeReq() *GetVerifyQRCodeReq {
+	return &GetVerifyQRCodeReq{}
+}
+
+func (p *GetVerifyQRCodeReq) InitDefault() {
+}
+
+func (p *GetVerifyQRCodeReq) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *GetVerifyQRCodeReq) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *GetVerifyQRCodeReq) GetItemId() (v int64) {
+	return p.ItemId
+}
+func (p *GetVerifyQRCodeReq) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *GetVerifyQRCodeReq) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *GetVerifyQRCodeReq) SetItemId(val int64) {
+	p.ItemId = val
+}
+
+func (p *GetVerifyQRCodeReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetVerifyQRCodeReq(%+v)", *p)
+}
+
+var fieldIDToName_GetVerifyQRCodeReq = map[int16]string{
+	1: "user_id",
+	2: "order_id",
+	3: "item_id",
+}
+
+type GetVerifyQRCodeResp struct {
+	Base      *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	QrContent string    `thrift:"qr_content,2" frugal:"2,default,string" json:"qr_content"`
+	ExpireAt  int64     `thrift:"expire_at,3" frugal:"3,default,i64" json:"expire_at"`
+}
+
+func NewGetVerifyQRCodeResp() *GetVerifyQRCodeResp {
+	return &GetVerifyQRCodeResp{}
+}
+
+func (p *GetVerifyQRCodeResp) InitDefault() {
+}
+
+var GetVerifyQRCodeResp_Base_DEFAULT *BaseResp
+
+func (p *GetVerifyQRCodeResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return GetVerifyQRCodeResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetVerifyQRCodeResp) GetQrContent() (v string) {
+	return p.QrContent
+}
+
+func (p *GetVerifyQRCodeResp) GetExpireAt() (v int64) {
+	return p.ExpireAt
+}
+func (p *GetVerifyQRCodeResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *GetVerifyQRCodeResp) SetQrContent(val string) {
+	p.QrContent = val
+}
+func (p *GetVerifyQRCodeResp) SetExpireAt(val int64) {
+	p.ExpireAt = val
+}
+
+func (p *GetVerifyQRCodeResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetVerifyQRCodeResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetVerifyQRCodeResp(%+v)", *p)
+}
+
+var fieldIDToName_GetVerifyQRCodeResp = map[int16]string{
+	1: "base",
+	2: "qr_content",
+	3: "expire_at",
+}
+
+type VerifyTicketReq struct {
+	DeviceNo  string `thrift:"device_no,1" frugal:"1,default,string" json:"device_no"`
+	QrContent string `thrift:"qr_content,2" frugal:"2,default,string" json:"qr_content"`
+	Timestamp int64  `thrift:"timestamp,3" frugal:"3,default,i64" json:"timestamp"`
+	Signature string `thrift:"signature,4" frugal:"4,default,string" json:"signature"`
+}
+
+func NewVerifyTicketReq() *VerifyTicketReq {
+	return &VerifyTicketReq{}
+}
+
+func (p *VerifyTicketReq) InitDefault() {
+}
+
+func (p *VerifyTicketReq) GetDeviceNo() (v string) {
+	return p.DeviceNo
+}
+
+func (p *VerifyTicketReq) GetQrContent() (v string) {
+	return p.QrContent
+}
+
+func (p *VerifyTicketReq) GetTimestamp() (v int64) {
+	return p.Timestamp
+}
+
+func (p *VerifyTicketReq) GetSignature() (v string) {
+	return p.Signature
+}
+func (p *VerifyTicketReq) SetDeviceNo(val string) {
+	p.DeviceNo = val
+}
+func (p *VerifyTicketReq) SetQrContent(val string) {
+	p.QrContent = val
+}
+func (p *VerifyTicketReq) SetTimestamp(val int64) {
+	p.Timestamp = val
+}
+func (p *VerifyTicketReq) SetSignature(val string) {
+	p.Signature = val
+}
+
+func (p *VerifyTicketReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyTicketReq(%+v)", *p)
+}
+
+var fieldIDToName_VerifyTicketReq = map[int16]string{
+	1: "device_no",
+	2: "qr_content",
+	3: "timestamp",
+	4: "signature",
+}
+
+type VerifiedItem struct {
+	ItemId       int64  `thrift:"item_id,1" frugal:"1,default,i64" json:"item_id"`
+	TicketName   string `thrift:"ticket_name,2" frugal:"2,default,string" json:"ticket_name"`
+	TravelerId   int64  `thrift:"traveler_id,3" frugal:"3,default,i64" json:"traveler_id"`
+	TravelerName string `thrift:"traveler_name,4" frugal:"4,default,string" json:"traveler_name"`
+}
+
+func NewVerifiedItem() *VerifiedItem {
+	return &VerifiedItem{}
+}
+
+func (p *VerifiedItem) InitDefault() {
+}
+
+func (p *VerifiedItem) GetItemId() (v int64) {
+	return p.ItemId
+}
+
+func (p *VerifiedItem) GetTicketName() (v string) {
+	return p.TicketName
+}
+
+func (p *VerifiedItem) GetTravelerId() (v int64) {
+	return p.TravelerId
+}
+
+func (p *VerifiedItem) GetTravelerName() (v string) {
+	return p.TravelerName
+}
+func (p *VerifiedItem) SetItemId(val int64) {
+	p.ItemId = val
+}
+func (p *VerifiedItem) SetTicketName(val string) {
+	p.TicketName = val
+}
+func (p *VerifiedItem) SetTravelerId(val int64) {
+	p.TravelerId = val
+}
+func (p *VerifiedItem) SetTravelerName(val string) {
+	p.TravelerName = val
+}
+
+func (p *VerifiedItem) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifiedItem(%+v)", *p)
+}
+
+var fieldIDToName_VerifiedItem = map[int16]string{
+	1: "item_id",
+	2: "ticket_name",
+	3: "traveler_id",
+	4: "traveler_name",
+}
+
+type VerifyTicketResp struct {
+	Base       *BaseResp       `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	OrderId    int64           `thrift:"order_id,2" frugal:"2,default,i64" json:"order_id"`
+	OrderNo    string          `thrift:"order_no,3" frugal:"3,default,string" json:"order_no"`
+	VerifyTime int64           `thrift:"verify_time,4" frugal:"4,default,i64" json:"verify_time"`
+	Items      []*VerifiedItem `thrift:"items,5" frugal:"5,default,list<VerifiedItem>" json:"items"`
+	Remaining  int32           `thrift:"remaining,6" frugal:"6,default,i32" json:"remaining"`
+}
+
+func NewVerifyTicketResp() *VerifyTicketResp {
+	return &VerifyTicketResp{}
+}
+
+func (p *VerifyTicketResp) InitDefault() {
+}
+
+var VerifyTicketResp_Base_DEFAULT *BaseResp
+
+func (p *VerifyTicketResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return VerifyTicketResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *VerifyTicketResp) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *VerifyTicketResp) GetOrderNo() (v string) {
+	return p.OrderNo
+}
+
+func (p *VerifyTicketResp) GetVerifyTime() (v int64) {
+	return p.VerifyTime
+}
+
+func (p *VerifyTicketResp) GetItems() (v []*VerifiedItem) {
+	return p.Items
+}
+
+func (p *VerifyTicketResp) GetRemaining() (v int32) {
+	return p.Remaining
+}
+func (p *VerifyTicketResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *VerifyTicketResp) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *VerifyTicketResp) SetOrderNo(val string) {
+	p.OrderNo = val
+}
+func (p *VerifyTicketResp) SetVerifyTime(val int64) {
+	p.VerifyTime = val
+}
+func (p *VerifyTicketResp) SetItems(val []*VerifiedItem) {
+	p.Items = val
+}
+func (p *VerifyTicketResp) SetRemaining(val int32) {
+	p.Remaining = val
+}
+
+func (p *VerifyTicketResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *VerifyTicketResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyTicketResp(%+v)", *p)
+}
+
+var fieldIDToName_VerifyTicketResp = map[int16]string{
+	1: "base",
+	2: "order_id",
+	3: "order_no",
+	4: "verify_time",
+	5: "items",
+	6: "remaining",
+}
+
+type RegisterGateDeviceReq struct {
+	MerchantId int64  `thrift:"merchant_id,1" frugal:"1,default,i64" json:"merchant_id"`
+	SpotId     int64  `thrift:"spot_id,2" frugal:"2,default,i64" json:"spot_id"`
+	DeviceNo   string `thrift:"device_no,3" frugal:"3,default,string" json:"device_no"`
+	DeviceName string `thrift:"device_name,4" frugal:"4,default,string" json:"device_name"`
+}
+
+func NewRegisterGateDeviceReq() *RegisterGateDeviceReq {
+	return &RegisterGateDeviceReq{}
+}
+
+func (p *RegisterGateDeviceReq) InitDefault() {
+}
+
+func (p *RegisterGateDeviceReq) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *RegisterGateDeviceReq) GetSpotId() (v int64) {
+	return p.SpotId
+}
+
+func (p *RegisterGateDeviceReq) GetDeviceNo() (v string) {
+	return p.DeviceNo
+}
+
+func (p *RegisterGateDeviceReq) GetDeviceName() (v string) {
+	return p.DeviceName
+}
+func (p *RegisterGateDeviceReq) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *RegisterGateDeviceReq) SetSpotId(val int64) {
+	p.SpotId = val
+}
+func (p *RegisterGateDeviceReq) SetDeviceNo(val string) {
+	p.DeviceNo = val
+}
+func (p *RegisterGateDeviceReq) SetDeviceName(val string) {
+	p.DeviceName = val
+}
+
+func (p *RegisterGateDeviceReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RegisterGateDeviceReq(%+v)", *p)
+}
+
+var fieldIDToName_RegisterGateDeviceReq = map[int16]string{
+	1: "merchant_id",
+	2: "spot_id",
+	3: "device_no",
+	4: "device_name",
+}
+
+type RegisterGateDeviceResp struct {
+	Base               *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	DeviceId           int64     `thrift:"device_id,2" frugal:"2,default,i64" json:"device_id"`
+	WhitelistPublicKey string    `thrift:"whitelist_public_key,3" frugal:"3,default,string" json:"whitelist_public_key"`
+	DeviceSecret       string    `thrift:"device_secret,4" frugal:"4,default,string" json:"device_secret"`
+}
+
+func NewRegisterGateDeviceResp() *RegisterGateDeviceResp {
+	return &RegisterGateDeviceResp{}
+}
+
+func (p *RegisterGateDeviceResp) InitDefault() {
+}
+
+var RegisterGateDeviceResp_Base_DEFAULT *BaseResp
+
+func (p *RegisterGateDeviceResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return RegisterGateDeviceResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *RegisterGateDeviceResp) GetDeviceId() (v int64) {
+	return p.DeviceId
+}
+
+func (p *RegisterGateDeviceResp) GetWhitelistPublicKey() (v string) {
+	return p.WhitelistPublicKey
+}
+
+func (p *RegisterGateDeviceResp) GetDeviceSecret() (v string) {
+	return p.DeviceSecret
+}
+func (p *RegisterGateDeviceResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *RegisterGateDeviceResp) SetDeviceId(val int64) {
+	p.DeviceId = val
+}
+func (p *RegisterGateDeviceResp) SetWhitelistPublicKey(val string) {
+	p.WhitelistPublicKey = val
+}
+func (p *RegisterGateDeviceResp) SetDeviceSecret(val string) {
+	p.DeviceSecret = val
+}
+
+func (p *RegisterGateDeviceResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *RegisterGateDeviceResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RegisterGateDeviceResp(%+v)", *p)
+}
+
+var fieldIDToName_RegisterGateDeviceResp = map[int16]string{
+	1: "base",
+	2: "device_id",
+	3: "whitelist_public_key",
+	4: "device_secret",
+}
+
+type SetGateDeviceStatusReq struct {
+	MerchantId int64  `thrift:"merchant_id,1" frugal:"1,default,i64" json:"merchant_id"`
+	DeviceNo   string `thrift:"device_no,2" frugal:"2,default,string" json:"device_no"`
+	Enabled    bool   `thrift:"enabled,3" frugal:"3,default,bool" json:"enabled"`
+}
+
+func NewSetGateDeviceStatusReq() *SetGateDeviceStatusReq {
+	return &SetGateDeviceStatusReq{}
+}
+
+func (p *SetGateDeviceStatusReq) InitDefault() {
+}
+
+func (p *SetGateDeviceStatusReq) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *SetGateDeviceStatusReq) GetDeviceNo() (v string) {
+	return p.DeviceNo
+}
+
+func (p *SetGateDeviceStatusReq) GetEnabled() (v bool) {
+	return p.Enabled
+}
+func (p *SetGateDeviceStatusReq) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *SetGateDeviceStatusReq) SetDeviceNo(val string) {
+	p.DeviceNo = val
+}
+func (p *SetGateDeviceStatusReq) SetEnabled(val bool) {
+	p.Enabled = val
+}
+
+func (p *SetGateDeviceStatusReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetGateDeviceStatusReq(%+v)", *p)
+}
+
+var fieldIDToName_SetGateDeviceStatusReq = map[int16]string{
+	1: "merchant_id",
+	2: "device_no",
+	3: "enabled",
+}
+
+type SetGateDeviceStatusResp struct {
+	Base *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+}
+
+func NewSetGateDeviceStatusResp() *SetGateDeviceStatusResp {
+	return &SetGateDeviceStatusResp{}
+}
+
+func (p *SetGateDeviceStatusResp) InitDefault() {
+}
+
+var SetGateDeviceStatusResp_Base_DEFAULT *BaseResp
+
+func (p *SetGateDeviceStatusResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return SetGateDeviceStatusResp_Base_DEFAULT
+	}
+	return p.Base
+}
+func (p *SetGateDeviceStatusResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+
+func (p *SetGateDeviceStatusResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *SetGateDeviceStatusResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SetGateDeviceStatusResp(%+v)", *p)
+}
+
+var fieldIDToName_SetGateDeviceStatusResp = map[int16]string{
+	1: "base",
+}
+
+type ResetGateDeviceSecretReq struct {
+	MerchantId int64  `thrift:"merchant_id,1" frugal:"1,default,i64" json:"merchant_id"`
+	DeviceNo   string `thrift:"device_no,2" frugal:"2,default,string" json:"device_no"`
+}
+
+func NewResetGateDeviceSecretReq() *ResetGateDeviceSecretReq {
+	return &ResetGateDeviceSecretReq{}
+}
+
+func (p *ResetGateDeviceSecretReq) InitDefault() {
+}
+
+func (p *ResetGateDeviceSecretReq) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *ResetGateDeviceSecretReq) GetDeviceNo() (v string) {
+	return p.DeviceNo
+}
+func (p *ResetGateDeviceSecretReq) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *ResetGateDeviceSecretReq) SetDeviceNo(val string) {
+	p.DeviceNo = val
+}
+
+func (p *ResetGateDeviceSecretReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResetGateDeviceSecretReq(%+v)", *p)
+}
+
+var fieldIDToName_ResetGateDeviceSecretReq = map[int16]string{
+	1: "merchant_id",
+	2: "device_no",
+}
+
+type ResetGateDeviceSecretResp struct {
+	Base         *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	DeviceSecret string    `thrift:"device_secret,2" frugal:"2,default,string" json:"device_secret"`
+}
+
+func NewResetGateDeviceSecretResp() *ResetGateDeviceSecretResp {
+	return &ResetGateDeviceSecretResp{}
+}
+
+func (p *ResetGateDeviceSecretResp) InitDefault() {
+}
+
+var ResetGateDeviceSecretResp_Base_DEFAULT *BaseResp
+
+func (p *ResetGateDeviceSecretResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return ResetGateDeviceSecretResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ResetGateDeviceSecretResp) GetDeviceSecret() (v string) {
+	return p.DeviceSecret
+}
+func (p *ResetGateDeviceSecretResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *ResetGateDeviceSecretResp) SetDeviceSecret(val string) {
+	p.DeviceSecret = val
+}
+
+func (p *ResetGateDeviceSecretResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ResetGateDeviceSecretResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResetGateDeviceSecretResp(%+v)", *p)
+}
+
+var fieldIDToName_ResetGateDeviceSecretResp = map[int16]string{
+	1: "base",
+	2: "device_secret",
+}
+
+type GetOfflineWhitelistReq struct {
+	DeviceNo  string `thrift:"device_no,1" frugal:"1,default,string" json:"device_no"`
+	Date      string `thrift:"date,2" frugal:"2,default,string" json:"date"`
+	Timestamp int64  `thrift:"timestamp,3" frugal:"3,default,i64" json:"timestamp"`
+	Signature string `thrift:"signature,4" frugal:"4,default,string" json:"signature"`
+}
+
+func NewGetOfflineWhitelistReq() *GetOfflineWhitelistReq {
+	return &GetOfflineWhitelistReq{}
+}
+
+func (p *GetOfflineWhitelistReq) InitDefault() {
+}
+
+func (p *GetOfflineWhitelistReq) GetDeviceNo() (v string) {
+	return p.DeviceNo
+}
+
+func (p *GetOfflineWhitelistReq) GetDate() (v string) {
+	return p.Date
+}
+
+func (p *GetOfflineWhitelistReq) GetTimestamp() (v int64) {
+	return p.Timestamp
+}
+
+func (p *GetOfflineWhitelistReq) GetSignature() (v string) {
+	return p.Signature
+}
+func (p *GetOfflineWhitelistReq) SetDeviceNo(val string) {
+	p.DeviceNo = val
+}
+func (p *GetOfflineWhitelistReq) SetDate(val string) {
+	p.Date = val
+}
+func (p *GetOfflineWhitelistReq) SetTimestamp(val int64) {
+	p.Timestamp = val
+}
+func (p *GetOfflineWhitelistReq) SetSignature(val string) {
+	p.Signature = val
+}
+
+func (p *GetOfflineWhitelistReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOfflineWhitelistReq(%+v)", *p)
+}
+
+var fieldIDToName_GetOfflineWhitelistReq = map[int16]string{
+	1: "device_no",
+	2: "date",
+	3: "timestamp",
+	4: "signature",
+}
+
+type GetOfflineWhitelistResp struct {
+	Base        *BaseResp `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Bundle      string    `thrift:"bundle,2" frugal:"2,default,string" json:"bundle"`
+	EntryCount  int32     `thrift:"entry_count,3" frugal:"3,default,i32" json:"entry_count"`
+	GeneratedAt int64     `thrift:"generated_at,4" frugal:"4,default,i64" json:"generated_at"`
+}
+
+func NewGetOfflineWhitelistResp() *GetOfflineWhitelistResp {
+	return &GetOfflineWhitelistResp{}
+}
+
+func (p *GetOfflineWhitelistResp) InitDefault() {
+}
+
+var GetOfflineWhitelistResp_Base_DEFAULT *BaseResp
+
+func (p *GetOfflineWhitelistResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return GetOfflineWhitelistResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetOfflineWhitelistResp) GetBundle() (v string) {
+	return p.Bundle
+}
+
+func (p *GetOfflineWhitelistResp) GetEntryCount() (v int32) {
+	return p.EntryCount
+}
+
+func (p *GetOfflineWhitelistResp) GetGeneratedAt() (v int64) {
+	return p.GeneratedAt
+}
+func (p *GetOfflineWhitelistResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *GetOfflineWhitelistResp) SetBundle(val string) {
+	p.Bundle = val
+}
+func (p *GetOfflineWhitelistResp) SetEntryCount(val int32) {
+	p.EntryCount = val
+}
+func (p *GetOfflineWhitelistResp) SetGeneratedAt(val int64) {
+	p.GeneratedAt = val
+}
+
+func (p *GetOfflineWhitelistResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetOfflineWhitelistResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOfflineWhitelistResp(%+v)", *p)
+}
+
+var fieldIDToName_GetOfflineWhitelistResp = map[int16]string{
+	1: "base",
+	2: "bundle",
+	3: "entry_count",
+	4: "generated_at",
+}
+
+type OfflineVerifyRecord struct {
+	VerifyCode string `thrift:"verify_code,1" frugal:"1,default,string" json:"verify_code"`
+	VerifiedAt int64  `thrift:"verified_at,2" frugal:"2,default,i64" json:"verified_at"`
+}
+
+func NewOfflineVerifyRecord() *OfflineVerifyRecord {
+	return &OfflineVerifyRecord{}
+}
+
+func (p *OfflineVerifyRecord) InitDefault() {
+}
+
+func (p *OfflineVerifyRecord) GetVerifyCode() (v string) {
+	return p.VerifyCode
+}
+
+func (p *OfflineVerifyRecord) GetVerifiedAt() (v int64) {
+	return p.VerifiedAt
+}
+func (p *OfflineVerifyRecord) SetVerifyCode(val string) {
+	p.VerifyCode = val
+}
+func (p *OfflineVerifyRecord) SetVerifiedAt(val int64) {
+	p.VerifiedAt = val
+}
+
+func (p *OfflineVerifyRecord) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OfflineVerifyRecord(%+v)", *p)
+}
+
+var fieldIDToName_OfflineVerifyRecord = map[int16]string{
+	1: "verify_code",
+	2: "verified_at",
+}
+
+type UploadOfflineVerificationsReq struct {
+	DeviceNo  string                 `thrift:"device_no,1" frugal:"1,default,string" json:"device_no"`
+	Records   []*OfflineVerifyRecord `thrift:"records,2" frugal:"2,default,list<OfflineVerifyRecord>" json:"records"`
+	Timestamp int64                  `thrift:"timestamp,3" frugal:"3,default,i64" json:"timestamp"`
+	Signature string                 `thrift:"signature,4" frugal:"4,default,string" json:"signature"`
+}
+
+func NewUploadOfflineVerificationsReq() *UploadOfflineVerificationsReq {
+	return &UploadOfflineVerificationsReq{}
+}
+
+func (p *UploadOfflineVerificationsReq) InitDefault() {
+}
+
+func (p *UploadOfflineVerificationsReq) GetDeviceNo() (v string) {
+	return p.DeviceNo
+}
+
+func (p *UploadOfflineVerificationsReq) GetRecords() (v []*OfflineVerifyRecord) {
+	return p.Records
+}
+
+func (p *UploadOfflineVerificationsReq) GetTimestamp() (v int64) {
+	return p.Timestamp
+}
+
+func (p *UploadOfflineVerificationsReq) GetSignature() (v string) {
+	return p.Signature
+}
+func (p *UploadOfflineVerificationsReq) SetDeviceNo(val string) {
+	p.DeviceNo = val
+}
+func (p *UploadOfflineVerificationsReq) SetRecords(val []*OfflineVerifyRecord) {
+	p.Records = val
+}
+func (p *UploadOfflineVerificationsReq) SetTimestamp(val int64) {
+	p.Timestamp = val
+}
+func (p *UploadOfflineVerificationsReq) SetSignature(val string) {
+	p.Signature = val
+}
+
+func (p *UploadOfflineVerificationsReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadOfflineVerificationsReq(%+v)", *p)
+}
+
+var fieldIDToName_UploadOfflineVerificationsReq = map[int16]string{
+	1: "device_no",
+	2: "records",
+	3: "timestamp",
+	4: "signature",
+}
+
+type OfflineVerifyException struct {
+	VerifyCode string `thrift:"verify_code,1" frugal:"1,default,string" json:"verify_code"`
+	VerifiedAt int64  `thrift:"verified_at,2" frugal:"2,default,i64" json:"verified_at"`
+	DeviceNo   string `thrift:"device_no,3" frugal:"3,default,string" json:"device_no"`
+	OrderId    int64  `thrift:"order_id,4" frugal:"4,default,i64" json:"order_id"`
+	Outcome    string `thrift:"outcome,5" frugal:"5,default,string" json:"outcome"`
+	Reason     string `thrift:"reason,6" frugal:"6,default,string" json:"reason"`
+}
+
+func NewOfflineVerifyException() *OfflineVerifyException {
+	return &OfflineVerifyException{}
+}
+
+func (p *OfflineVerifyException) InitDefault() {
+}
+
+func (p *OfflineVerifyException) GetVerifyCode() (v string) {
+	return p.VerifyCode
+}
+
+func (p *OfflineVerifyException) GetVerifiedAt() (v int64) {
+	return p.VerifiedAt
+}
+
+func (p *OfflineVerifyException) GetDeviceNo() (v string) {
+	return p.DeviceNo
+}
+
+func (p *OfflineVerifyException) GetOrderId() (v int64) {
+	return p.OrderId
+}
+
+func (p *OfflineVerifyException) GetOutcome() (v string) {
+	return p.Outcome
+}
+
+func (p *OfflineVerifyException) GetReason() (v string) {
+	return p.Reason
+}
+func (p *OfflineVerifyException) SetVerifyCode(val string) {
+	p.VerifyCode = val
+}
+func (p *OfflineVerifyException) SetVerifiedAt(val int64) {
+	p.VerifiedAt = val
+}
+func (p *OfflineVerifyException) SetDeviceNo(val string) {
+	p.DeviceNo = val
+}
+func (p *OfflineVerifyException) SetOrderId(val int64) {
+	p.OrderId = val
+}
+func (p *OfflineVerifyException) SetOutcome(val string) {
+	p.Outcome = val
+}
+func (p *OfflineVerifyException) SetReason(val string) {
+	p.Reason = val
+}
+
+func (p *OfflineVerifyException) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OfflineVerifyException(%+v)", *p)
+}
+
+var fieldIDToName_OfflineVerifyException = map[int16]string{
+	1: "verify_code",
+	2: "verified_at",
+	3: "device_no",
+	4: "order_id",
+	5: "outcome",
+	6: "reason",
+}
+
+type UploadOfflineVerificationsResp struct {
+	Base       *BaseResp                 `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Applied    int32                     `thrift:"applied,2" frugal:"2,default,i32" json:"applied"`
+	Duplicated int32                     `thrift:"duplicated,3" frugal:"3,default,i32" json:"duplicated"`
+	Exceptions []*OfflineVerifyException `thrift:"exceptions,4" frugal:"4,default,list<OfflineVerifyException>" json:"exceptions"`
+}
+
+func NewUploadOfflineVerificationsResp() *UploadOfflineVerificationsResp {
+	return &UploadOfflineVerificationsResp{}
+}
+
+func (p *UploadOfflineVerificationsResp) InitDefault() {
+}
+
+var UploadOfflineVerificationsResp_Base_DEFAULT *BaseResp
+
+func (p *UploadOfflineVerificationsResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return UploadOfflineVerificationsResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *UploadOfflineVerificationsResp) GetApplied() (v int32) {
+	return p.Applied
+}
+
+func (p *UploadOfflineVerificationsResp) GetDuplicated() (v int32) {
+	return p.Duplicated
+}
+
+func (p *UploadOfflineVerificationsResp) GetExceptions() (v []*OfflineVerifyException) {
+	return p.Exceptions
+}
+func (p *UploadOfflineVerificationsResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *UploadOfflineVerificationsResp) SetApplied(val int32) {
+	p.Applied = val
+}
+func (p *UploadOfflineVerificationsResp) SetDuplicated(val int32) {
+	p.Duplicated = val
+}
+func (p *UploadOfflineVerificationsResp) SetExceptions(val []*OfflineVerifyException) {
+	p.Exceptions = val
+}
+
+func (p *UploadOfflineVerificationsResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UploadOfflineVerificationsResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadOfflineVerificationsResp(%+v)", *p)
+}
+
+var fieldIDToName_UploadOfflineVerificationsResp = map[int16]string{
+	1: "base",
+	2: "applied",
+	3: "duplicated",
+	4: "exceptions",
+}
+
+type GetOfflineVerifyReportReq struct {
+	MerchantId int64  `thrift:"merchant_id,1" frugal:"1,default,i64" json:"merchant_id"`
+	SpotId     int64  `thrift:"spot_id,2" frugal:"2,default,i64" json:"spot_id"`
+	StartDate  string `thrift:"start_date,3" frugal:"3,default,string" json:"start_date"`
+	EndDate    string `thrift:"end_date,4" frugal:"4,default,string" json:"end_date"`
+}
+
+func NewGetOfflineVerifyReportReq() *GetOfflineVerifyReportReq {
+	return &GetOfflineVerifyReportReq{}
+}
+
+func (p *GetOfflineVerifyReportReq) InitDefault() {
+}
+
+func (p *GetOfflineVerifyReportReq) GetMerchantId() (v int64) {
+	return p.MerchantId
+}
+
+func (p *GetOfflineVerifyReportReq) GetSpotId() (v int64) {
+	return p.SpotId
+}
+
+func (p *GetOfflineVerifyReportReq) GetStartDate() (v string) {
+	return p.StartDate
+}
+
+func (p *GetOfflineVerifyReportReq) GetEndDate() (v string) {
+	return p.EndDate
+}
+func (p *GetOfflineVerifyReportReq) SetMerchantId(val int64) {
+	p.MerchantId = val
+}
+func (p *GetOfflineVerifyReportReq) SetSpotId(val int64) {
+	p.SpotId = val
+}
+func (p *GetOfflineVerifyReportReq) SetStartDate(val string) {
+	p.StartDate = val
+}
+func (p *GetOfflineVerifyReportReq) SetEndDate(val string) {
+	p.EndDate = val
+}
+
+func (p *GetOfflineVerifyReportReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOfflineVerifyReportReq(%+v)", *p)
+}
+
+var fieldIDToName_GetOfflineVerifyReportReq = map[int16]string{
+	1: "merchant_id",
+	2: "spot_id",
+	3: "start_date",
+	4: "end_date",
+}
+
+type GetOfflineVerifyReportResp struct {
+	Base       *BaseResp                 `thrift:"base,1" frugal:"1,default,BaseResp" json:"base"`
+	Applied    int32                     `thrift:"applied,2" frugal:"2,default,i32" json:"applied"`
+	Duplicated int32                     `thrift:"duplicated,3" frugal:"3,default,i32" json:"duplicated"`
+	Conflicts  int32                     `thrift:"conflicts,4" frugal:"4,default,i32" json:"conflicts"`
+	Rejected   int32                     `thrift:"rejected,5" frugal:"5,default,i32" json:"rejected"`
+	Exceptions []*OfflineVerifyException `thrift:"exceptions,6" frugal:"6,default,list<OfflineVerifyException>" json:"exceptions"`
+}
+
+func NewGetOfflineVerifyReportResp() *GetOfflineVerifyReportResp {
+	return &GetOfflineVerifyReportResp{}
+}
+
+func (p *GetOfflineVerifyReportResp) InitDefault() {
+}
+
+var GetOfflineVerifyReportResp_Base_DEFAULT *BaseResp
+
+func (p *GetOfflineVerifyReportResp) GetBase() (v *BaseResp) {
+	if !p.IsSetBase() {
+		return GetOfflineVerifyReportResp_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *GetOfflineVerifyReportResp) GetApplied() (v int32) {
+	return p.Applied
+}
+
+func (p *GetOfflineVerifyReportResp) GetDuplicated() (v int32) {
+	return p.Duplicated
+}
+
+func (p *GetOfflineVerifyReportResp) GetConflicts() (v int32) {
+	return p.Conflicts
+}
+
+func (p *GetOfflineVerifyReportResp) GetRejected() (v int32) {
+	return p.Rejected
+}
+
+func (p *GetOfflineVerifyReportResp) GetExceptions() (v []*OfflineVerifyException) {
+	return p.Exceptions
+}
+func (p *GetOfflineVerifyReportResp) SetBase(val *BaseResp) {
+	p.Base = val
+}
+func (p *GetOfflineVerifyReportResp) SetApplied(val int32) {
+	p.Applied = val
+}
+func (p *GetOfflineVerifyReportResp) SetDuplicated(val int32) {
+	p.Duplicated = val
+}
+func (p *GetOfflineVerifyReportResp) SetConflicts(val int32) {
+	p.Conflicts = val
+}
+func (p *GetOfflineVerifyReportResp) SetRejected(val int32) {
+	p.Rejected = val
+}
+func (p *GetOfflineVerifyReportResp) SetExceptions(val []*OfflineVerifyException) {
+	p.Exceptions = val
+}
+
+func (p *GetOfflineVerifyReportResp) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetOfflineVerifyReportResp) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetOfflineVerifyReportResp(%+v)", *p)
+}
+
+var fieldIDToName_GetOfflineVerifyReportResp = map[int16]string{
+	1: "base",
+	2: "applied",
+	3: "duplicated",
+	4: "conflicts",
+	5: "rejected",
+	6: "exceptions",
+}
+
+type VerifyService interface {
+	GetVerifyQRCode(ctx context.Context, req *GetVerifyQRCodeReq) (r *GetVerifyQRCodeResp, err error)
+
+	VerifyTicket(ctx context.Context, req *VerifyTicketReq) (r *VerifyTicketResp, err error)
+
+	RegisterGateDevice(ctx context.Context, req *RegisterGateDeviceReq) (r *RegisterGateDeviceResp, err error)
+
+	SetGateDeviceStatus(ctx context.Context, req *SetGateDeviceStatusReq) (r *SetGateDeviceStatusResp, err error)
+
+	ResetGateDeviceSecret(ctx context.Context, req *ResetGateDeviceSecretReq) (r *ResetGateDeviceSecretResp, err error)
+
+	GetOfflineWhitelist(ctx context.Context, req *GetOfflineWhitelistReq) (r *GetOfflineWhitelistResp, err error)
+
+	UploadOfflineVerifications(ctx context.Context, req *UploadOfflineVerificationsReq) (r *UploadOfflineVerificationsResp, err error)
+
+	GetOfflineVerifyReport(ctx context.Context, req *GetOfflineVerifyReportReq) (r *GetOfflineVerifyReportResp, err error)
+}
+
+type VerifyServiceGetVerifyQRCodeArgs struct {
+	Req *GetVerifyQRCodeReq `thrift:"req,1" frugal:"1,default,GetVerifyQRCodeReq" json:"req"`
+}
+
+func NewVerifyServiceGetVerifyQRCodeArgs() *VerifyServiceGetVerifyQRCodeArgs {
+	return &VerifyServiceGetVerifyQRCodeArgs{}
+}
+
+func (p *VerifyServiceGetVerifyQRCodeArgs) InitDefault() {
+}
+
+var VerifyServiceGetVerifyQRCodeArgs_Req_DEFAULT *GetVerifyQRCodeReq
+
+func (p *VerifyServiceGetVerifyQRCodeArgs) GetReq() (v *GetVerifyQRCodeReq) {
+	if !p.IsSetReq() {
+		return VerifyServiceGetVerifyQRCodeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VerifyServiceGetVerifyQRCodeArgs) SetReq(val *GetVerifyQRCodeReq) {
+	p.Req = val
+}
+
+func (p *VerifyServiceGetVerifyQRCodeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyServiceGetVerifyQRCodeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceGetVerifyQRCodeArgs(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceGetVerifyQRCodeArgs = map[int16]string{
+	1: "req",
+}
+
+type VerifyServiceGetVerifyQRCodeResult struct {
+	Success *GetVerifyQRCodeResp `thrift:"success,0,optional" frugal:"0,optional,GetVerifyQRCodeResp" json:"success,omitempty"`
+}
+
+func NewVerifyServiceGetVerifyQRCodeResult() *VerifyServiceGetVerifyQRCodeResult {
+	return &VerifyServiceGetVerifyQRCodeResult{}
+}
+
+func (p *VerifyServiceGetVerifyQRCodeResult) InitDefault() {
+}
+
+var VerifyServiceGetVerifyQRCodeResult_Success_DEFAULT *GetVerifyQRCodeResp
+
+func (p *VerifyServiceGetVerifyQRCodeResult) GetSuccess() (v *GetVerifyQRCodeResp) {
+	if !p.IsSetSuccess() {
+		return VerifyServiceGetVerifyQRCodeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VerifyServiceGetVerifyQRCodeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetVerifyQRCodeResp)
+}
+
+func (p *VerifyServiceGetVerifyQRCodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyServiceGetVerifyQRCodeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceGetVerifyQRCodeResult(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceGetVerifyQRCodeResult = map[int16]string{
+	0: "success",
+}
+
+type VerifyServiceVerifyTicketArgs struct {
+	Req *VerifyTicketReq `thrift:"req,1" frugal:"1,default,VerifyTicketReq" json:"req"`
+}
+
+func NewVerifyServiceVerifyTicketArgs() *VerifyServiceVerifyTicketArgs {
+	return &VerifyServiceVerifyTicketArgs{}
+}
+
+func (p *VerifyServiceVerifyTicketArgs) InitDefault() {
+}
+
+var VerifyServiceVerifyTicketArgs_Req_DEFAULT *VerifyTicketReq
+
+func (p *VerifyServiceVerifyTicketArgs) GetReq() (v *VerifyTicketReq) {
+	if !p.IsSetReq() {
+		return VerifyServiceVerifyTicketArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VerifyServiceVerifyTicketArgs) SetReq(val *VerifyTicketReq) {
+	p.Req = val
+}
+
+func (p *VerifyServiceVerifyTicketArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyServiceVerifyTicketArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceVerifyTicketArgs(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceVerifyTicketArgs = map[int16]string{
+	1: "req",
+}
+
+type VerifyServiceVerifyTicketResult struct {
+	Success *VerifyTicketResp `thrift:"success,0,optional" frugal:"0,optional,VerifyTicketResp" json:"success,omitempty"`
+}
+
+func NewVerifyServiceVerifyTicketResult() *VerifyServiceVerifyTicketResult {
+	return &VerifyServiceVerifyTicketResult{}
+}
+
+func (p *VerifyServiceVerifyTicketResult) InitDefault() {
+}
+
+var VerifyServiceVerifyTicketResult_Success_DEFAULT *VerifyTicketResp
+
+func (p *VerifyServiceVerifyTicketResult) GetSuccess() (v *VerifyTicketResp) {
+	if !p.IsSetSuccess() {
+		return VerifyServiceVerifyTicketResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VerifyServiceVerifyTicketResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VerifyTicketResp)
+}
+
+func (p *VerifyServiceVerifyTicketResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyServiceVerifyTicketResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceVerifyTicketResult(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceVerifyTicketResult = map[int16]string{
+	0: "success",
+}
+
+type VerifyServiceRegisterGateDeviceArgs struct {
+	Req *RegisterGateDeviceReq `thrift:"req,1" frugal:"1,default,RegisterGateDeviceReq" json:"req"`
+}
+
+func NewVerifyServiceRegisterGateDeviceArgs() *VerifyServiceRegisterGateDeviceArgs {
+	return &VerifyServiceRegisterGateDeviceArgs{}
+}
+
+func (p *VerifyServiceRegisterGateDeviceArgs) InitDefault() {
+}
+
+var VerifyServiceRegisterGateDeviceArgs_Req_DEFAULT *RegisterGateDeviceReq
+
+func (p *VerifyServiceRegisterGateDeviceArgs) GetReq() (v *RegisterGateDeviceReq) {
+	if !p.IsSetReq() {
+		return VerifyServiceRegisterGateDeviceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VerifyServiceRegisterGateDeviceArgs) SetReq(val *RegisterGateDeviceReq) {
+	p.Req = val
+}
+
+func (p *VerifyServiceRegisterGateDeviceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyServiceRegisterGateDeviceArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceRegisterGateDeviceArgs(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceRegisterGateDeviceArgs = map[int16]string{
+	1: "req",
+}
+
+type VerifyServiceRegisterGateDeviceResult struct {
+	Success *RegisterGateDeviceResp `thrift:"success,0,optional" frugal:"0,optional,RegisterGateDeviceResp" json:"success,omitempty"`
+}
+
+func NewVerifyServiceRegisterGateDeviceResult() *VerifyServiceRegisterGateDeviceResult {
+	return &VerifyServiceRegisterGateDeviceResult{}
+}
+
+func (p *VerifyServiceRegisterGateDeviceResult) InitDefault() {
+}
+
+var VerifyServiceRegisterGateDeviceResult_Success_DEFAULT *RegisterGateDeviceResp
+
+func (p *VerifyServiceRegisterGateDeviceResult) GetSuccess() (v *RegisterGateDeviceResp) {
+	if !p.IsSetSuccess() {
+		return VerifyServiceRegisterGateDeviceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VerifyServiceRegisterGateDeviceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RegisterGateDeviceResp)
+}
+
+func (p *VerifyServiceRegisterGateDeviceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyServiceRegisterGateDeviceResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceRegisterGateDeviceResult(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceRegisterGateDeviceResult = map[int16]string{
+	0: "success",
+}
+
+type VerifyServiceSetGateDeviceStatusArgs struct {
+	Req *SetGateDeviceStatusReq `thrift:"req,1" frugal:"1,default,SetGateDeviceStatusReq" json:"req"`
+}
+
+func NewVerifyServiceSetGateDeviceStatusArgs() *VerifyServiceSetGateDeviceStatusArgs {
+	return &VerifyServiceSetGateDeviceStatusArgs{}
+}
+
+func (p *VerifyServiceSetGateDeviceStatusArgs) InitDefault() {
+}
+
+var VerifyServiceSetGateDeviceStatusArgs_Req_DEFAULT *SetGateDeviceStatusReq
+
+func (p *VerifyServiceSetGateDeviceStatusArgs) GetReq() (v *SetGateDeviceStatusReq) {
+	if !p.IsSetReq() {
+		return VerifyServiceSetGateDeviceStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VerifyServiceSetGateDeviceStatusArgs) SetReq(val *SetGateDeviceStatusReq) {
+	p.Req = val
+}
+
+func (p *VerifyServiceSetGateDeviceStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyServiceSetGateDeviceStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceSetGateDeviceStatusArgs(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceSetGateDeviceStatusArgs = map[int16]string{
+	1: "req",
+}
+
+type VerifyServiceSetGateDeviceStatusResult struct {
+	Success *SetGateDeviceStatusResp `thrift:"success,0,optional" frugal:"0,optional,SetGateDeviceStatusResp" json:"success,omitempty"`
+}
+
+func NewVerifyServiceSetGateDeviceStatusResult() *VerifyServiceSetGateDeviceStatusResult {
+	return &VerifyServiceSetGateDeviceStatusResult{}
+}
+
+func (p *VerifyServiceSetGateDeviceStatusResult) InitDefault() {
+}
+
+var VerifyServiceSetGateDeviceStatusResult_Success_DEFAULT *SetGateDeviceStatusResp
+
+func (p *VerifyServiceSetGateDeviceStatusResult) GetSuccess() (v *SetGateDeviceStatusResp) {
+	if !p.IsSetSuccess() {
+		return VerifyServiceSetGateDeviceStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VerifyServiceSetGateDeviceStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SetGateDeviceStatusResp)
+}
+
+func (p *VerifyServiceSetGateDeviceStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyServiceSetGateDeviceStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceSetGateDeviceStatusResult(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceSetGateDeviceStatusResult = map[int16]string{
+	0: "success",
+}
+
+type VerifyServiceResetGateDeviceSecretArgs struct {
+	Req *ResetGateDeviceSecretReq `thrift:"req,1" frugal:"1,default,ResetGateDeviceSecretReq" json:"req"`
+}
+
+func NewVerifyServiceResetGateDeviceSecretArgs() *VerifyServiceResetGateDeviceSecretArgs {
+	return &VerifyServiceResetGateDeviceSecretArgs{}
+}
+
+func (p *VerifyServiceResetGateDeviceSecretArgs) InitDefault() {
+}
+
+var VerifyServiceResetGateDeviceSecretArgs_Req_DEFAULT *ResetGateDeviceSecretReq
+
+func (p *VerifyServiceResetGateDeviceSecretArgs) GetReq() (v *ResetGateDeviceSecretReq) {
+	if !p.IsSetReq() {
+		return VerifyServiceResetGateDeviceSecretArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VerifyServiceResetGateDeviceSecretArgs) SetReq(val *ResetGateDeviceSecretReq) {
+	p.Req = val
+}
+
+func (p *VerifyServiceResetGateDeviceSecretArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyServiceResetGateDeviceSecretArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceResetGateDeviceSecretArgs(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceResetGateDeviceSecretArgs = map[int16]string{
+	1: "req",
+}
+
+type VerifyServiceResetGateDeviceSecretResult struct {
+	Success *ResetGateDeviceSecretResp `thrift:"success,0,optional" frugal:"0,optional,ResetGateDeviceSecretResp" json:"success,omitempty"`
+}
+
+func NewVerifyServiceResetGateDeviceSecretResult() *VerifyServiceResetGateDeviceSecretResult {
+	return &VerifyServiceResetGateDeviceSecretResult{}
+}
+
+func (p *VerifyServiceResetGateDeviceSecretResult) InitDefault() {
+}
+
+var VerifyServiceResetGateDeviceSecretResult_Success_DEFAULT *ResetGateDeviceSecretResp
+
+func (p *VerifyServiceResetGateDeviceSecretResult) GetSuccess() (v *ResetGateDeviceSecretResp) {
+	if !p.IsSetSuccess() {
+		return VerifyServiceResetGateDeviceSecretResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VerifyServiceResetGateDeviceSecretResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ResetGateDeviceSecretResp)
+}
+
+func (p *VerifyServiceResetGateDeviceSecretResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyServiceResetGateDeviceSecretResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceResetGateDeviceSecretResult(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceResetGateDeviceSecretResult = map[int16]string{
+	0: "success",
+}
+
+type VerifyServiceGetOfflineWhitelistArgs struct {
+	Req *GetOfflineWhitelistReq `thrift:"req,1" frugal:"1,default,GetOfflineWhitelistReq" json:"req"`
+}
+
+func NewVerifyServiceGetOfflineWhitelistArgs() *VerifyServiceGetOfflineWhitelistArgs {
+	return &VerifyServiceGetOfflineWhitelistArgs{}
+}
+
+func (p *VerifyServiceGetOfflineWhitelistArgs) InitDefault() {
+}
+
+var VerifyServiceGetOfflineWhitelistArgs_Req_DEFAULT *GetOfflineWhitelistReq
+
+func (p *VerifyServiceGetOfflineWhitelistArgs) GetReq() (v *GetOfflineWhitelistReq) {
+	if !p.IsSetReq() {
+		return VerifyServiceGetOfflineWhitelistArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VerifyServiceGetOfflineWhitelistArgs) SetReq(val *GetOfflineWhitelistReq) {
+	p.Req = val
+}
+
+func (p *VerifyServiceGetOfflineWhitelistArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyServiceGetOfflineWhitelistArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceGetOfflineWhitelistArgs(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceGetOfflineWhitelistArgs = map[int16]string{
+	1: "req",
+}
+
+type VerifyServiceGetOfflineWhitelistResult struct {
+	Success *GetOfflineWhitelistResp `thrift:"success,0,optional" frugal:"0,optional,GetOfflineWhitelistResp" json:"success,omitempty"`
+}
+
+func NewVerifyServiceGetOfflineWhitelistResult() *VerifyServiceGetOfflineWhitelistResult {
+	return &VerifyServiceGetOfflineWhitelistResult{}
+}
+
+func (p *VerifyServiceGetOfflineWhitelistResult) InitDefault() {
+}
+
+var VerifyServiceGetOfflineWhitelistResult_Success_DEFAULT *GetOfflineWhitelistResp
+
+func (p *VerifyServiceGetOfflineWhitelistResult) GetSuccess() (v *GetOfflineWhitelistResp) {
+	if !p.IsSetSuccess() {
+		return VerifyServiceGetOfflineWhitelistResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VerifyServiceGetOfflineWhitelistResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetOfflineWhitelistResp)
+}
+
+func (p *VerifyServiceGetOfflineWhitelistResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyServiceGetOfflineWhitelistResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceGetOfflineWhitelistResult(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceGetOfflineWhitelistResult = map[int16]string{
+	0: "success",
+}
+
+type VerifyServiceUploadOfflineVerificationsArgs struct {
+	Req *UploadOfflineVerificationsReq `thrift:"req,1" frugal:"1,default,UploadOfflineVerificationsReq" json:"req"`
+}
+
+func NewVerifyServiceUploadOfflineVerificationsArgs() *VerifyServiceUploadOfflineVerificationsArgs {
+	return &VerifyServiceUploadOfflineVerificationsArgs{}
+}
+
+func (p *VerifyServiceUploadOfflineVerificationsArgs) InitDefault() {
+}
+
+var VerifyServiceUploadOfflineVerificationsArgs_Req_DEFAULT *UploadOfflineVerificationsReq
+
+func (p *VerifyServiceUploadOfflineVerificationsArgs) GetReq() (v *UploadOfflineVerificationsReq) {
+	if !p.IsSetReq() {
+		return VerifyServiceUploadOfflineVerificationsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VerifyServiceUploadOfflineVerificationsArgs) SetReq(val *UploadOfflineVerificationsReq) {
+	p.Req = val
+}
+
+func (p *VerifyServiceUploadOfflineVerificationsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyServiceUploadOfflineVerificationsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceUploadOfflineVerificationsArgs(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceUploadOfflineVerificationsArgs = map[int16]string{
+	1: "req",
+}
+
+type VerifyServiceUploadOfflineVerificationsResult struct {
+	Success *UploadOfflineVerificationsResp `thrift:"success,0,optional" frugal:"0,optional,UploadOfflineVerificationsResp" json:"success,omitempty"`
+}
+
+func NewVerifyServiceUploadOfflineVerificationsResult() *VerifyServiceUploadOfflineVerificationsResult {
+	return &VerifyServiceUploadOfflineVerificationsResult{}
+}
+
+func (p *VerifyServiceUploadOfflineVerificationsResult) InitDefault() {
+}
+
+var VerifyServiceUploadOfflineVerificationsResult_Success_DEFAULT *UploadOfflineVerificationsResp
+
+func (p *VerifyServiceUploadOfflineVerificationsResult) GetSuccess() (v *UploadOfflineVerificationsResp) {
+	if !p.IsSetSuccess() {
+		return VerifyServiceUploadOfflineVerificationsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VerifyServiceUploadOfflineVerificationsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UploadOfflineVerificationsResp)
+}
+
+func (p *VerifyServiceUploadOfflineVerificationsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyServiceUploadOfflineVerificationsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceUploadOfflineVerificationsResult(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceUploadOfflineVerificationsResult = map[int16]string{
+	0: "success",
+}
+
+type VerifyServiceGetOfflineVerifyReportArgs struct {
+	Req *GetOfflineVerifyReportReq `thrift:"req,1" frugal:"1,default,GetOfflineVerifyReportReq" json:"req"`
+}
+
+func NewVerifyServiceGetOfflineVerifyReportArgs() *VerifyServiceGetOfflineVerifyReportArgs {
+	return &VerifyServiceGetOfflineVerifyReportArgs{}
+}
+
+func (p *VerifyServiceGetOfflineVerifyReportArgs) InitDefault() {
+}
+
+var VerifyServiceGetOfflineVerifyReportArgs_Req_DEFAULT *GetOfflineVerifyReportReq
+
+func (p *VerifyServiceGetOfflineVerifyReportArgs) GetReq() (v *GetOfflineVerifyReportReq) {
+	if !p.IsSetReq() {
+		return VerifyServiceGetOfflineVerifyReportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VerifyServiceGetOfflineVerifyReportArgs) SetReq(val *GetOfflineVerifyReportReq) {
+	p.Req = val
+}
+
+func (p *VerifyServiceGetOfflineVerifyReportArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VerifyServiceGetOfflineVerifyReportArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceGetOfflineVerifyReportArgs(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceGetOfflineVerifyReportArgs = map[int16]string{
+	1: "req",
+}
+
+type VerifyServiceGetOfflineVerifyReportResult struct {
+	Success *GetOfflineVerifyReportResp `thrift:"success,0,optional" frugal:"0,optional,GetOfflineVerifyReportResp" json:"success,omitempty"`
+}
+
+func NewVerifyServiceGetOfflineVerifyReportResult() *VerifyServiceGetOfflineVerifyReportResult {
+	return &VerifyServiceGetOfflineVerifyReportResult{}
+}
+
+func (p *VerifyServiceGetOfflineVerifyReportResult) InitDefault() {
+}
+
+var VerifyServiceGetOfflineVerifyReportResult_Success_DEFAULT *GetOfflineVerifyReportResp
+
+func (p *VerifyServiceGetOfflineVerifyReportResult) GetSuccess() (v *GetOfflineVerifyReportResp) {
+	if !p.IsSetSuccess() {
+		return VerifyServiceGetOfflineVerifyReportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VerifyServiceGetOfflineVerifyReportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetOfflineVerifyReportResp)
+}
+
+func (p *VerifyServiceGetOfflineVerifyReportResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerifyServiceGetOfflineVerifyReportResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyServiceGetOfflineVerifyReportResult(%+v)", *p)
+}
+
+var fieldIDToName_VerifyServiceGetOfflineVerifyReportResult = map[int16]string{
+	0: "success",
+}
@@ -0,0 +1,91 @@
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
+
+package verifyservice
+
+import (
+	"context"
+	verify "example_shop/kitex_gen/verify"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	GetVerifyQRCode(ctx context.Context, req *verify.GetVerifyQRCodeReq, callOptions ...callopt.Option) (r *verify.GetVerifyQRCodeResp, err error)
+	VerifyTicket(ctx context.Context, req *verify.VerifyTicketReq, callOptions ...callopt.Option) (r *verify.VerifyTicketResp, err error)
+	RegisterGateDevice(ctx context.Context, req *verify.RegisterGateDeviceReq, callOptions ...callopt.Option) (r *verify.RegisterGateDeviceResp, err error)
+	SetGateDeviceStatus(ctx context.Context, req *verify.SetGateDeviceStatusReq, callOptions ...callopt.Option) (r *verify.SetGateDeviceStatusResp, err error)
+	ResetGateDeviceSecret(ctx context.Context, req *verify.ResetGateDeviceSecretReq, callOptions ...callopt.Option) (r *verify.ResetGateDeviceSecretResp, err error)
+	GetOfflineWhitelist(ctx context.Context, req *verify.GetOfflineWhitelistReq, callOptions ...callopt.Option) (r *verify.GetOfflineWhitelistResp, err error)
+	UploadOfflineVerifications(ctx context.Context, req *verify.UploadOfflineVerificationsReq, callOptions ...callopt.Option) (r *verify.UploadOfflineVerificationsResp, err error)
+	GetOfflineVerifyReport(ctx context.Context, req *verify.GetOfflineVerifyReportReq, callOptions ...callopt.Option) (r *verify.GetOfflineVerifyReportResp, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfoForClient(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kVerifyServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kVerifyServiceClient struct {
+	*kClient
+}
+
+func (p *kVerifyServiceClient) GetVerifyQRCode(ctx context.Context, req *verify.GetVerifyQRCodeReq, callOptions ...callopt.Option) (r *verify.GetVerifyQRCodeResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetVerifyQRCode(ctx, req)
+}
+
+func (p *kVerifyServiceClient) VerifyTicket(ctx context.Context, req *verify.VerifyTicketReq, callOptions ...callopt.Option) (r *verify.VerifyTicketResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyTicket(ctx, req)
+}
+
+func (p *kVerifyServiceClient) RegisterGateDevice(ctx context.Context, req *verify.RegisterGateDeviceReq, callOptions ...callopt.Option) (r *verify.RegisterGateDeviceResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RegisterGateDevice(ctx, req)
+}
+
+func (p *kVerifyServiceClient) SetGateDeviceStatus(ctx context.Context, req *verify.SetGateDeviceStatusReq, callOptions ...callopt.Option) (r *verify.SetGateDeviceStatusResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetGateDeviceStatus(ctx, req)
+}
+
+func (p *kVerifyServiceClient) ResetGateDeviceSecret(ctx context.Context, req *verify.ResetGateDeviceSecretReq, callOptions ...callopt.Option) (r *verify.ResetGateDeviceSecretResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ResetGateDeviceSecret(ctx, req)
+}
+
+func (p *kVerifyServiceClient) GetOfflineWhitelist(ctx context.Context, req *verify.GetOfflineWhitelistReq, callOptions ...callopt.Option) (r *verify.GetOfflineWhitelistResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetOfflineWhitelist(ctx, req)
+}
+
+func (p *kVerifyServiceClient) UploadOfflineVerifications(ctx context.Context, req *verify.UploadOfflineVerificationsReq, callOptions ...callopt.Option) (r *verify.UploadOfflineVerificationsResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadOfflineVerifications(ctx, req)
+}
+
+func (p *kVerifyServiceClient) GetOfflineVerifyReport(ctx context.Context, req *verify.GetOfflineVerifyReportReq, callOptions ...callopt.Option) (r *verify.GetOfflineVerifyReportResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetOfflineVerifyReport(ctx, req)
+}
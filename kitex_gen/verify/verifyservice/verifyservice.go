@@ -0,0 +1,370 @@
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
+
+package verifyservice
+
+import (
+	"context"
+	"errors"
+	verify "example_shop/kitex_gen/verify"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"GetVerifyQRCode": kitex.NewMethodInfo(
+		getVerifyQRCodeHandler,
+		newVerifyServiceGetVerifyQRCodeArgs,
+		newVerifyServiceGetVerifyQRCodeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"VerifyTicket": kitex.NewMethodInfo(
+		verifyTicketHandler,
+		newVerifyServiceVerifyTicketArgs,
+		newVerifyServiceVerifyTicketResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RegisterGateDevice": kitex.NewMethodInfo(
+		registerGateDeviceHandler,
+		newVerifyServiceRegisterGateDeviceArgs,
+		newVerifyServiceRegisterGateDeviceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SetGateDeviceStatus": kitex.NewMethodInfo(
+		setGateDeviceStatusHandler,
+		newVerifyServiceSetGateDeviceStatusArgs,
+		newVerifyServiceSetGateDeviceStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ResetGateDeviceSecret": kitex.NewMethodInfo(
+		resetGateDeviceSecretHandler,
+		newVerifyServiceResetGateDeviceSecretArgs,
+		newVerifyServiceResetGateDeviceSecretResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetOfflineWhitelist": kitex.NewMethodInfo(
+		getOfflineWhitelistHandler,
+		newVerifyServiceGetOfflineWhitelistArgs,
+		newVerifyServiceGetOfflineWhitelistResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UploadOfflineVerifications": kitex.NewMethodInfo(
+		uploadOfflineVerificationsHandler,
+		newVerifyServiceUploadOfflineVerificationsArgs,
+		newVerifyServiceUploadOfflineVerificationsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetOfflineVerifyReport": kitex.NewMethodInfo(
+		getOfflineVerifyReportHandler,
+		newVerifyServiceGetOfflineVerifyReportArgs,
+		newVerifyServiceGetOfflineVerifyReportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+}
+
+var (
+	verifyServiceServiceInfo                = NewServiceInfo()
+	verifyServiceServiceInfoForClient       = NewServiceInfoForClient()
+	verifyServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return verifyServiceServiceInfo
+}
+
+// for stream client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return verifyServiceServiceInfoForStreamClient
+}
+
+// for client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return verifyServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(false, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "VerifyService"
+	handlerType := (*verify.VerifyService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "verify",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Thrift,
+		KiteXGenVersion: "v0.15.4",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func getVerifyQRCodeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*verify.VerifyServiceGetVerifyQRCodeArgs)
+	realResult := result.(*verify.VerifyServiceGetVerifyQRCodeResult)
+	success, err := handler.(verify.VerifyService).GetVerifyQRCode(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVerifyServiceGetVerifyQRCodeArgs() interface{} {
+	return verify.NewVerifyServiceGetVerifyQRCodeArgs()
+}
+
+func newVerifyServiceGetVerifyQRCodeResult() interface{} {
+	return verify.NewVerifyServiceGetVerifyQRCodeResult()
+}
+
+func verifyTicketHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*verify.VerifyServiceVerifyTicketArgs)
+	realResult := result.(*verify.VerifyServiceVerifyTicketResult)
+	success, err := handler.(verify.VerifyService).VerifyTicket(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVerifyServiceVerifyTicketArgs() interface{} {
+	return verify.NewVerifyServiceVerifyTicketArgs()
+}
+
+func newVerifyServiceVerifyTicketResult() interface{} {
+	return verify.NewVerifyServiceVerifyTicketResult()
+}
+
+func registerGateDeviceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*verify.VerifyServiceRegisterGateDeviceArgs)
+	realResult := result.(*verify.VerifyServiceRegisterGateDeviceResult)
+	success, err := handler.(verify.VerifyService).RegisterGateDevice(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVerifyServiceRegisterGateDeviceArgs() interface{} {
+	return verify.NewVerifyServiceRegisterGateDeviceArgs()
+}
+
+func newVerifyServiceRegisterGateDeviceResult() interface{} {
+	return verify.NewVerifyServiceRegisterGateDeviceResult()
+}
+
+func setGateDeviceStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*verify.VerifyServiceSetGateDeviceStatusArgs)
+	realResult := result.(*verify.VerifyServiceSetGateDeviceStatusResult)
+	success, err := handler.(verify.VerifyService).SetGateDeviceStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVerifyServiceSetGateDeviceStatusArgs() interface{} {
+	return verify.NewVerifyServiceSetGateDeviceStatusArgs()
+}
+
+func newVerifyServiceSetGateDeviceStatusResult() interface{} {
+	return verify.NewVerifyServiceSetGateDeviceStatusResult()
+}
+
+func resetGateDeviceSecretHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*verify.VerifyServiceResetGateDeviceSecretArgs)
+	realResult := result.(*verify.VerifyServiceResetGateDeviceSecretResult)
+	success, err := handler.(verify.VerifyService).ResetGateDeviceSecret(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVerifyServiceResetGateDeviceSecretArgs() interface{} {
+	return verify.NewVerifyServiceResetGateDeviceSecretArgs()
+}
+
+func newVerifyServiceResetGateDeviceSecretResult() interface{} {
+	return verify.NewVerifyServiceResetGateDeviceSecretResult()
+}
+
+func getOfflineWhitelistHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*verify.VerifyServiceGetOfflineWhitelistArgs)
+	realResult := result.(*verify.VerifyServiceGetOfflineWhitelistResult)
+	success, err := handler.(verify.VerifyService).GetOfflineWhitelist(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVerifyServiceGetOfflineWhitelistArgs() interface{} {
+	return verify.NewVerifyServiceGetOfflineWhitelistArgs()
+}
+
+func newVerifyServiceGetOfflineWhitelistResult() interface{} {
+	return verify.NewVerifyServiceGetOfflineWhitelistResult()
+}
+
+func uploadOfflineVerificationsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*verify.VerifyServiceUploadOfflineVerificationsArgs)
+	realResult := result.(*verify.VerifyServiceUploadOfflineVerificationsResult)
+	success, err := handler.(verify.VerifyService).UploadOfflineVerifications(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVerifyServiceUploadOfflineVerificationsArgs() interface{} {
+	return verify.NewVerifyServiceUploadOfflineVerificationsArgs()
+}
+
+func newVerifyServiceUploadOfflineVerificationsResult() interface{} {
+	return verify.NewVerifyServiceUploadOfflineVerificationsResult()
+}
+
+func getOfflineVerifyReportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*verify.VerifyServiceGetOfflineVerifyReportArgs)
+	realResult := result.(*verify.VerifyServiceGetOfflineVerifyReportResult)
+	success, err := handler.(verify.VerifyService).GetOfflineVerifyReport(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVerifyServiceGetOfflineVerifyReportArgs() interface{} {
+	return verify.NewVerifyServiceGetOfflineVerifyReportArgs()
+}
+
+func newVerifyServiceGetOfflineVerifyReportResult() interface{} {
+	return verify.NewVerifyServiceGetOfflineVerifyReportResult()
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) GetVerifyQRCode(ctx context.Context, req *verify.GetVerifyQRCodeReq) (r *verify.GetVerifyQRCodeResp, err error) {
+	var _args verify.VerifyServiceGetVerifyQRCodeArgs
+	_args.Req = req
+	var _result verify.VerifyServiceGetVerifyQRCodeResult
+	if err = p.c.Call(ctx, "GetVerifyQRCode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyTicket(ctx context.Context, req *verify.VerifyTicketReq) (r *verify.VerifyTicketResp, err error) {
+	var _args verify.VerifyServiceVerifyTicketArgs
+	_args.Req = req
+	var _result verify.VerifyServiceVerifyTicketResult
+	if err = p.c.Call(ctx, "VerifyTicket", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RegisterGateDevice(ctx context.Context, req *verify.RegisterGateDeviceReq) (r *verify.RegisterGateDeviceResp, err error) {
+	var _args verify.VerifyServiceRegisterGateDeviceArgs
+	_args.Req = req
+	var _result verify.VerifyServiceRegisterGateDeviceResult
+	if err = p.c.Call(ctx, "RegisterGateDevice", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetGateDeviceStatus(ctx context.Context, req *verify.SetGateDeviceStatusReq) (r *verify.SetGateDeviceStatusResp, err error) {
+	var _args verify.VerifyServiceSetGateDeviceStatusArgs
+	_args.Req = req
+	var _result verify.VerifyServiceSetGateDeviceStatusResult
+	if err = p.c.Call(ctx, "SetGateDeviceStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ResetGateDeviceSecret(ctx context.Context, req *verify.ResetGateDeviceSecretReq) (r *verify.ResetGateDeviceSecretResp, err error) {
+	var _args verify.VerifyServiceResetGateDeviceSecretArgs
+	_args.Req = req
+	var _result verify.VerifyServiceResetGateDeviceSecretResult
+	if err = p.c.Call(ctx, "ResetGateDeviceSecret", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetOfflineWhitelist(ctx context.Context, req *verify.GetOfflineWhitelistReq) (r *verify.GetOfflineWhitelistResp, err error) {
+	var _args verify.VerifyServiceGetOfflineWhitelistArgs
+	_args.Req = req
+	var _result verify.VerifyServiceGetOfflineWhitelistResult
+	if err = p.c.Call(ctx, "GetOfflineWhitelist", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadOfflineVerifications(ctx context.Context, req *verify.UploadOfflineVerificationsReq) (r *verify.UploadOfflineVerificationsResp, err error) {
+	var _args verify.VerifyServiceUploadOfflineVerificationsArgs
+	_args.Req = req
+	var _result verify.VerifyServiceUploadOfflineVerificationsResult
+	if err = p.c.Call(ctx, "UploadOfflineVerifications", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetOfflineVerifyReport(ctx context.Context, req *verify.GetOfflineVerifyReportReq) (r *verify.GetOfflineVerifyReportResp, err error) {
+	var _args verify.VerifyServiceGetOfflineVerifyReportArgs
+	_args.Req = req
+	var _result verify.VerifyServiceGetOfflineVerifyReportResult
+	if err = p.c.Call(ctx, "GetOfflineVerifyReport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -0,0 +1,362 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/config"
+	"example_shop/common/constant"
+	"example_shop/common/couponrule"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/kitex_gen/coupon"
+	"fmt"
+	"log"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// 领取脚本返回值
+const (
+	claimOK        = 1
+	claimStockMiss = -1 // 库存缓存不存在，需要预热
+	claimUserMiss  = -2 // 用户已领取张数缓存不存在，需要预热
+	claimQuotaFull = -3 // 已达到每人限领张数
+	claimStockOut  = -4 // 库存不足
+)
+
+// 缓存预热后重试扣减的最大次数
+const claimWarmRetries = 3
+
+// 默认每个用户对同一优惠券的领取上限
+const defaultPerUserLimit = 1
+
+// antiBrushScript 滑动窗口防刷：窗口内任一维度次数达到上限则拒绝，否则所有维度记一次
+// KEYS: 各维度窗口key；ARGV[1]=当前毫秒时间戳，ARGV[2]=窗口毫秒数，ARGV[3]=上限，ARGV[4]=本次请求唯一标识
+var antiBrushScript = redis.NewScript(`
+for _, key in ipairs(KEYS) do
+	redis.call('ZREMRANGEBYSCORE', key, 0, tonumber(ARGV[1]) - tonumber(ARGV[2]))
+	if redis.call('ZCARD', key) >= tonumber(ARGV[3]) then
+		return 0
+	end
+end
+for _, key in ipairs(KEYS) do
+	redis.call('ZADD', key, ARGV[1], ARGV[4])
+	redis.call('PEXPIRE', key, ARGV[2])
+end
+return 1
+`)
+
+// claimScript 原子扣减库存并累加用户已领取张数
+// KEYS[1]=库存key，KEYS[2]=用户已领取张数key；ARGV[1]=每人限领张数
+var claimScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+if redis.call('EXISTS', KEYS[2]) == 0 then
+	return -2
+end
+if tonumber(redis.call('GET', KEYS[2])) >= tonumber(ARGV[1]) then
+	return -3
+end
+if tonumber(redis.call('GET', KEYS[1])) <= 0 then
+	return -4
+end
+redis.call('DECR', KEYS[1])
+redis.call('INCR', KEYS[2])
+return 1
+`)
+
+// claimRollbackScript 落库失败时回补库存与用户已领取张数，缓存已失效则跳过
+var claimRollbackScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('INCR', KEYS[1])
+end
+if redis.call('EXISTS', KEYS[2]) == 1 and tonumber(redis.call('GET', KEYS[2])) > 0 then
+	redis.call('DECR', KEYS[2])
+end
+return 1
+`)
+
+// incrIfExistsScript 缓存存在时按增量调整，KEYS[1]=缓存key，ARGV[1]=增量
+var incrIfExistsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return redis.call('INCRBY', KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+var errCouponStockOut = errors.New("优惠券已领完")
+
+// ClaimCoupon 用户领取优惠券：防刷校验 -> Redis原子扣库存 -> MySQL落库
+func (s *CouponService) ClaimCoupon(ctx context.Context, req *coupon.ClaimCouponReq) (*coupon.ClaimCouponResp, error) {
+	resp := &coupon.ClaimCouponResp{}
+	if req.UserId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID不合法")
+		return resp, nil
+	}
+	c, err := getCoupon(ctx, req.CouponId)
+	if err != nil {
+		resp.Base = couponErrResp(err)
+		return resp, nil
+	}
+	if msg := checkClaimable(c, time.Now()); msg != "" {
+		resp.Base = fail(constant.CodeConflict, msg)
+		return resp, nil
+	}
+	ext, err := c.Ext()
+	if err != nil {
+		log.Printf("解析优惠券扩展字段失败, coupon_id=%d: %v", c.ID, err)
+		resp.Base = fail(constant.CodeServerError, "优惠券配置错误")
+		return resp, nil
+	}
+
+	userID := uint64(req.UserId)
+	if err = checkClaimRules(db.MysqlDB.WithContext(ctx), c, userID); err != nil {
+		var nc *errNotClaimable
+		if errors.As(err, &nc) {
+			resp.Base = fail(constant.CodeConflict, nc.msg)
+			return resp, nil
+		}
+		log.Printf("校验领取规则失败, coupon_id=%d: %v", c.ID, err)
+		resp.Base = fail(constant.CodeServerError, "领取失败，请稍后重试")
+		return resp, nil
+	}
+	allowed, err := checkAntiBrush(ctx, userID, strings.TrimSpace(req.DeviceId))
+	if err != nil {
+		log.Printf("防刷校验失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "领取失败，请稍后重试")
+		return resp, nil
+	}
+	if !allowed {
+		resp.Base = fail(constant.CodeTooFrequent, "领取过于频繁，请稍后再试")
+		return resp, nil
+	}
+
+	if ext.FlashSale {
+		code, token, err := flashClaim(ctx, c, userID, perUserLimit(ext))
+		if err != nil {
+			log.Printf("秒杀领取失败, coupon_id=%d: %v", c.ID, err)
+			resp.Base = fail(constant.CodeServerError, "领取失败，请稍后重试")
+			return resp, nil
+		}
+		if base := claimCodeResp(code); base != nil {
+			resp.Base = base
+			return resp, nil
+		}
+		resp.Base = success("抢券成功，正在发放")
+		resp.ClaimToken = token
+		return resp, nil
+	}
+
+	code, err := deductStock(ctx, c, userID, perUserLimit(ext))
+	if err != nil {
+		log.Printf("扣减优惠券库存失败, coupon_id=%d: %v", c.ID, err)
+		resp.Base = fail(constant.CodeServerError, "领取失败，请稍后重试")
+		return resp, nil
+	}
+	if base := claimCodeResp(code); base != nil {
+		resp.Base = base
+		return resp, nil
+	}
+
+	uc, err := saveUserCoupon(ctx, c, userID)
+	if err != nil {
+		rollbackStock(ctx, c.ID, userID)
+		if errors.Is(err, errCouponStockOut) {
+			resp.Base = fail(constant.CodeConflict, err.Error())
+			return resp, nil
+		}
+		log.Printf("保存用户优惠券失败, coupon_id=%d, user_id=%d: %v", c.ID, userID, err)
+		resp.Base = fail(constant.CodeServerError, "领取失败，请稍后重试")
+		return resp, nil
+	}
+	resp.Base = success("领取成功")
+	resp.UserCouponId = int64(uc.ID)
+	return resp, nil
+}
+
+// perUserLimit 返回每人限领张数，未配置时使用默认值
+func perUserLimit(ext model.CouponExt) int {
+	if ext.PerUserLimit <= 0 {
+		return defaultPerUserLimit
+	}
+	return ext.PerUserLimit
+}
+
+// claimCodeResp 将扣库存脚本的失败返回值转换为响应，成功时返回nil
+func claimCodeResp(code int) *coupon.BaseResp {
+	switch code {
+	case claimQuotaFull:
+		return fail(constant.CodeConflict, "已达到领取上限")
+	case claimStockOut:
+		return fail(constant.CodeConflict, errCouponStockOut.Error())
+	}
+	return nil
+}
+
+// checkClaimable 校验优惠券当前是否可领取，不可领取时返回原因
+func checkClaimable(c *model.Coupon, now time.Time) string {
+	if c.CouponStatus != constant.CouponStatusValid {
+		return "优惠券已失效"
+	}
+	if !now.Before(c.ValidEndTime) {
+		return "优惠券已过期"
+	}
+	return ""
+}
+
+// checkClaimRules 按优惠券扩展规则校验用户能否领取，不满足时返回 *errNotClaimable
+func checkClaimRules(tx *gorm.DB, c *model.Coupon, userID uint64) error {
+	ext, err := c.Ext()
+	if err != nil {
+		return &errNotClaimable{"优惠券配置错误"}
+	}
+	var in couponrule.ClaimInput
+	if ext.NewUserOnly {
+		if in.NewUser, err = couponrule.IsNewUser(tx, userID); err != nil {
+			return err
+		}
+	}
+	if err = couponrule.CheckClaim(ext, in); err != nil {
+		return &errNotClaimable{err.Error()}
+	}
+	return nil
+}
+
+// checkAntiBrush 按用户、设备两个维度做滑动窗口限流，返回是否放行
+func checkAntiBrush(ctx context.Context, userID uint64, deviceID string) (bool, error) {
+	cfg := config.Cfg.Coupon
+	if cfg.AntiBrushLimit <= 0 || cfg.AntiBrushExpire <= 0 {
+		return true, nil
+	}
+	keys := []string{fmt.Sprintf(constant.RedisKeyAntiBrushUser, userID)}
+	if deviceID != "" {
+		keys = append(keys, fmt.Sprintf(constant.RedisKeyAntiBrushDevice, deviceID))
+	}
+	now := time.Now().UnixMilli()
+	member := strconv.FormatInt(now, 10) + "-" + strconv.FormatInt(rand.Int63(), 36)
+	window := int64(cfg.AntiBrushExpire) * 1000
+	ret, err := antiBrushScript.Run(ctx, db.Rdb, keys, now, window, cfg.AntiBrushLimit, member).Int()
+	if err != nil {
+		return false, err
+	}
+	return ret == 1, nil
+}
+
+// deductStock 执行扣库存脚本，缓存缺失时从MySQL预热后重试
+func deductStock(ctx context.Context, c *model.Coupon, userID uint64, quota int) (int, error) {
+	stockKey := fmt.Sprintf(constant.RedisKeyCouponStock, c.ID)
+	userKey := fmt.Sprintf(constant.RedisKeyCouponUserClaim, c.ID, userID)
+	for i := 0; i < claimWarmRetries; i++ {
+		code, err := claimScript.Run(ctx, db.Rdb, []string{stockKey, userKey}, quota).Int()
+		if err != nil {
+			return 0, err
+		}
+		switch code {
+		case claimStockMiss:
+			err = warmStock(ctx, c, stockKey)
+		case claimUserMiss:
+			err = warmUserClaim(ctx, c, userID, userKey)
+		default:
+			return code, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return 0, errors.New("库存缓存预热失败")
+}
+
+// cacheTTL 库存相关缓存保留到优惠券过期后一天
+func cacheTTL(c *model.Coupon) time.Duration {
+	ttl := time.Until(c.ValidEndTime) + 24*time.Hour
+	if ttl < time.Minute {
+		ttl = time.Minute
+	}
+	return ttl
+}
+
+// warmStock 以MySQL中的剩余库存预热Redis，已存在则不覆盖
+func warmStock(ctx context.Context, c *model.Coupon, key string) error {
+	var stock uint32
+	err := db.MysqlDB.WithContext(ctx).Model(&model.Coupon{}).
+		Where("id = ?", c.ID).Select("stock").Scan(&stock).Error
+	if err != nil {
+		return err
+	}
+	return db.Rdb.SetNX(ctx, key, stock, cacheTTL(c)).Err()
+}
+
+// warmUserClaim 以MySQL中用户已领取张数预热Redis，已存在则不覆盖
+func warmUserClaim(ctx context.Context, c *model.Coupon, userID uint64, key string) error {
+	var count int64
+	err := db.MysqlDB.WithContext(ctx).Model(&model.UserCoupon{}).
+		Where("user_id = ? AND coupon_id = ?", userID, c.ID).Count(&count).Error
+	if err != nil {
+		return err
+	}
+	return db.Rdb.SetNX(ctx, key, count, cacheTTL(c)).Err()
+}
+
+// rollbackStock 回补Redis中的库存与用户已领取张数
+func rollbackStock(ctx context.Context, couponID, userID uint64) {
+	keys := []string{
+		fmt.Sprintf(constant.RedisKeyCouponStock, couponID),
+		fmt.Sprintf(constant.RedisKeyCouponUserClaim, couponID, userID),
+	}
+	if err := claimRollbackScript.Run(ctx, db.Rdb, keys).Err(); err != nil {
+		log.Printf("回补优惠券库存缓存失败, coupon_id=%d, user_id=%d: %v", couponID, userID, err)
+	}
+}
+
+// adjustStockCache MySQL库存变化后按增量调整库存缓存，缓存不存在时跳过，下次领取时从MySQL预热
+// 秒杀领取尚在消息流中的记录未扣减MySQL库存，此时缓存是唯一准确的剩余量，不能删除后重新预热
+func adjustStockCache(ctx context.Context, couponID uint64, delta int64) {
+	if delta == 0 {
+		return
+	}
+	key := fmt.Sprintf(constant.RedisKeyCouponStock, couponID)
+	if err := incrIfExistsScript.Run(ctx, db.Rdb, []string{key}, delta).Err(); err != nil {
+		log.Printf("调整优惠券库存缓存失败, coupon_id=%d, delta=%d: %v", couponID, delta, err)
+	}
+}
+
+// saveUserCoupon 在事务中扣减MySQL库存并写入用户优惠券，库存条件更新兜底防超卖
+func saveUserCoupon(ctx context.Context, c *model.Coupon, userID uint64) (*model.UserCoupon, error) {
+	uc := newUserCoupon(c, userID)
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Coupon{}).
+			Where("id = ? AND stock > 0 AND coupon_status = ?", c.ID, constant.CouponStatusValid).
+			Update("stock", gorm.Expr("stock - 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errCouponStockOut
+		}
+		return tx.Create(uc).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc, nil
+}
+
+// newUserCoupon 根据优惠券配置构造用户优惠券，冗余名称、面额与有效期
+func newUserCoupon(c *model.Coupon, userID uint64) *model.UserCoupon {
+	return &model.UserCoupon{
+		UserID:         userID,
+		CouponID:       c.ID,
+		CouponName:     c.CouponName,
+		Denomination:   c.Denomination,
+		MinUseAmount:   c.MinUseAmount,
+		ValidStartTime: c.ValidStartTime,
+		ValidEndTime:   c.ValidEndTime,
+		UseStatus:      constant.UseStatusUnused,
+	}
+}
@@ -0,0 +1,338 @@
+package coupon
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/couponcode"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/kitex_gen/coupon"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	maxCodesPerBatch  = 50000 // 单批次最多生成的兑换码数量
+	codeInsertChunk   = 1000  // 每次批量插入的兑换码数量
+	codeGenMaxRetries = 5     // 兑换码冲突时的最大补生成轮数
+)
+
+var (
+	errCodeNotFound = errors.New("兑换码不存在")
+	errCodeRedeemed = errors.New("兑换码已被使用")
+	errCodeRevoked  = errors.New("兑换码已作废")
+	errInvalidBatch = errors.New("批次ID不合法")
+)
+
+// errNotClaimable 优惠券当前不可领取，消息可直接返回给调用方
+type errNotClaimable struct{ msg string }
+
+func (e *errNotClaimable) Error() string { return e.msg }
+
+// GenerateCodes 批量生成兑换码，按生成数量预占优惠券库存，保证每个兑换码都能兑换
+func (s *CouponService) GenerateCodes(ctx context.Context, req *coupon.GenerateCodesReq) (*coupon.GenerateCodesResp, error) {
+	resp := &coupon.GenerateCodesResp{}
+	if req.Count <= 0 || req.Count > maxCodesPerBatch {
+		resp.Base = fail(constant.CodeParamError, fmt.Sprintf("生成数量必须在1到%d之间", maxCodesPerBatch))
+		return resp, nil
+	}
+	name := strings.TrimSpace(req.BatchName)
+	if name == "" {
+		resp.Base = fail(constant.CodeParamError, "批次名称不能为空")
+		return resp, nil
+	}
+	c, err := getCoupon(ctx, req.CouponId)
+	if err != nil {
+		resp.Base = couponErrResp(err)
+		return resp, nil
+	}
+	if msg := checkClaimable(c, time.Now()); msg != "" {
+		resp.Base = fail(constant.CodeConflict, msg)
+		return resp, nil
+	}
+
+	batch := &model.CouponCodeBatch{
+		CouponID:    c.ID,
+		BatchName:   name,
+		TotalCount:  uint32(req.Count),
+		BatchStatus: constant.BatchStatusActive,
+	}
+	err = db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Coupon{}).
+			Where("id = ? AND stock >= ? AND coupon_status = ?", c.ID, req.Count, constant.CouponStatusValid).
+			Update("stock", gorm.Expr("stock - ?", req.Count))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errCouponStockOut
+		}
+		if err := tx.Create(batch).Error; err != nil {
+			return err
+		}
+		return insertCodes(tx, batch, int(req.Count))
+	})
+	if err != nil {
+		if errors.Is(err, errCouponStockOut) {
+			resp.Base = fail(constant.CodeConflict, "优惠券库存不足")
+			return resp, nil
+		}
+		log.Printf("生成兑换码失败, coupon_id=%d: %v", c.ID, err)
+		resp.Base = fail(constant.CodeServerError, "生成兑换码失败")
+		return resp, nil
+	}
+	adjustStockCache(ctx, c.ID, -int64(req.Count))
+	resp.BatchId = int64(batch.ID)
+	resp.Base = success("生成成功")
+	return resp, nil
+}
+
+// insertCodes 分块插入兑换码，唯一索引冲突的跳过并补生成，直到数量达标
+func insertCodes(tx *gorm.DB, batch *model.CouponCodeBatch, count int) error {
+	inserted := 0
+	for round := 0; inserted < count; round++ {
+		if round > codeGenMaxRetries+count/codeInsertChunk {
+			return errors.New("兑换码冲突过多")
+		}
+		n := count - inserted
+		if n > codeInsertChunk {
+			n = codeInsertChunk
+		}
+		codes := make([]model.CouponCode, 0, n)
+		for i := 0; i < n; i++ {
+			code, err := couponcode.Generate()
+			if err != nil {
+				return err
+			}
+			codes = append(codes, model.CouponCode{
+				BatchID:    batch.ID,
+				CouponID:   batch.CouponID,
+				Code:       code,
+				CodeStatus: constant.CodeStatusUnused,
+			})
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&codes)
+		if result.Error != nil {
+			return result.Error
+		}
+		inserted += int(result.RowsAffected)
+	}
+	return nil
+}
+
+// RedeemCode 兑换码兑换为用户优惠券，同一兑换码只能成功兑换一次
+func (s *CouponService) RedeemCode(ctx context.Context, req *coupon.RedeemCodeReq) (*coupon.RedeemCodeResp, error) {
+	resp := &coupon.RedeemCodeResp{}
+	if req.UserId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID不合法")
+		return resp, nil
+	}
+	code, err := couponcode.Normalize(req.Code)
+	if err != nil {
+		resp.Base = fail(constant.CodeParamError, err.Error())
+		return resp, nil
+	}
+	userID := uint64(req.UserId)
+	allowed, err := checkAntiBrush(ctx, userID, "")
+	if err != nil {
+		log.Printf("防刷校验失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "兑换失败，请稍后重试")
+		return resp, nil
+	}
+	if !allowed {
+		resp.Base = fail(constant.CodeTooFrequent, "兑换过于频繁，请稍后再试")
+		return resp, nil
+	}
+
+	var uc *model.UserCoupon
+	err = db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var cc model.CouponCode
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Preload("Batch").
+			Where("code = ?", code).First(&cc).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errCodeNotFound
+		}
+		if err != nil {
+			return err
+		}
+		switch {
+		case cc.CodeStatus == constant.CodeStatusRedeemed:
+			return errCodeRedeemed
+		case cc.CodeStatus == constant.CodeStatusRevoked,
+			cc.Batch == nil || cc.Batch.BatchStatus != constant.BatchStatusActive:
+			return errCodeRevoked
+		}
+		var c model.Coupon
+		if err = tx.Where("id = ?", cc.CouponID).First(&c).Error; err != nil {
+			return err
+		}
+		if msg := checkClaimable(&c, time.Now()); msg != "" {
+			return &errNotClaimable{msg}
+		}
+		if err = checkClaimRules(tx, &c, userID); err != nil {
+			return err
+		}
+
+		uc = newUserCoupon(&c, userID)
+		if err = tx.Create(uc).Error; err != nil {
+			return err
+		}
+		result := tx.Model(&model.CouponCode{}).
+			Where("id = ? AND code_status = ?", cc.ID, constant.CodeStatusUnused).
+			Updates(map[string]interface{}{
+				"code_status":    constant.CodeStatusRedeemed,
+				"user_id":        userID,
+				"user_coupon_id": uc.ID,
+				"redeem_time":    time.Now(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errCodeRedeemed
+		}
+		return nil
+	})
+	if err != nil {
+		var nc *errNotClaimable
+		switch {
+		case errors.As(err, &nc):
+			resp.Base = fail(constant.CodeConflict, nc.msg)
+		case errors.Is(err, errCodeNotFound):
+			resp.Base = fail(constant.CodeNotFound, err.Error())
+		case errors.Is(err, errCodeRedeemed), errors.Is(err, errCodeRevoked):
+			resp.Base = fail(constant.CodeConflict, err.Error())
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			resp.Base = fail(constant.CodeNotFound, "优惠券不存在")
+		default:
+			log.Printf("兑换码兑换失败, user_id=%d: %v", userID, err)
+			resp.Base = fail(constant.CodeServerError, "兑换失败，请稍后重试")
+		}
+		return resp, nil
+	}
+	resp.UserCouponId = int64(uc.ID)
+	resp.Base = success("兑换成功")
+	return resp, nil
+}
+
+// ExportCodeBatch 导出批次兑换码为CSV，带BOM便于Excel直接打开
+func (s *CouponService) ExportCodeBatch(ctx context.Context, req *coupon.ExportCodeBatchReq) (*coupon.ExportCodeBatchResp, error) {
+	resp := &coupon.ExportCodeBatchResp{}
+	batch, err := getCodeBatch(ctx, req.BatchId)
+	if err != nil {
+		resp.Base = batchErrResp(err)
+		return resp, nil
+	}
+	var codes []model.CouponCode
+	if err = db.MysqlDB.WithContext(ctx).Where("batch_id = ?", batch.ID).Order("id").Find(&codes).Error; err != nil {
+		log.Printf("查询兑换码失败, batch_id=%d: %v", batch.ID, err)
+		resp.Base = fail(constant.CodeServerError, "导出失败")
+		return resp, nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("\xEF\xBB\xBF")
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"兑换码", "状态", "兑换用户ID", "兑换时间"})
+	for _, cc := range codes {
+		redeemTime := ""
+		if cc.RedeemTime != nil {
+			redeemTime = cc.RedeemTime.Format("2006-01-02 15:04:05")
+		}
+		userID := ""
+		if cc.UserID > 0 {
+			userID = strconv.FormatUint(cc.UserID, 10)
+		}
+		_ = w.Write([]string{couponcode.Format(cc.Code), cc.CodeStatus, userID, redeemTime})
+	}
+	w.Flush()
+	if err = w.Error(); err != nil {
+		log.Printf("生成CSV失败, batch_id=%d: %v", batch.ID, err)
+		resp.Base = fail(constant.CodeServerError, "导出失败")
+		return resp, nil
+	}
+	resp.FileName = fmt.Sprintf("coupon_codes_%d_%s.csv", batch.ID, time.Now().Format("20060102"))
+	resp.CsvContent = buf.Bytes()
+	resp.Base = success("导出成功")
+	return resp, nil
+}
+
+// RevokeCodeBatch 作废批次，未兑换的兑换码置为作废并归还预占的库存
+func (s *CouponService) RevokeCodeBatch(ctx context.Context, req *coupon.RevokeCodeBatchReq) (*coupon.RevokeCodeBatchResp, error) {
+	resp := &coupon.RevokeCodeBatchResp{}
+	batch, err := getCodeBatch(ctx, req.BatchId)
+	if err != nil {
+		resp.Base = batchErrResp(err)
+		return resp, nil
+	}
+	var revoked int64
+	err = db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.CouponCodeBatch{}).
+			Where("id = ? AND batch_status = ?", batch.ID, constant.BatchStatusActive).
+			Update("batch_status", constant.BatchStatusRevoked)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errCodeRevoked
+		}
+		result = tx.Model(&model.CouponCode{}).
+			Where("batch_id = ? AND code_status = ?", batch.ID, constant.CodeStatusUnused).
+			Update("code_status", constant.CodeStatusRevoked)
+		if result.Error != nil {
+			return result.Error
+		}
+		revoked = result.RowsAffected
+		if revoked == 0 {
+			return nil
+		}
+		return tx.Model(&model.Coupon{}).Where("id = ?", batch.CouponID).
+			Update("stock", gorm.Expr("stock + ?", revoked)).Error
+	})
+	if err != nil {
+		if errors.Is(err, errCodeRevoked) {
+			resp.Base = fail(constant.CodeConflict, "批次已作废")
+			return resp, nil
+		}
+		log.Printf("作废兑换码批次失败, batch_id=%d: %v", batch.ID, err)
+		resp.Base = fail(constant.CodeServerError, "作废失败")
+		return resp, nil
+	}
+	adjustStockCache(ctx, batch.CouponID, revoked)
+	resp.RevokedCount = int32(revoked)
+	resp.Base = success("作废成功")
+	return resp, nil
+}
+
+// getCodeBatch 按ID查询兑换码批次
+func getCodeBatch(ctx context.Context, id int64) (*model.CouponCodeBatch, error) {
+	if id <= 0 {
+		return nil, errInvalidBatch
+	}
+	var batch model.CouponCodeBatch
+	if err := db.MysqlDB.WithContext(ctx).Where("id = ?", id).First(&batch).Error; err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
+
+// batchErrResp 将查询批次的错误转换为响应
+func batchErrResp(err error) *coupon.BaseResp {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fail(constant.CodeNotFound, "批次不存在")
+	}
+	if errors.Is(err, errInvalidBatch) {
+		return fail(constant.CodeParamError, err.Error())
+	}
+	log.Printf("查询兑换码批次失败: %v", err)
+	return fail(constant.CodeServerError, "查询批次失败")
+}
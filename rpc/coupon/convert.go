@@ -0,0 +1,37 @@
+package coupon
+
+import (
+	"example_shop/common/model"
+	"example_shop/kitex_gen/coupon"
+)
+
+// toCouponInfo 将数据库模型转换为RPC响应结构
+func toCouponInfo(c *model.Coupon) *coupon.CouponInfo {
+	info := &coupon.CouponInfo{
+		Id:             int64(c.ID),
+		CouponName:     c.CouponName,
+		CouponType:     c.CouponType,
+		Denomination:   c.Denomination,
+		MinUseAmount:   c.MinUseAmount,
+		ValidStartTime: c.ValidStartTime.Unix(),
+		ValidEndTime:   c.ValidEndTime.Unix(),
+		Stock:          int32(c.Stock),
+		CouponStatus:   c.CouponStatus,
+		CreatedAt:      c.CreatedAt.Unix(),
+		UpdatedAt:      c.UpdatedAt.Unix(),
+		IssuerType:     c.IssuerType,
+		MerchantId:     int64(c.MerchantID),
+		StackGroup:     c.StackGroup,
+		Priority:       c.Priority,
+	}
+	if len(c.Scopes) > 0 {
+		info.Scopes = toScopeInfos(c.Scopes)
+		info.ApplySpotIds = scopeSpotIDs(c.Scopes)
+	} else if c.ApplySpotIDs != nil {
+		info.ApplySpotIds = *c.ApplySpotIDs
+	}
+	if c.ExtFields != nil {
+		info.ExtFields = c.ExtFields.String()
+	}
+	return info
+}
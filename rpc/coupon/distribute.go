@@ -0,0 +1,202 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/segment"
+	"example_shop/kitex_gen/coupon"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+var errInvalidDistribution = errors.New("发券任务ID不合法")
+
+// CreateSegment 创建用户分群，规则非法时拒绝
+func (s *CouponService) CreateSegment(ctx context.Context, req *coupon.CreateSegmentReq) (*coupon.CreateSegmentResp, error) {
+	resp := &coupon.CreateSegmentResp{}
+	name := strings.TrimSpace(req.SegmentName)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		resp.Base = fail(constant.CodeParamError, "分群名称不能为空且不能超过100个字符")
+		return resp, nil
+	}
+	rule, err := segment.Parse([]byte(req.Rule))
+	if err != nil {
+		resp.Base = fail(constant.CodeParamError, err.Error())
+		return resp, nil
+	}
+	seg := &model.CouponSegment{SegmentName: name, Rule: model.JSON(req.Rule)}
+	if remark := strings.TrimSpace(req.Remark); remark != "" {
+		seg.Remark = &remark
+	}
+	if err = db.MysqlDB.WithContext(ctx).Create(seg).Error; err != nil {
+		log.Printf("创建用户分群失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "创建用户分群失败")
+		return resp, nil
+	}
+	resp.SegmentId = int64(seg.ID)
+	if resp.EstimatedUsers, err = countSegment(ctx, rule); err != nil {
+		log.Printf("统计分群用户数失败, segment_id=%d: %v", seg.ID, err)
+	}
+	resp.Base = success("创建成功")
+	return resp, nil
+}
+
+// DistributeCoupon 创建定向发券任务，由后台任务按页异步发放
+func (s *CouponService) DistributeCoupon(ctx context.Context, req *coupon.DistributeCouponReq) (*coupon.DistributeCouponResp, error) {
+	resp := &coupon.DistributeCouponResp{}
+	c, err := getCoupon(ctx, req.CouponId)
+	if err != nil {
+		resp.Base = couponErrResp(err)
+		return resp, nil
+	}
+	if reason := checkClaimable(c, time.Now()); reason != "" {
+		resp.Base = fail(constant.CodeConflict, reason)
+		return resp, nil
+	}
+	if c.Stock == 0 {
+		resp.Base = fail(constant.CodeConflict, "优惠券库存不足")
+		return resp, nil
+	}
+	if req.SegmentId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "分群ID不合法")
+		return resp, nil
+	}
+	var seg model.CouponSegment
+	if err = db.MysqlDB.WithContext(ctx).Where("id = ?", req.SegmentId).First(&seg).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.Base = fail(constant.CodeNotFound, "用户分群不存在")
+			return resp, nil
+		}
+		log.Printf("查询用户分群失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询用户分群失败")
+		return resp, nil
+	}
+	rule, err := segment.Parse(seg.Rule)
+	if err != nil {
+		resp.Base = fail(constant.CodeConflict, err.Error())
+		return resp, nil
+	}
+	estimated, err := countSegment(ctx, rule)
+	if err != nil {
+		log.Printf("统计分群用户数失败, segment_id=%d: %v", seg.ID, err)
+	}
+
+	d := &model.CouponDistribution{
+		CouponID:       c.ID,
+		SegmentID:      seg.ID,
+		DistStatus:     constant.DistStatusPending,
+		EstimatedUsers: uint32(estimated),
+		Operator:       strings.TrimSpace(req.Operator),
+	}
+	if err = db.MysqlDB.WithContext(ctx).Create(d).Error; err != nil {
+		log.Printf("创建发券任务失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "创建发券任务失败")
+		return resp, nil
+	}
+	resp.DistributionId = int64(d.ID)
+	resp.EstimatedUsers = estimated
+	resp.Base = success("发券任务已创建")
+	return resp, nil
+}
+
+// GetDistribution 查询定向发券任务进度
+func (s *CouponService) GetDistribution(ctx context.Context, req *coupon.GetDistributionReq) (*coupon.GetDistributionResp, error) {
+	resp := &coupon.GetDistributionResp{}
+	d, err := getDistribution(ctx, req.DistributionId)
+	if err != nil {
+		resp.Base = distributionErrResp(err)
+		return resp, nil
+	}
+	resp.Distribution = toDistributionInfo(d)
+	resp.Base = success("查询成功")
+	return resp, nil
+}
+
+// ResumeDistribution 将失败的发券任务重新置为执行中，从游标处继续发放
+func (s *CouponService) ResumeDistribution(ctx context.Context, req *coupon.ResumeDistributionReq) (*coupon.ResumeDistributionResp, error) {
+	resp := &coupon.ResumeDistributionResp{}
+	if _, err := getDistribution(ctx, req.DistributionId); err != nil {
+		resp.Base = distributionErrResp(err)
+		return resp, nil
+	}
+	result := db.MysqlDB.WithContext(ctx).Model(&model.CouponDistribution{}).
+		Where("id = ? AND dist_status = ?", req.DistributionId, constant.DistStatusFailed).
+		Updates(map[string]interface{}{
+			"dist_status": constant.DistStatusRunning,
+			"fail_count":  0,
+			"finished_at": nil,
+		})
+	if result.Error != nil {
+		log.Printf("恢复发券任务失败: %v", result.Error)
+		resp.Base = fail(constant.CodeServerError, "恢复发券任务失败")
+		return resp, nil
+	}
+	if result.RowsAffected == 0 {
+		resp.Base = fail(constant.CodeConflict, "只有失败的发券任务可以恢复")
+		return resp, nil
+	}
+	resp.Base = success("发券任务已恢复")
+	return resp, nil
+}
+
+// countSegment 统计分群当前命中的用户数
+func countSegment(ctx context.Context, rule *segment.Rule) (int64, error) {
+	var n int64
+	err := rule.Apply(db.MysqlDB.WithContext(ctx).Model(&model.SysUser{}), time.Now()).Count(&n).Error
+	return n, err
+}
+
+// getDistribution 按ID查询发券任务
+func getDistribution(ctx context.Context, id int64) (*model.CouponDistribution, error) {
+	if id <= 0 {
+		return nil, errInvalidDistribution
+	}
+	var d model.CouponDistribution
+	if err := db.MysqlDB.WithContext(ctx).Where("id = ?", id).First(&d).Error; err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// distributionErrResp 将查询发券任务的错误转换为响应
+func distributionErrResp(err error) *coupon.BaseResp {
+	switch {
+	case errors.Is(err, errInvalidDistribution):
+		return fail(constant.CodeParamError, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fail(constant.CodeNotFound, "发券任务不存在")
+	default:
+		log.Printf("查询发券任务失败: %v", err)
+		return fail(constant.CodeServerError, "查询发券任务失败")
+	}
+}
+
+// toDistributionInfo 转换发券任务进度
+func toDistributionInfo(d *model.CouponDistribution) *coupon.DistributionInfo {
+	info := &coupon.DistributionInfo{
+		Id:             int64(d.ID),
+		CouponId:       int64(d.CouponID),
+		SegmentId:      int64(d.SegmentID),
+		DistStatus:     d.DistStatus,
+		EstimatedUsers: int64(d.EstimatedUsers),
+		MatchedCount:   int64(d.MatchedCount),
+		IssuedCount:    int64(d.IssuedCount),
+		SkippedCount:   int64(d.SkippedCount),
+		LastUserId:     int64(d.LastUserID),
+		CreatedAt:      d.CreatedAt.Unix(),
+	}
+	if d.LastError != nil {
+		info.LastError = *d.LastError
+	}
+	if d.FinishedAt != nil {
+		info.FinishedAt = d.FinishedAt.Unix()
+	}
+	return info
+}
@@ -0,0 +1,304 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/segment"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	distributeInterval     = 10 * time.Second // 发券任务轮询间隔
+	distributePageSize     = 500              // 每页解析的用户数
+	distributePagesPerTick = 20               // 每轮每个任务最多处理的页数，避免单个大任务长期占用
+	distributeMaxFails     = 5                // 连续失败达到该次数后任务置为失败，需人工恢复
+)
+
+// errDistributionStop 任务因业务原因终止，如优惠券失效，不计入失败重试
+type errDistributionStop struct{ reason string }
+
+func (e *errDistributionStop) Error() string { return e.reason }
+
+// runDistributions 推进待执行与执行中的发券任务
+func runDistributions(ctx context.Context) error {
+	var list []model.CouponDistribution
+	err := db.MysqlDB.WithContext(ctx).Preload("Segment").
+		Where("dist_status IN ?", []string{constant.DistStatusPending, constant.DistStatusRunning}).
+		Order("id").Find(&list).Error
+	if err != nil {
+		return err
+	}
+	for i := range list {
+		if ctx.Err() != nil {
+			break
+		}
+		runDistribution(ctx, &list[i])
+	}
+	return nil
+}
+
+// runDistribution 按页推进单个任务，单页失败时记录错误并在下一轮重试
+func runDistribution(ctx context.Context, d *model.CouponDistribution) {
+	if d.Segment == nil {
+		finishDistribution(ctx, d.ID, constant.DistStatusFailed, "用户分群不存在")
+		return
+	}
+	rule, err := segment.Parse(d.Segment.Rule)
+	if err != nil {
+		finishDistribution(ctx, d.ID, constant.DistStatusFailed, err.Error())
+		return
+	}
+	for page := 0; page < distributePagesPerTick && ctx.Err() == nil; page++ {
+		status, issued, err := distributePage(ctx, d, rule)
+		if err != nil {
+			var stop *errDistributionStop
+			if errors.As(err, &stop) {
+				finishDistribution(ctx, d.ID, constant.DistStatusFailed, stop.reason)
+				return
+			}
+			recordDistributionError(ctx, d, err)
+			return
+		}
+		if len(issued) > 0 {
+			adjustStockCache(ctx, d.CouponID, -int64(len(issued)))
+			clearUserClaimCache(ctx, d.CouponID, issued)
+		}
+		if status != constant.DistStatusRunning {
+			log.Printf("[job] 定向发券任务%d结束: %s, 发放%d张", d.ID, status, d.IssuedCount)
+			return
+		}
+	}
+}
+
+// distributePage 在一个事务内解析下一页用户、扣减库存、发放优惠券并推进游标，返回任务状态与本页获券用户
+func distributePage(ctx context.Context, d *model.CouponDistribution, rule *segment.Rule) (string, []uint64, error) {
+	var status string
+	var issued []uint64
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var c model.Coupon
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", d.CouponID).First(&c).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &errDistributionStop{"优惠券不存在"}
+			}
+			return err
+		}
+		now := time.Now()
+		if reason := checkClaimable(&c, now); reason != "" {
+			return &errDistributionStop{reason}
+		}
+
+		var userIDs []uint64
+		err := rule.Apply(tx.Model(&model.SysUser{}), now).
+			Where("sys_user.id > ?", d.LastUserID).
+			Order("sys_user.id").Limit(distributePageSize).Pluck("sys_user.id", &userIDs).Error
+		if err != nil {
+			return err
+		}
+		eligible, err := underUserLimit(tx, &c, userIDs)
+		if err != nil {
+			return err
+		}
+
+		next := *d
+		next.DistStatus = constant.DistStatusRunning
+		next.FailCount = 0
+		next.LastError = nil
+		issueCount := len(eligible)
+		if uint32(issueCount) > c.Stock {
+			issueCount = int(c.Stock)
+		}
+		processed := userIDs
+		if issueCount < len(eligible) {
+			// 库存不足：游标只推进到最后一个获券用户，其后的用户不计入已处理
+			processed = nil
+			if issueCount > 0 {
+				processed = usersThrough(userIDs, eligible[issueCount-1])
+			}
+			next.DistStatus = constant.DistStatusStockOut
+		} else if len(userIDs) < distributePageSize {
+			next.DistStatus = constant.DistStatusCompleted
+		}
+
+		if issueCount > 0 {
+			issued = eligible[:issueCount]
+			list := make([]*model.UserCoupon, 0, issueCount)
+			for _, uid := range issued {
+				list = append(list, newUserCoupon(&c, uid))
+			}
+			if err = tx.Create(&list).Error; err != nil {
+				return err
+			}
+			result := tx.Model(&model.Coupon{}).Where("id = ? AND stock >= ?", c.ID, issueCount).
+				Update("stock", gorm.Expr("stock - ?", issueCount))
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return errCouponStockOut
+			}
+		}
+
+		if len(processed) > 0 {
+			next.LastUserID = processed[len(processed)-1]
+		}
+		next.MatchedCount += uint32(len(processed))
+		next.IssuedCount += uint32(issueCount)
+		next.SkippedCount += uint32(countSkipped(processed, eligible))
+		updates := map[string]interface{}{
+			"dist_status":   next.DistStatus,
+			"last_user_id":  next.LastUserID,
+			"matched_count": next.MatchedCount,
+			"issued_count":  next.IssuedCount,
+			"skipped_count": next.SkippedCount,
+			"fail_count":    0,
+			"last_error":    nil,
+		}
+		if next.DistStatus != constant.DistStatusRunning {
+			updates["finished_at"] = now
+		}
+		// 以游标为条件更新，防止同一页被重复处理
+		result := tx.Model(&model.CouponDistribution{}).
+			Where("id = ? AND last_user_id = ? AND dist_status IN ?", d.ID, d.LastUserID,
+				[]string{constant.DistStatusPending, constant.DistStatusRunning}).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("发券任务%d游标已变化", d.ID)
+		}
+		*d = next
+		status = next.DistStatus
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return status, issued, nil
+}
+
+// underUserLimit 过滤出领取数量未达每人限领且满足新用户限制的用户，保持原顺序
+func underUserLimit(tx *gorm.DB, c *model.Coupon, userIDs []uint64) ([]uint64, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	ext, err := c.Ext()
+	if err != nil {
+		return nil, &errDistributionStop{"优惠券扩展规则格式错误"}
+	}
+	limit := int64(perUserLimit(ext))
+	var rows []struct {
+		UserID uint64
+		Cnt    int64
+	}
+	err = tx.Model(&model.UserCoupon{}).Select("user_id, COUNT(*) AS cnt").
+		Where("coupon_id = ? AND user_id IN ?", c.ID, userIDs).Group("user_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	held := make(map[uint64]int64, len(rows))
+	for _, r := range rows {
+		held[r.UserID] = r.Cnt
+	}
+	// 仅限新用户的券跳过已有支付订单的用户
+	if ext.NewUserOnly {
+		var paid []uint64
+		err = tx.Model(&model.OrderMain{}).Distinct("user_id").
+			Where("user_id IN ? AND pay_time IS NOT NULL", userIDs).Pluck("user_id", &paid).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, uid := range paid {
+			held[uid] = limit
+		}
+	}
+	eligible := make([]uint64, 0, len(userIDs))
+	for _, uid := range userIDs {
+		if held[uid] < limit {
+			eligible = append(eligible, uid)
+		}
+	}
+	return eligible, nil
+}
+
+// usersThrough 返回 userIDs 中截至 last（含）的前缀
+func usersThrough(userIDs []uint64, last uint64) []uint64 {
+	for i, uid := range userIDs {
+		if uid == last {
+			return userIDs[:i+1]
+		}
+	}
+	return userIDs
+}
+
+// countSkipped 统计已处理用户中因限领或新用户限制被跳过的人数
+func countSkipped(processed, eligible []uint64) int {
+	set := make(map[uint64]struct{}, len(eligible))
+	for _, uid := range eligible {
+		set[uid] = struct{}{}
+	}
+	n := 0
+	for _, uid := range processed {
+		if _, ok := set[uid]; !ok {
+			n++
+		}
+	}
+	return n
+}
+
+// recordDistributionError 记录单页失败，连续失败达到上限后任务置为失败
+func recordDistributionError(ctx context.Context, d *model.CouponDistribution, cause error) {
+	log.Printf("[job] 定向发券任务%d处理失败: %v", d.ID, cause)
+	msg := []rune(cause.Error())
+	if len(msg) > 500 {
+		msg = msg[:500]
+	}
+	updates := map[string]interface{}{
+		"fail_count": gorm.Expr("fail_count + 1"),
+		"last_error": string(msg),
+	}
+	if int(d.FailCount)+1 >= distributeMaxFails {
+		updates["dist_status"] = constant.DistStatusFailed
+		updates["finished_at"] = time.Now()
+	}
+	err := db.MysqlDB.WithContext(ctx).Model(&model.CouponDistribution{}).
+		Where("id = ? AND dist_status IN ?", d.ID, []string{constant.DistStatusPending, constant.DistStatusRunning}).
+		Updates(updates).Error
+	if err != nil {
+		log.Printf("记录发券任务失败原因失败, id=%d: %v", d.ID, err)
+	}
+}
+
+// finishDistribution 终止任务
+func finishDistribution(ctx context.Context, id uint64, status, reason string) {
+	log.Printf("[job] 定向发券任务%d终止: %s", id, reason)
+	err := db.MysqlDB.WithContext(ctx).Model(&model.CouponDistribution{}).
+		Where("id = ? AND dist_status IN ?", id, []string{constant.DistStatusPending, constant.DistStatusRunning}).
+		Updates(map[string]interface{}{
+			"dist_status": status,
+			"last_error":  reason,
+			"finished_at": time.Now(),
+		}).Error
+	if err != nil {
+		log.Printf("更新发券任务状态失败, id=%d: %v", id, err)
+	}
+}
+
+// clearUserClaimCache 删除用户已领张数缓存，下次领取时从MySQL重新预热
+func clearUserClaimCache(ctx context.Context, couponID uint64, userIDs []uint64) {
+	keys := make([]string, 0, len(userIDs))
+	for _, uid := range userIDs {
+		keys = append(keys, fmt.Sprintf(constant.RedisKeyCouponUserClaim, couponID, uid))
+	}
+	if err := db.Rdb.Del(ctx, keys...).Err(); err != nil {
+		log.Printf("删除用户领取数缓存失败, coupon_id=%d: %v", couponID, err)
+	}
+}
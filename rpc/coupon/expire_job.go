@@ -0,0 +1,104 @@
+package coupon
+
+import (
+	"context"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"log"
+	"time"
+)
+
+const (
+	expireJobInterval  = time.Minute           // 过期清理执行间隔
+	expireBatchSize    = 500                   // 每批处理行数
+	expireMaxBatches   = 200                   // 单次执行最多处理的批数，剩余的下次继续
+	expireBatchBreathe = 50 * time.Millisecond // 批次间隔，避免长时间占用数据库
+)
+
+// expireSummary 过期清理结果汇总
+type expireSummary struct {
+	UserCoupons int64 // 置为 EXPIRED 的用户优惠券数
+	Coupons     int64 // 置为 INVALID 的优惠券数
+}
+
+// runExpireSweep 将已过有效期的未使用用户优惠券置为过期，已过有效期的优惠券置为失效
+func runExpireSweep(ctx context.Context) error {
+	start := time.Now()
+	now := start
+	var sum expireSummary
+	var err error
+	if sum.UserCoupons, err = expireUserCoupons(ctx, now); err != nil {
+		return err
+	}
+	if sum.Coupons, err = invalidateCoupons(ctx, now); err != nil {
+		return err
+	}
+	log.Printf("[job] 优惠券过期清理完成: user_coupon置为EXPIRED %d 条, coupon置为INVALID %d 条, 耗时%s",
+		sum.UserCoupons, sum.Coupons, time.Since(start))
+	return nil
+}
+
+// expireUserCoupons 分批更新过期的未使用用户优惠券，返回更新行数
+func expireUserCoupons(ctx context.Context, now time.Time) (int64, error) {
+	var total int64
+	for i := 0; i < expireMaxBatches && ctx.Err() == nil; i++ {
+		var ids []uint64
+		err := db.MysqlDB.WithContext(ctx).Model(&model.UserCoupon{}).
+			Where("use_status = ? AND valid_end_time <= ?", constant.UseStatusUnused, now).
+			Order("id").Limit(expireBatchSize).Pluck("id", &ids).Error
+		if err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		result := db.MysqlDB.WithContext(ctx).Model(&model.UserCoupon{}).
+			Where("id IN ? AND use_status = ?", ids, constant.UseStatusUnused).
+			Update("use_status", constant.UseStatusExpired)
+		if result.Error != nil {
+			return total, result.Error
+		}
+		total += result.RowsAffected
+		if result.RowsAffected > 0 {
+			bumpSpotCouponsVersion(ctx)
+		}
+		if len(ids) < expireBatchSize {
+			break
+		}
+		time.Sleep(expireBatchBreathe)
+	}
+	return total, nil
+}
+
+// invalidateCoupons 分批更新过期的有效优惠券，每批更新后递增景点优惠券缓存版本，返回更新行数
+func invalidateCoupons(ctx context.Context, now time.Time) (int64, error) {
+	var total int64
+	for i := 0; i < expireMaxBatches && ctx.Err() == nil; i++ {
+		var ids []uint64
+		err := db.MysqlDB.WithContext(ctx).Model(&model.Coupon{}).
+			Where("coupon_status = ? AND valid_end_time <= ?", constant.CouponStatusValid, now).
+			Order("id").Limit(expireBatchSize).Pluck("id", &ids).Error
+		if err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		result := db.MysqlDB.WithContext(ctx).Model(&model.Coupon{}).
+			Where("id IN ? AND coupon_status = ?", ids, constant.CouponStatusValid).
+			Update("coupon_status", constant.CouponStatusInvalid)
+		if result.Error != nil {
+			return total, result.Error
+		}
+		total += result.RowsAffected
+		if result.RowsAffected > 0 {
+			bumpSpotCouponsVersion(ctx)
+		}
+		if len(ids) < expireBatchSize {
+			break
+		}
+		time.Sleep(expireBatchBreathe)
+	}
+	return total, nil
+}
@@ -0,0 +1,398 @@
+package coupon
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/kitex_gen/coupon"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	claimBatchSize   = 200              // 每批落库的领取记录数
+	claimBlockTime   = 2 * time.Second  // 消费者阻塞等待新消息的时长
+	claimIdleTimeout = time.Minute      // 超过该时长未确认的消息由其他消费者接管
+	claimRetryDelay  = time.Second      // 落库失败后重试间隔
+	claimTokenTTL    = 24 * time.Hour   // 领取结果保留时长
+	claimStreamMax   = int64(1_000_000) // 消息流最大长度（近似裁剪）
+)
+
+// flashClaimScript 秒杀领取：原子扣减库存、累加用户已领取张数、写入消息流并记录领取凭证
+// KEYS[1]=库存key，KEYS[2]=用户已领取张数key，KEYS[3]=消息流key，KEYS[4]=领取凭证key
+// ARGV[1]=每人限领张数，ARGV[2]=领取凭证，ARGV[3]=用户ID，ARGV[4]=优惠券ID，ARGV[5]=凭证过期秒数，ARGV[6]=消息流最大长度
+var flashClaimScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+if redis.call('EXISTS', KEYS[2]) == 0 then
+	return -2
+end
+if tonumber(redis.call('GET', KEYS[2])) >= tonumber(ARGV[1]) then
+	return -3
+end
+if tonumber(redis.call('GET', KEYS[1])) <= 0 then
+	return -4
+end
+redis.call('DECR', KEYS[1])
+redis.call('INCR', KEYS[2])
+redis.call('XADD', KEYS[3], 'MAXLEN', '~', ARGV[6], '*', 'token', ARGV[2], 'user_id', ARGV[3], 'coupon_id', ARGV[4])
+redis.call('HSET', KEYS[4], 'status', 'PENDING')
+redis.call('EXPIRE', KEYS[4], ARGV[5])
+return 1
+`)
+
+// failClaimScript 标记领取失败并回补库存与用户已领取张数，已标记失败的凭证跳过，保证重放不重复回补
+// KEYS[1]=领取凭证key，KEYS[2]=库存key，KEYS[3]=用户已领取张数key；ARGV[1]=失败状态，ARGV[2]=凭证过期秒数
+var failClaimScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'status') == ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'status', ARGV[1])
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+if redis.call('EXISTS', KEYS[2]) == 1 then
+	redis.call('INCR', KEYS[2])
+end
+if redis.call('EXISTS', KEYS[3]) == 1 and tonumber(redis.call('GET', KEYS[3])) > 0 then
+	redis.call('DECR', KEYS[3])
+end
+return 1
+`)
+
+// flashClaim 在Redis中完成秒杀领取判定，成功时返回领取凭证
+func flashClaim(ctx context.Context, c *model.Coupon, userID uint64, quota int) (int, string, error) {
+	token, err := newClaimToken()
+	if err != nil {
+		return 0, "", err
+	}
+	stockKey := fmt.Sprintf(constant.RedisKeyCouponStock, c.ID)
+	userKey := fmt.Sprintf(constant.RedisKeyCouponUserClaim, c.ID, userID)
+	keys := []string{stockKey, userKey, constant.RedisKeyClaimStream, fmt.Sprintf(constant.RedisKeyClaimToken, token)}
+	for i := 0; i < claimWarmRetries; i++ {
+		code, err := flashClaimScript.Run(ctx, db.Rdb, keys,
+			quota, token, userID, c.ID, int64(claimTokenTTL/time.Second), claimStreamMax).Int()
+		if err != nil {
+			return 0, "", err
+		}
+		switch code {
+		case claimStockMiss:
+			err = warmStock(ctx, c, stockKey)
+		case claimUserMiss:
+			err = warmUserClaim(ctx, c, userID, userKey)
+		case claimOK:
+			return code, token, nil
+		default:
+			return code, "", nil
+		}
+		if err != nil {
+			return 0, "", err
+		}
+	}
+	return 0, "", errors.New("库存缓存预热失败")
+}
+
+// newClaimToken 生成随机领取凭证
+func newClaimToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// GetClaimStatus 查询秒杀领取结果，Redis中结果已过期时回查MySQL
+func (s *CouponService) GetClaimStatus(ctx context.Context, req *coupon.GetClaimStatusReq) (*coupon.GetClaimStatusResp, error) {
+	resp := &coupon.GetClaimStatusResp{}
+	token := strings.TrimSpace(req.ClaimToken)
+	if token == "" {
+		resp.Base = fail(constant.CodeParamError, "领取凭证不能为空")
+		return resp, nil
+	}
+	result, err := db.Rdb.HGetAll(ctx, fmt.Sprintf(constant.RedisKeyClaimToken, token)).Result()
+	if err != nil {
+		log.Printf("查询领取结果失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询失败")
+		return resp, nil
+	}
+	if status := result["status"]; status != "" {
+		resp.ClaimStatus = status
+		resp.UserCouponId, _ = strconv.ParseInt(result["user_coupon_id"], 10, 64)
+		resp.Base = success("查询成功")
+		return resp, nil
+	}
+
+	var uc model.UserCoupon
+	err = db.MysqlDB.WithContext(ctx).Select("id").Where("claim_token = ?", token).First(&uc).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		resp.Base = fail(constant.CodeNotFound, "领取凭证不存在或已过期")
+		return resp, nil
+	}
+	if err != nil {
+		log.Printf("查询领取结果失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询失败")
+		return resp, nil
+	}
+	resp.ClaimStatus = constant.ClaimStatusSuccess
+	resp.UserCouponId = int64(uc.ID)
+	resp.Base = success("查询成功")
+	return resp, nil
+}
+
+// flashClaimMsg 消息流中的一条秒杀领取记录
+type flashClaimMsg struct {
+	streamID string
+	token    string
+	userID   uint64
+	couponID uint64
+}
+
+// StartClaimConsumer 启动秒杀领取消费者，批量写入user_coupon，ctx取消后退出
+// 启动时先重放本消费者未确认的消息，并定期接管其他消费者超时未确认的消息，保证领取记录不丢失
+func StartClaimConsumer(ctx context.Context) {
+	err := db.Rdb.XGroupCreateMkStream(ctx, constant.RedisKeyClaimStream, constant.RedisGroupClaimStream, "0").Err()
+	if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+		log.Printf("创建秒杀领取消费组失败: %v", err)
+		return
+	}
+	consumer := consumerName()
+	log.Printf("秒杀领取消费者启动: %s", consumer)
+
+	// "0" 读取本消费者已投递未确认的消息，">" 读取新消息
+	lastID := "0"
+	nextAutoClaim := time.Now()
+	for ctx.Err() == nil {
+		if time.Now().After(nextAutoClaim) {
+			if n := autoClaimIdle(ctx, consumer); n > 0 {
+				lastID = "0"
+			}
+			nextAutoClaim = time.Now().Add(claimIdleTimeout)
+		}
+
+		args := &redis.XReadGroupArgs{
+			Group:    constant.RedisGroupClaimStream,
+			Consumer: consumer,
+			Streams:  []string{constant.RedisKeyClaimStream, lastID},
+			Count:    claimBatchSize,
+		}
+		if lastID == ">" {
+			args.Block = claimBlockTime
+		}
+		streams, err := db.Rdb.XReadGroup(ctx, args).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			if ctx.Err() == nil {
+				log.Printf("读取秒杀领取消息失败: %v", err)
+				time.Sleep(claimRetryDelay)
+			}
+			continue
+		}
+		var msgs []redis.XMessage
+		if len(streams) > 0 {
+			msgs = streams[0].Messages
+		}
+		if len(msgs) == 0 {
+			lastID = ">"
+			continue
+		}
+		if err = persistClaims(ctx, msgs); err != nil {
+			log.Printf("秒杀领取记录落库失败，稍后重试: %v", err)
+			lastID = "0"
+			time.Sleep(claimRetryDelay)
+		}
+	}
+	log.Printf("秒杀领取消费者退出: %s", consumer)
+}
+
+// consumerName 以主机名+进程号区分消费者
+func consumerName() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// autoClaimIdle 接管其他消费者长时间未确认的消息，返回接管数量
+func autoClaimIdle(ctx context.Context, consumer string) int {
+	total := 0
+	start := "0-0"
+	for {
+		msgs, next, err := db.Rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   constant.RedisKeyClaimStream,
+			Group:    constant.RedisGroupClaimStream,
+			Consumer: consumer,
+			MinIdle:  claimIdleTimeout,
+			Start:    start,
+			Count:    claimBatchSize,
+		}).Result()
+		if err != nil {
+			log.Printf("接管超时秒杀领取消息失败: %v", err)
+			return total
+		}
+		total += len(msgs)
+		if next == "0-0" || len(msgs) == 0 {
+			return total
+		}
+		start = next
+	}
+}
+
+// persistClaims 按优惠券分组批量落库，成功或确定失败的消息会被确认删除
+func persistClaims(ctx context.Context, msgs []redis.XMessage) error {
+	groups := make(map[uint64][]flashClaimMsg)
+	var done []string
+	for _, m := range msgs {
+		claim, ok := parseClaimMsg(m)
+		if !ok {
+			log.Printf("秒杀领取消息格式错误，丢弃: %s %v", m.ID, m.Values)
+			done = append(done, m.ID)
+			continue
+		}
+		groups[claim.couponID] = append(groups[claim.couponID], claim)
+	}
+
+	var firstErr error
+	for couponID, claims := range groups {
+		failed, err := persistCouponClaims(ctx, couponID, claims)
+		if len(failed) > 0 {
+			log.Printf("秒杀领取落库失败（库存不足或优惠券已失效）, coupon_id=%d, 失败数量=%d", couponID, len(failed))
+			failClaims(ctx, failed)
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		for _, claim := range claims {
+			done = append(done, claim.streamID)
+		}
+	}
+	if len(done) > 0 {
+		pipe := db.Rdb.TxPipeline()
+		pipe.XAck(ctx, constant.RedisKeyClaimStream, constant.RedisGroupClaimStream, done...)
+		pipe.XDel(ctx, constant.RedisKeyClaimStream, done...)
+		if _, err := pipe.Exec(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// parseClaimMsg 解析消息流字段
+func parseClaimMsg(m redis.XMessage) (flashClaimMsg, bool) {
+	token, _ := m.Values["token"].(string)
+	userStr, _ := m.Values["user_id"].(string)
+	couponStr, _ := m.Values["coupon_id"].(string)
+	userID, err1 := strconv.ParseUint(userStr, 10, 64)
+	couponID, err2 := strconv.ParseUint(couponStr, 10, 64)
+	if token == "" || err1 != nil || err2 != nil {
+		return flashClaimMsg{}, false
+	}
+	return flashClaimMsg{streamID: m.ID, token: token, userID: userID, couponID: couponID}, true
+}
+
+// persistCouponClaims 同一优惠券的领取记录在一个事务中落库，已落库的凭证跳过，保证重放幂等
+// MySQL库存不足时按消息顺序只落库剩余库存数量，优惠券已失效或已删除时全部不落库，返回未能落库的领取记录
+func persistCouponClaims(ctx context.Context, couponID uint64, claims []flashClaimMsg) ([]flashClaimMsg, error) {
+	tokens := make([]string, 0, len(claims))
+	for _, claim := range claims {
+		tokens = append(tokens, claim.token)
+	}
+
+	var failed []flashClaimMsg
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []string
+		if err := tx.Model(&model.UserCoupon{}).Where("claim_token IN ?", tokens).
+			Pluck("claim_token", &existing).Error; err != nil {
+			return err
+		}
+		saved := make(map[string]struct{}, len(existing))
+		for _, t := range existing {
+			saved[t] = struct{}{}
+		}
+		var pending []flashClaimMsg
+		for _, claim := range claims {
+			if _, ok := saved[claim.token]; !ok {
+				pending = append(pending, claim)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		var c model.Coupon
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", couponID).First(&c).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			failed = pending
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if c.CouponStatus != constant.CouponStatusValid {
+			failed = pending
+			return nil
+		}
+		if n := int(c.Stock); n < len(pending) {
+			pending, failed = pending[:n], pending[n:]
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		rows := make([]*model.UserCoupon, 0, len(pending))
+		for _, claim := range pending {
+			uc := newUserCoupon(&c, claim.userID)
+			token := claim.token
+			uc.ClaimToken = &token
+			rows = append(rows, uc)
+		}
+		err = tx.Model(&model.Coupon{}).Where("id = ?", couponID).
+			Update("stock", gorm.Expr("stock - ?", len(rows))).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&rows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var saved []model.UserCoupon
+	if err = db.MysqlDB.WithContext(ctx).Select("id", "claim_token").
+		Where("claim_token IN ?", tokens).Find(&saved).Error; err != nil {
+		return nil, err
+	}
+	pipe := db.Rdb.Pipeline()
+	for _, uc := range saved {
+		key := fmt.Sprintf(constant.RedisKeyClaimToken, *uc.ClaimToken)
+		pipe.HSet(ctx, key, "status", constant.ClaimStatusSuccess, "user_coupon_id", uc.ID)
+		pipe.Expire(ctx, key, claimTokenTTL)
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		log.Printf("更新秒杀领取结果失败, coupon_id=%d: %v", couponID, err)
+	}
+	return failed, nil
+}
+
+// failClaims 标记领取失败并回补Redis库存与用户已领取张数
+func failClaims(ctx context.Context, claims []flashClaimMsg) {
+	for _, claim := range claims {
+		keys := []string{
+			fmt.Sprintf(constant.RedisKeyClaimToken, claim.token),
+			fmt.Sprintf(constant.RedisKeyCouponStock, claim.couponID),
+			fmt.Sprintf(constant.RedisKeyCouponUserClaim, claim.couponID, claim.userID),
+		}
+		err := failClaimScript.Run(ctx, db.Rdb, keys, constant.ClaimStatusFailed, int64(claimTokenTTL/time.Second)).Err()
+		if err != nil {
+			log.Printf("标记秒杀领取失败出错, token=%s: %v", claim.token, err)
+		}
+	}
+}
@@ -1,11 +1,402 @@
 package coupon
 
 import (
+	"context"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/pricing"
 	"example_shop/kitex_gen/coupon"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// CouponService 优惠券服务，实现 kitex_gen 中定义的 CouponService 接口
 type CouponService struct{}
 
-func (s *CouponService) AddCoupon() (*coupon.BaseResp, error) {
-	return &coupon.BaseResp{Code: 200, Msg: "添加成功"}, nil
+// CreateCoupon 创建优惠券
+func (s *CouponService) CreateCoupon(ctx context.Context, req *coupon.CreateCouponReq) (*coupon.CreateCouponResp, error) {
+	resp := &coupon.CreateCouponResp{}
+	if req.Stock < 0 {
+		resp.Base = fail(constant.CodeParamError, "库存不能小于0")
+		return resp, nil
+	}
+	scopes, err := resolveScopes(ctx, req.Scopes, req.ApplySpotIds)
+	if err != nil {
+		resp.Base = scopeErrResp(err)
+		return resp, nil
+	}
+	c := &model.Coupon{
+		CouponName:     strings.TrimSpace(req.CouponName),
+		CouponType:     req.CouponType,
+		Denomination:   req.Denomination,
+		MinUseAmount:   req.MinUseAmount,
+		ValidStartTime: unixToTime(req.ValidStartTime),
+		ValidEndTime:   unixToTime(req.ValidEndTime),
+		Stock:          uint32(req.Stock),
+		CouponStatus:   constant.CouponStatusValid,
+		ExtFields:      toJSON(req.ExtFields),
+		IssuerType:     strings.TrimSpace(req.IssuerType),
+		MerchantID:     uint64(req.MerchantId),
+		StackGroup:     strings.TrimSpace(req.StackGroup),
+		Priority:       req.Priority,
+	}
+	if req.MerchantId < 0 {
+		resp.Base = fail(constant.CodeParamError, "商家ID不合法")
+		return resp, nil
+	}
+	if c.IssuerType == "" {
+		c.IssuerType = constant.IssuerTypePlatform
+		if c.MerchantID > 0 {
+			c.IssuerType = constant.IssuerTypeMerchant
+		}
+	}
+	if err = validateCoupon(c); err != nil {
+		resp.Base = fail(constant.CodeParamError, err.Error())
+		return resp, nil
+	}
+	if err = checkMerchantScope(ctx, c, scopes); err != nil {
+		resp.Base = scopeErrResp(err)
+		return resp, nil
+	}
+	if !c.ValidEndTime.After(time.Now()) {
+		resp.Base = fail(constant.CodeParamError, "有效期结束时间必须晚于当前时间")
+		return resp, nil
+	}
+	err = db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(c).Error; err != nil {
+			return err
+		}
+		return replaceScopes(tx, c.ID, scopes)
+	})
+	if err != nil {
+		log.Printf("创建优惠券失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "创建优惠券失败")
+		return resp, nil
+	}
+	bumpSpotCouponsVersion(ctx)
+	resp.Base = success("创建成功")
+	resp.CouponId = int64(c.ID)
+	return resp, nil
+}
+
+// UpdateCoupon 修改优惠券，仅更新请求中传入的字段；已有用户领取后不能修改类型、面额、使用门槛与折扣封顶金额
+func (s *CouponService) UpdateCoupon(ctx context.Context, req *coupon.UpdateCouponReq) (*coupon.UpdateCouponResp, error) {
+	resp := &coupon.UpdateCouponResp{}
+	c, err := getCoupon(ctx, req.CouponId)
+	if err != nil {
+		resp.Base = couponErrResp(err)
+		return resp, nil
+	}
+	if err = checkOperator(c, req.OperatorMerchantId); err != nil {
+		resp.Base = scopeErrResp(err)
+		return resp, nil
+	}
+	if c.CouponStatus == constant.CouponStatusInvalid {
+		resp.Base = fail(constant.CodeConflict, "优惠券已失效，不能修改")
+		return resp, nil
+	}
+	oldRule := couponRuleOf(c)
+	if req.CouponName != nil {
+		c.CouponName = strings.TrimSpace(*req.CouponName)
+	}
+	if req.CouponType != nil {
+		c.CouponType = *req.CouponType
+	}
+	if req.Denomination != nil {
+		c.Denomination = *req.Denomination
+	}
+	if req.MinUseAmount != nil {
+		c.MinUseAmount = *req.MinUseAmount
+	}
+	if req.ValidStartTime != nil {
+		c.ValidStartTime = unixToTime(*req.ValidStartTime)
+	}
+	if req.ValidEndTime != nil {
+		c.ValidEndTime = unixToTime(*req.ValidEndTime)
+	}
+	oldStock := c.Stock
+	if req.Stock != nil {
+		if *req.Stock < 0 {
+			resp.Base = fail(constant.CodeParamError, "库存不能小于0")
+			return resp, nil
+		}
+		c.Stock = uint32(*req.Stock)
+	}
+	scopes, scopeChanged := c.Scopes, req.IsSetScopes() || req.ApplySpotIds != nil
+	if scopeChanged {
+		var applySpotIDs string
+		if req.ApplySpotIds != nil {
+			applySpotIDs = *req.ApplySpotIds
+		}
+		if scopes, err = resolveScopes(ctx, req.Scopes, applySpotIDs); err != nil {
+			resp.Base = scopeErrResp(err)
+			return resp, nil
+		}
+		c.ApplySpotIDs = nil
+	}
+	if req.ExtFields != nil {
+		c.ExtFields = toJSON(*req.ExtFields)
+	}
+	if req.StackGroup != nil {
+		c.StackGroup = strings.TrimSpace(*req.StackGroup)
+	}
+	if req.Priority != nil {
+		c.Priority = *req.Priority
+	}
+	if err = validateCoupon(c); err != nil {
+		resp.Base = fail(constant.CodeParamError, err.Error())
+		return resp, nil
+	}
+	if err = checkMerchantScope(ctx, c, scopes); err != nil {
+		resp.Base = scopeErrResp(err)
+		return resp, nil
+	}
+	ruleChanged := couponRuleOf(c) != oldRule
+	err = db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if ruleChanged {
+			if err := checkRuleEditable(tx, c.ID); err != nil {
+				return err
+			}
+		}
+		err := tx.Model(&model.Coupon{}).
+			Where("id = ? AND coupon_status = ?", c.ID, constant.CouponStatusValid).
+			Updates(map[string]interface{}{
+				"coupon_name":      c.CouponName,
+				"coupon_type":      c.CouponType,
+				"denomination":     c.Denomination,
+				"min_use_amount":   c.MinUseAmount,
+				"valid_start_time": c.ValidStartTime,
+				"valid_end_time":   c.ValidEndTime,
+				"stock":            c.Stock,
+				"apply_spot_ids":   c.ApplySpotIDs,
+				"ext_fields":       c.ExtFields,
+				"stack_group":      c.StackGroup,
+				"priority":         c.Priority,
+			}).Error
+		if err != nil || !scopeChanged {
+			return err
+		}
+		return replaceScopes(tx, c.ID, scopes)
+	})
+	if errors.Is(err, errCouponRuleLocked) {
+		resp.Base = fail(constant.CodeConflict, err.Error())
+		return resp, nil
+	}
+	if err != nil {
+		log.Printf("修改优惠券失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "修改优惠券失败")
+		return resp, nil
+	}
+	adjustStockCache(ctx, c.ID, int64(c.Stock)-int64(oldStock))
+	bumpSpotCouponsVersion(ctx)
+	resp.Base = success("修改成功")
+	return resp, nil
+}
+
+// GetCoupon 查询优惠券详情
+func (s *CouponService) GetCoupon(ctx context.Context, req *coupon.GetCouponReq) (*coupon.GetCouponResp, error) {
+	resp := &coupon.GetCouponResp{}
+	c, err := getCoupon(ctx, req.CouponId)
+	if err != nil {
+		resp.Base = couponErrResp(err)
+		return resp, nil
+	}
+	resp.Base = success("查询成功")
+	resp.Coupon = toCouponInfo(c)
+	return resp, nil
+}
+
+// ListCoupons 分页查询优惠券，支持按状态、有效期区间、适用景点、发券方筛选
+func (s *CouponService) ListCoupons(ctx context.Context, req *coupon.ListCouponsReq) (*coupon.ListCouponsResp, error) {
+	resp := &coupon.ListCouponsResp{}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	query := db.MysqlDB.WithContext(ctx).Model(&model.Coupon{})
+	if req.CouponStatus != "" {
+		query = query.Where("coupon_status = ?", req.CouponStatus)
+	}
+	if req.StartTime > 0 {
+		query = query.Where("valid_end_time >= ?", unixToTime(req.StartTime))
+	}
+	if req.EndTime > 0 {
+		query = query.Where("valid_start_time <= ?", unixToTime(req.EndTime))
+	}
+	if req.SpotId > 0 {
+		var spot model.SpotInfo
+		err := db.MysqlDB.WithContext(ctx).Select("id", "merchant_id", "province", "city").
+			Where("id = ?", req.SpotId).First(&spot).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.Coupons = []*coupon.CouponInfo{}
+			resp.Base = success("查询成功")
+			return resp, nil
+		}
+		if err != nil {
+			log.Printf("查询景点失败: %v", err)
+			resp.Base = fail(constant.CodeServerError, "查询优惠券失败")
+			return resp, nil
+		}
+		query = applicableToSpot(query, &spot)
+	}
+	if req.IssuerType != "" {
+		query = query.Where("issuer_type = ?", req.IssuerType)
+	}
+	if req.MerchantId > 0 {
+		query = query.Where("merchant_id = ?", req.MerchantId)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Printf("查询优惠券总数失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询优惠券失败")
+		return resp, nil
+	}
+	var list []model.Coupon
+	err := query.Preload("Scopes").Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error
+	if err != nil {
+		log.Printf("查询优惠券列表失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询优惠券失败")
+		return resp, nil
+	}
+	resp.Coupons = make([]*coupon.CouponInfo, 0, len(list))
+	for i := range list {
+		resp.Coupons = append(resp.Coupons, toCouponInfo(&list[i]))
+	}
+	resp.Total = total
+	resp.Base = success("查询成功")
+	return resp, nil
+}
+
+// InvalidateCoupon 使优惠券失效，失效后不可再领取和修改
+func (s *CouponService) InvalidateCoupon(ctx context.Context, req *coupon.InvalidateCouponReq) (*coupon.InvalidateCouponResp, error) {
+	resp := &coupon.InvalidateCouponResp{}
+	c, err := getCoupon(ctx, req.CouponId)
+	if err != nil {
+		resp.Base = couponErrResp(err)
+		return resp, nil
+	}
+	if err = checkOperator(c, req.OperatorMerchantId); err != nil {
+		resp.Base = scopeErrResp(err)
+		return resp, nil
+	}
+	result := db.MysqlDB.WithContext(ctx).Model(&model.Coupon{}).
+		Where("id = ? AND coupon_status = ?", req.CouponId, constant.CouponStatusValid).
+		Update("coupon_status", constant.CouponStatusInvalid)
+	if result.Error != nil {
+		log.Printf("优惠券失效操作失败: %v", result.Error)
+		resp.Base = fail(constant.CodeServerError, "操作失败")
+		return resp, nil
+	}
+	if result.RowsAffected == 0 {
+		resp.Base = fail(constant.CodeConflict, "优惠券已失效")
+		return resp, nil
+	}
+	bumpSpotCouponsVersion(ctx)
+	resp.Base = success("操作成功")
+	return resp, nil
+}
+
+// getCoupon 按ID查询优惠券及其适用范围
+func getCoupon(ctx context.Context, id int64) (*model.Coupon, error) {
+	if id <= 0 {
+		return nil, errInvalidCouponID
+	}
+	var c model.Coupon
+	if err := db.MysqlDB.WithContext(ctx).Preload("Scopes").Where("id = ?", id).First(&c).Error; err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+var (
+	errInvalidCouponID  = errors.New("优惠券ID不合法")
+	errCouponRuleLocked = errors.New("优惠券已有用户领取，不能修改类型、面额、使用门槛与折扣封顶金额")
+)
+
+// couponRule 决定优惠金额的字段，用户领券后不可修改
+type couponRule struct {
+	couponType   string
+	denomination int64 // 面额（分）或折扣率×100
+	minUseAmount int64
+	maxDiscount  int64
+}
+
+// couponRuleOf 取优惠券的优惠金额相关字段，扩展字段无法解析时按不封顶处理
+func couponRuleOf(c *model.Coupon) couponRule {
+	r := couponRule{
+		couponType:   c.CouponType,
+		denomination: pricing.ToCents(c.Denomination),
+		minUseAmount: pricing.ToCents(c.MinUseAmount),
+	}
+	if ext, err := c.Ext(); err == nil {
+		r.maxDiscount = pricing.ToCents(ext.MaxDiscount)
+	}
+	return r
+}
+
+// checkRuleEditable 锁定优惠券行后确认尚无用户领取，与领券落库互斥
+func checkRuleEditable(tx *gorm.DB, couponID uint64) error {
+	var c model.Coupon
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", couponID).First(&c).Error; err != nil {
+		return err
+	}
+	var claimed int64
+	if err := tx.Model(&model.UserCoupon{}).Unscoped().Where("coupon_id = ?", couponID).Count(&claimed).Error; err != nil {
+		return err
+	}
+	if claimed > 0 {
+		return errCouponRuleLocked
+	}
+	return nil
+}
+
+// couponErrResp 将查询优惠券的错误转换为响应
+func couponErrResp(err error) *coupon.BaseResp {
+	switch {
+	case errors.Is(err, errInvalidCouponID):
+		return fail(constant.CodeParamError, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fail(constant.CodeNotFound, "优惠券不存在")
+	default:
+		log.Printf("查询优惠券失败: %v", err)
+		return fail(constant.CodeServerError, "查询优惠券失败")
+	}
+}
+
+// normalizePage 处理分页参数默认值与上限
+func normalizePage(page, pageSize int32) (int, int) {
+	p, ps := int(page), int(pageSize)
+	if p <= 0 {
+		p = constant.DefaultPage
+	}
+	if ps <= 0 {
+		ps = constant.DefaultPageSize
+	}
+	if ps > constant.MaxPageSize {
+		ps = constant.MaxPageSize
+	}
+	return p, ps
+}
+
+// unixToTime 秒级时间戳转time.Time，0返回零值
+func unixToTime(sec int64) time.Time {
+	if sec <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// toJSON 将字符串转换为JSON字段，空字符串返回nil
+func toJSON(s string) *model.JSON {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	j := model.JSON(s)
+	return &j
 }
@@ -0,0 +1,24 @@
+package coupon
+
+import (
+	"example_shop/common/job"
+	"sort"
+)
+
+// RegisterJobs 注册优惠券服务的后台任务
+func RegisterJobs(s *job.Scheduler) {
+	s.Register(job.Job{Name: "coupon_expire_sweep", Interval: expireJobInterval, Run: runExpireSweep})
+
+	notifiers := buildNotifiers()
+	channels := make([]string, 0, len(notifiers))
+	for ch := range notifiers {
+		channels = append(channels, ch)
+	}
+	sort.Strings(channels)
+	scanner := &remindScanner{channels: channels}
+	sender := &remindSender{notifiers: notifiers}
+	s.Register(job.Job{Name: "coupon_remind_scan", Interval: remindScanInterval, Run: scanner.run})
+	s.Register(job.Job{Name: "coupon_remind_send", Interval: remindSendInterval, Run: sender.run})
+	s.Register(job.Job{Name: "coupon_distribute", Interval: distributeInterval, Run: runDistributions})
+	s.Register(job.Job{Name: "coupon_stats", Interval: statsInterval, Run: runCouponStats})
+}
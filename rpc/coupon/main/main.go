@@ -1,10 +1,12 @@
 package main
 
 import (
-	"context" // 必须导入，Test方法要用到ctx参数
+	"context"
+	"example_shop/common/db"
 	_ "example_shop/common/init"
-	"example_shop/kitex_gen/coupon"
+	"example_shop/common/job"
 	"example_shop/kitex_gen/coupon/couponservice"
+	"example_shop/rpc/coupon"
 
 	"log"
 
@@ -12,29 +14,26 @@ import (
 	"github.com/cloudwego/kitex/server"
 )
 
-// 空结构体（无任何业务逻辑）
-type CouponServiceImpl struct{}
-
-// 【关键】补全Test方法的空实现（解决爆红核心）
-// 方法签名必须和Kitex生成的接口完全一致，一字不差！
-func (s *CouponServiceImpl) Test(ctx context.Context, req *coupon.EmptyReq) (*coupon.BaseResp, error) {
-	// 纯空逻辑：啥都不做，只返回默认成功响应
-	return &coupon.BaseResp{
-		Code: 200,
-		Msg:  "空方法执行成功（无任何业务逻辑）",
-	}, nil
-}
-
 func main() {
-	// 启动空服务
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 秒杀领取记录异步落库
+	go coupon.StartClaimConsumer(ctx)
+
+	// 后台定时任务，多副本仅主节点执行
+	scheduler := job.NewScheduler(db.Rdb, "coupon_service")
+	coupon.RegisterJobs(scheduler)
+	scheduler.Start(ctx)
+
 	svr := couponservice.NewServer(
-		new(CouponServiceImpl), // 现在这个结构体已实现全部接口，不再爆红
+		new(coupon.CouponService),
 		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
 			ServiceName: "coupon_service",
 		}),
 	)
 
-	log.Println("✅ 极简空服务启动成功！")
+	log.Println("✅ 优惠券服务启动成功！")
 	if err := svr.Run(); err != nil {
 		log.Println("启动失败：", err)
 	}
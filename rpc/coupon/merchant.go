@@ -0,0 +1,99 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/kitex_gen/coupon"
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+var errNotCouponOwner = errors.New("只能操作本商家发行的优惠券")
+
+// errCouponScope 适用范围或发券方校验失败，消息可直接返回给调用方
+type errCouponScope struct{ msg string }
+
+func (e *errCouponScope) Error() string { return e.msg }
+
+// checkMerchantScope 校验商家券：发券商家须审核通过，适用景点与门票须全部属于该商家，平台券不校验
+func checkMerchantScope(ctx context.Context, c *model.Coupon, scopes []model.CouponScope) error {
+	if c.IssuerType != constant.IssuerTypeMerchant {
+		return nil
+	}
+	var merchant model.SysMerchant
+	err := db.MysqlDB.WithContext(ctx).Select("id", "audit_status").
+		Where("id = ?", c.MerchantID).First(&merchant).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &errCouponScope{"发券商家不存在"}
+		}
+		return err
+	}
+	if merchant.AuditStatus != constant.AuditStatusApproved {
+		return &errCouponScope{"商家未通过审核，不能发行优惠券"}
+	}
+
+	seen := make(map[uint64]struct{}, len(scopes))
+	var spotIDs []uint64
+	for _, s := range scopes {
+		if s.ScopeType == constant.ScopeTypeCity || s.ScopeType == constant.ScopeTypeProvince {
+			return &errCouponScope{"商家券不支持按城市或省份限定范围"}
+		}
+		if _, ok := seen[s.SpotID]; !ok {
+			seen[s.SpotID] = struct{}{}
+			spotIDs = append(spotIDs, s.SpotID)
+		}
+	}
+	if len(spotIDs) == 0 {
+		return nil
+	}
+	var owned []uint64
+	err = db.MysqlDB.WithContext(ctx).Model(&model.SpotInfo{}).
+		Where("id IN ? AND merchant_id = ?", spotIDs, c.MerchantID).Pluck("id", &owned).Error
+	if err != nil {
+		return err
+	}
+	if len(owned) == len(spotIDs) {
+		return nil
+	}
+	ownedSet := make(map[uint64]struct{}, len(owned))
+	for _, id := range owned {
+		ownedSet[id] = struct{}{}
+	}
+	for _, id := range spotIDs {
+		if _, ok := ownedSet[id]; !ok {
+			return &errCouponScope{fmt.Sprintf("景点%d不属于该商家", id)}
+		}
+	}
+	return nil
+}
+
+// checkOperator 商家操作时校验优惠券归属，operatorMerchantID 为0表示平台操作
+func checkOperator(c *model.Coupon, operatorMerchantID int64) error {
+	if operatorMerchantID == 0 {
+		return nil
+	}
+	if c.IssuerType != constant.IssuerTypeMerchant || c.MerchantID != uint64(operatorMerchantID) {
+		return errNotCouponOwner
+	}
+	return nil
+}
+
+// scopeErrResp 将适用范围与商家券校验错误转换为响应
+func scopeErrResp(err error) *coupon.BaseResp {
+	var se *errCouponScope
+	switch {
+	case errors.As(err, &se):
+		return fail(constant.CodeParamError, se.msg)
+	case errors.Is(err, errNotCouponOwner):
+		return fail(constant.CodeForbidden, err.Error())
+	default:
+		log.Printf("校验优惠券适用范围失败: %v", err)
+		return fail(constant.CodeServerError, "校验优惠券适用范围失败")
+	}
+}
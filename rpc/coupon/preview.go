@@ -0,0 +1,253 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/couponrule"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/pricing"
+	"example_shop/kitex_gen/coupon"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 单个订单明细最大购票数量（OrderItem.TicketNum 为 TINYINT UNSIGNED）
+const maxTicketNum = 255
+
+// errDraftOrder 构造草稿订单时的参数错误，消息可直接返回给调用方
+type errDraftOrder struct{ msg string }
+
+func (e *errDraftOrder) Error() string { return e.msg }
+
+// PreviewOrderPrice 预览订单价格，返回使用指定优惠券（可叠加）后的明细拆分与各券优惠贡献
+func (s *CouponService) PreviewOrderPrice(ctx context.Context, req *coupon.PreviewOrderPriceReq) (*coupon.PreviewOrderPriceResp, error) {
+	resp := &coupon.PreviewOrderPriceResp{}
+	if req.UserId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID不合法")
+		return resp, nil
+	}
+	order, err := buildDraftOrder(ctx, uint64(req.UserId), req.Items)
+	if err != nil {
+		resp.Base = draftErrResp(err)
+		return resp, nil
+	}
+
+	ids, ok := mergeUserCouponIDs(req.UserCouponId, req.UserCouponIds)
+	if !ok {
+		resp.Base = fail(constant.CodeParamError, "用户优惠券ID不合法")
+		return resp, nil
+	}
+	userCoupons, err := loadUserCoupons(ctx, uint64(req.UserId), ids)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.Base = fail(constant.CodeNotFound, "优惠券不存在")
+			return resp, nil
+		}
+		log.Printf("查询用户优惠券失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询优惠券失败")
+		return resp, nil
+	}
+
+	env, err := pricingEnv(ctx, uint64(req.UserId), userCoupons...)
+	if err != nil {
+		log.Printf("查询新用户状态失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "计算失败")
+		return resp, nil
+	}
+	res, err := pricing.Calculate(order, userCoupons, env)
+	if err != nil {
+		resp.Base = fail(constant.CodeConflict, err.Error())
+		return resp, nil
+	}
+	resp.TotalAmount = res.TotalAmount
+	resp.DiscountAmount = res.Discount
+	resp.PayAmount = res.PayAmount
+	resp.Items = toItemPriceInfos(res.Items)
+	resp.Coupons = toContributionInfos(res.Coupons)
+	resp.Base = success("计算成功")
+	return resp, nil
+}
+
+// pricingEnv 构造预览计价环境，仅在券规则限定新用户时查询用户是否为新用户
+func pricingEnv(ctx context.Context, userID uint64, userCoupons ...*model.UserCoupon) (pricing.Env, error) {
+	env := pricing.Env{Now: time.Now()}
+	coupons := make([]*model.Coupon, 0, len(userCoupons))
+	for _, uc := range userCoupons {
+		coupons = append(coupons, uc.Coupon)
+	}
+	if !couponrule.NeedsNewUser(coupons...) {
+		return env, nil
+	}
+	newUser, err := couponrule.IsNewUser(db.MysqlDB.WithContext(ctx), userID)
+	if err != nil {
+		return env, err
+	}
+	env.NewUser = newUser
+	return env, nil
+}
+
+// buildDraftOrder 根据购票明细构造草稿订单，门票必须在售且属于同一景点
+func buildDraftOrder(ctx context.Context, userID uint64, items []*coupon.OrderItemReq) (*model.OrderMain, error) {
+	if len(items) == 0 {
+		return nil, &errDraftOrder{"购票明细不能为空"}
+	}
+	ids := make([]uint64, 0, len(items))
+	for _, it := range items {
+		if it == nil || it.TicketTypeId <= 0 {
+			return nil, &errDraftOrder{"门票类型ID不合法"}
+		}
+		if it.TicketNum <= 0 || it.TicketNum > maxTicketNum {
+			return nil, &errDraftOrder{"购票数量不合法"}
+		}
+		ids = append(ids, uint64(it.TicketTypeId))
+	}
+	var tickets []model.TicketType
+	if err := db.MysqlDB.WithContext(ctx).Where("id IN ?", ids).Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+	ticketMap := make(map[uint64]*model.TicketType, len(tickets))
+	for i := range tickets {
+		ticketMap[tickets[i].ID] = &tickets[i]
+	}
+
+	order := &model.OrderMain{UserID: userID, OrderStatus: constant.OrderStatusDraft}
+	for _, it := range items {
+		t, ok := ticketMap[uint64(it.TicketTypeId)]
+		if !ok {
+			return nil, &errDraftOrder{fmt.Sprintf("门票类型%d不存在", it.TicketTypeId)}
+		}
+		if t.TicketStatus != constant.TicketStatusOnSale {
+			return nil, &errDraftOrder{fmt.Sprintf("门票%s未在售", t.TicketName)}
+		}
+		if order.SpotID == 0 {
+			order.SpotID = t.SpotID
+		} else if order.SpotID != t.SpotID {
+			return nil, &errDraftOrder{"一个订单只能购买同一景点的门票"}
+		}
+		order.OrderItems = append(order.OrderItems, model.OrderItem{
+			TicketTypeID: t.ID,
+			TicketName:   t.TicketName,
+			SinglePrice:  t.Price,
+			TicketNum:    uint8(it.TicketNum),
+		})
+	}
+	var spot model.SpotInfo
+	if err := db.MysqlDB.WithContext(ctx).Select("id", "merchant_id", "province", "city").
+		Where("id = ?", order.SpotID).First(&spot).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &errDraftOrder{"景点不存在"}
+		}
+		return nil, err
+	}
+	order.MerchantID = spot.MerchantID
+	order.Spot = &spot
+	return order, nil
+}
+
+// draftErrResp 将构造草稿订单的错误转换为响应
+func draftErrResp(err error) *coupon.BaseResp {
+	var de *errDraftOrder
+	if errors.As(err, &de) {
+		return fail(constant.CodeParamError, de.msg)
+	}
+	log.Printf("构造草稿订单失败: %v", err)
+	return fail(constant.CodeServerError, "查询门票失败")
+}
+
+// mergeUserCouponIDs 合并单券与多券入参并去重，保持传入顺序，存在非法ID时返回false
+func mergeUserCouponIDs(single int64, list []int64) ([]uint64, bool) {
+	all := list
+	if single != 0 {
+		all = append([]int64{single}, list...)
+	}
+	seen := make(map[int64]struct{}, len(all))
+	ids := make([]uint64, 0, len(all))
+	for _, id := range all {
+		if id <= 0 {
+			return nil, false
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, uint64(id))
+	}
+	return ids, true
+}
+
+// loadUserCoupons 按传入顺序查询用户名下的多张优惠券，任一不存在时返回 gorm.ErrRecordNotFound
+func loadUserCoupons(ctx context.Context, userID uint64, ids []uint64) ([]*model.UserCoupon, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var list []model.UserCoupon
+	err := db.MysqlDB.WithContext(ctx).Preload("Coupon.Scopes").
+		Where("id IN ? AND user_id = ?", ids, userID).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[uint64]*model.UserCoupon, len(list))
+	for i := range list {
+		found[list[i].ID] = &list[i]
+	}
+	userCoupons := make([]*model.UserCoupon, 0, len(ids))
+	for _, id := range ids {
+		uc, ok := found[id]
+		if !ok {
+			return nil, gorm.ErrRecordNotFound
+		}
+		userCoupons = append(userCoupons, uc)
+	}
+	return userCoupons, nil
+}
+
+// toContributionInfos 转换各优惠券的优惠贡献
+func toContributionInfos(list []pricing.CouponDiscount) []*coupon.CouponContributionInfo {
+	infos := make([]*coupon.CouponContributionInfo, 0, len(list))
+	for _, cd := range list {
+		infos = append(infos, &coupon.CouponContributionInfo{
+			UserCouponId: int64(cd.UserCouponID),
+			CouponId:     int64(cd.CouponID),
+			CouponName:   cd.CouponName,
+			IssuerType:   cd.IssuerType,
+			MerchantId:   int64(cd.MerchantID),
+			Discount:     cd.Discount,
+		})
+	}
+	return infos
+}
+
+// toItemPriceInfos 转换明细价格拆分
+func toItemPriceInfos(items []pricing.ItemPrice) []*coupon.ItemPriceInfo {
+	list := make([]*coupon.ItemPriceInfo, 0, len(items))
+	for _, it := range items {
+		list = append(list, &coupon.ItemPriceInfo{
+			TicketTypeId: int64(it.TicketTypeID),
+			TicketName:   it.TicketName,
+			SinglePrice:  it.SinglePrice,
+			TicketNum:    int32(it.TicketNum),
+			Amount:       it.Amount,
+			Discount:     it.Discount,
+			PayAmount:    it.PayAmount,
+			Allocations:  toAllocationInfos(it.Allocations),
+		})
+	}
+	return list
+}
+
+// toAllocationInfos 转换明细上各优惠券的分摊
+func toAllocationInfos(list []pricing.Allocation) []*coupon.CouponAllocationInfo {
+	infos := make([]*coupon.CouponAllocationInfo, 0, len(list))
+	for _, a := range list {
+		infos = append(infos, &coupon.CouponAllocationInfo{
+			UserCouponId: int64(a.UserCouponID),
+			Discount:     a.Discount,
+		})
+	}
+	return infos
+}
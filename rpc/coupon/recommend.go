@@ -0,0 +1,102 @@
+package coupon
+
+import (
+	"context"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/pricing"
+	"example_shop/kitex_gen/coupon"
+	"log"
+	"sort"
+)
+
+// RecommendCoupons 结算页推荐优惠券：计算用户每张未使用优惠券对草稿订单的实际优惠并排序
+func (s *CouponService) RecommendCoupons(ctx context.Context, req *coupon.RecommendCouponsReq) (*coupon.RecommendCouponsResp, error) {
+	resp := &coupon.RecommendCouponsResp{}
+	if req.UserId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID不合法")
+		return resp, nil
+	}
+	order, err := buildDraftOrder(ctx, uint64(req.UserId), req.Items)
+	if err != nil {
+		resp.Base = draftErrResp(err)
+		return resp, nil
+	}
+	var list []model.UserCoupon
+	err = db.MysqlDB.WithContext(ctx).Preload("Coupon.Scopes").
+		Where("user_id = ? AND use_status = ?", req.UserId, constant.UseStatusUnused).
+		Find(&list).Error
+	if err != nil {
+		log.Printf("查询用户优惠券失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询优惠券失败")
+		return resp, nil
+	}
+
+	userCoupons := make([]*model.UserCoupon, 0, len(list))
+	for i := range list {
+		userCoupons = append(userCoupons, &list[i])
+	}
+	env, err := pricingEnv(ctx, uint64(req.UserId), userCoupons...)
+	if err != nil {
+		log.Printf("查询新用户状态失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询优惠券失败")
+		return resp, nil
+	}
+	base, err := pricing.Calculate(order, nil, env)
+	if err != nil {
+		resp.Base = fail(constant.CodeConflict, err.Error())
+		return resp, nil
+	}
+	resp.Coupons = make([]*coupon.CouponRecommendation, 0, len(list))
+	for _, uc := range userCoupons {
+		resp.Coupons = append(resp.Coupons, recommendOne(order, uc, env))
+	}
+	sortRecommendations(resp.Coupons)
+	resp.TotalAmount = base.TotalAmount
+	resp.Base = success("查询成功")
+	return resp, nil
+}
+
+// recommendOne 计算单张优惠券的推荐结果，不可用时记录原因
+func recommendOne(order *model.OrderMain, uc *model.UserCoupon, env pricing.Env) *coupon.CouponRecommendation {
+	rec := &coupon.CouponRecommendation{
+		UserCouponId:   int64(uc.ID),
+		CouponId:       int64(uc.CouponID),
+		CouponName:     uc.CouponName,
+		Denomination:   uc.Denomination,
+		MinUseAmount:   uc.MinUseAmount,
+		ValidStartTime: uc.ValidStartTime.Unix(),
+		ValidEndTime:   uc.ValidEndTime.Unix(),
+	}
+	if uc.Coupon != nil {
+		rec.CouponType = uc.Coupon.CouponType
+	}
+	res, err := pricing.CalculateDiscount(order, uc, env)
+	if err != nil {
+		rec.Reason = err.Error()
+		return rec
+	}
+	if res.Discount <= 0 {
+		rec.Reason = "该订单无可抵扣金额"
+		return rec
+	}
+	rec.Usable = true
+	rec.DiscountAmount = res.Discount
+	rec.PayAmount = res.PayAmount
+	return rec
+}
+
+// sortRecommendations 可用券在前，按优惠金额降序，金额相同时先过期的在前
+func sortRecommendations(list []*coupon.CouponRecommendation) {
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.Usable != b.Usable {
+			return a.Usable
+		}
+		if a.DiscountAmount != b.DiscountAmount {
+			return a.DiscountAmount > b.DiscountAmount
+		}
+		return a.ValidEndTime < b.ValidEndTime
+	})
+}
@@ -0,0 +1,100 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/pricing"
+	"example_shop/common/redeem"
+	"example_shop/kitex_gen/coupon"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LockCoupon 订单进入支付时锁定用户优惠券，支持平台券与商家券叠加
+func (s *CouponService) LockCoupon(ctx context.Context, req *coupon.LockCouponReq) (*coupon.LockCouponResp, error) {
+	resp := &coupon.LockCouponResp{}
+	ids, ok := mergeUserCouponIDs(req.UserCouponId, req.UserCouponIds)
+	if req.UserId <= 0 || req.OrderId <= 0 || !ok || len(ids) == 0 {
+		resp.Base = fail(constant.CodeParamError, "参数不合法")
+		return resp, nil
+	}
+	var res *pricing.Result
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		res, err = redeem.Lock(tx, uint64(req.UserId), uint64(req.OrderId), ids, time.Now())
+		return err
+	})
+	if err != nil {
+		resp.Base = redeemErrResp(err, "锁定优惠券失败")
+		return resp, nil
+	}
+	resp.DiscountAmount = res.Discount
+	resp.PayAmount = res.PayAmount
+	resp.Coupons = toContributionInfos(res.Coupons)
+	resp.Base = success("锁定成功")
+	return resp, nil
+}
+
+// ConsumeCoupon 订单支付成功后核销锁定的优惠券，未支付的订单拒绝核销，重复调用幂等
+func (s *CouponService) ConsumeCoupon(ctx context.Context, req *coupon.ConsumeCouponReq) (*coupon.ConsumeCouponResp, error) {
+	resp := &coupon.ConsumeCouponResp{}
+	if req.OrderId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "订单ID不合法")
+		return resp, nil
+	}
+	var consumed bool
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		consumed, err = redeem.Consume(tx, uint64(req.OrderId), time.Now())
+		return err
+	})
+	if err != nil {
+		resp.Base = redeemErrResp(err, "核销优惠券失败")
+		return resp, nil
+	}
+	resp.Consumed = consumed
+	resp.Base = success("核销成功")
+	return resp, nil
+}
+
+// ReleaseCoupon 订单取消或全额退款后释放优惠券，其他状态的订单拒绝释放，重复调用幂等
+func (s *CouponService) ReleaseCoupon(ctx context.Context, req *coupon.ReleaseCouponReq) (*coupon.ReleaseCouponResp, error) {
+	resp := &coupon.ReleaseCouponResp{}
+	if req.OrderId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "订单ID不合法")
+		return resp, nil
+	}
+	var released int64
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		released, err = redeem.Release(tx, uint64(req.OrderId), time.Now())
+		return err
+	})
+	if err != nil {
+		resp.Base = redeemErrResp(err, "释放优惠券失败")
+		return resp, nil
+	}
+	resp.Released = int32(released)
+	resp.Base = success("释放成功")
+	return resp, nil
+}
+
+// redeemErrResp 将优惠券核销流程的错误转换为响应
+func redeemErrResp(err error, msg string) *coupon.BaseResp {
+	switch {
+	case errors.Is(err, redeem.ErrNotLockable), errors.Is(err, redeem.ErrOrderMismatch), errors.Is(err, redeem.ErrNotLocked),
+		errors.Is(err, redeem.ErrNotReleasable), errors.Is(err, redeem.ErrNotPaid):
+		return fail(constant.CodeConflict, err.Error())
+	case pricing.IsUnusable(err):
+		return fail(constant.CodeConflict, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fail(constant.CodeNotFound, "订单不存在")
+	default:
+		log.Printf("%s: %v", msg, err)
+		return fail(constant.CodeServerError, msg)
+	}
+}
@@ -0,0 +1,236 @@
+package coupon
+
+import (
+	"context"
+	"example_shop/common/config"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/notify"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	remindScanInterval = 5 * time.Minute  // 扫描即将过期优惠券的间隔
+	remindSendInterval = 30 * time.Second // 发送待投递提醒的间隔
+	remindBatchSize    = 200              // 每批处理条数
+	remindMaxAttempts  = 3                // 单条提醒最大发送次数
+	remindBizType      = "COUPON_EXPIRE_REMIND"
+)
+
+// remindWindow 提醒时间窗口：有效期结束时间落在 (now+From, now+To] 内的券生成对应提醒
+type remindWindow struct {
+	RemindType string
+	From, To   time.Duration
+}
+
+// 1小时内过期的券只发1小时提醒，不再补发24小时提醒
+var remindWindows = []remindWindow{
+	{RemindType: constant.RemindTypeBefore24H, From: time.Hour, To: 24 * time.Hour},
+	{RemindType: constant.RemindTypeBefore1H, From: 0, To: time.Hour},
+}
+
+// buildNotifiers 根据配置创建启用的通知渠道
+func buildNotifiers() map[string]notify.Notifier {
+	notifiers := make(map[string]notify.Notifier)
+	for _, ch := range config.Cfg.Notify.Channels {
+		switch strings.ToUpper(strings.TrimSpace(ch)) {
+		case notify.ChannelSMS:
+			notifiers[notify.ChannelSMS] = notify.NewSMSNotifier()
+		case notify.ChannelInApp:
+			notifiers[notify.ChannelInApp] = notify.NewInAppNotifier(db.Rdb)
+		case notify.ChannelWebhook:
+			if config.Cfg.Notify.WebhookURL == "" {
+				log.Println("WEBHOOK 渠道未配置 WebhookURL，已忽略")
+				continue
+			}
+			notifiers[notify.ChannelWebhook] = notify.NewWebhookNotifier(config.Cfg.Notify.WebhookURL)
+		default:
+			log.Printf("未知的通知渠道: %s", ch)
+		}
+	}
+	return notifiers
+}
+
+// remindScanner 扫描即将过期的未使用优惠券，按渠道写入待发送提醒，唯一索引保证不重复入队
+type remindScanner struct {
+	channels []string
+}
+
+func (r *remindScanner) run(ctx context.Context) error {
+	if len(r.channels) == 0 {
+		return nil
+	}
+	now := time.Now()
+	var total int64
+	for _, w := range remindWindows {
+		n, err := r.scanWindow(ctx, now, w)
+		total += n
+		if err != nil {
+			return err
+		}
+	}
+	if total > 0 {
+		log.Printf("[job] 优惠券过期提醒入队 %d 条", total)
+	}
+	return nil
+}
+
+// scanWindow 按ID游标分批扫描时间窗口内的用户优惠券
+func (r *remindScanner) scanWindow(ctx context.Context, now time.Time, w remindWindow) (int64, error) {
+	var total int64
+	var lastID uint64
+	for ctx.Err() == nil {
+		var list []model.UserCoupon
+		err := db.MysqlDB.WithContext(ctx).Select("id", "user_id").
+			Where("id > ? AND use_status = ? AND valid_end_time > ? AND valid_end_time <= ?",
+				lastID, constant.UseStatusUnused, now.Add(w.From), now.Add(w.To)).
+			Order("id").Limit(remindBatchSize).Find(&list).Error
+		if err != nil {
+			return total, err
+		}
+		if len(list) == 0 {
+			break
+		}
+		logs := make([]model.CouponRemindLog, 0, len(list)*len(r.channels))
+		for _, uc := range list {
+			for _, ch := range r.channels {
+				logs = append(logs, model.CouponRemindLog{
+					UserCouponID: uc.ID,
+					RemindType:   w.RemindType,
+					Channel:      ch,
+					UserID:       uc.UserID,
+					SendStatus:   constant.RemindStatusPending,
+				})
+			}
+		}
+		result := db.MysqlDB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&logs)
+		if result.Error != nil {
+			return total, result.Error
+		}
+		total += result.RowsAffected
+		lastID = list[len(list)-1].ID
+		if len(list) < remindBatchSize {
+			break
+		}
+	}
+	return total, nil
+}
+
+// remindSender 发送待投递的提醒，发送前先将状态置为 SENDING，防止多副本或重启后重复发送
+type remindSender struct {
+	notifiers map[string]notify.Notifier
+}
+
+func (r *remindSender) run(ctx context.Context) error {
+	var logs []model.CouponRemindLog
+	err := db.MysqlDB.WithContext(ctx).
+		Where("send_status = ? OR (send_status = ? AND attempts < ?)",
+			constant.RemindStatusPending, constant.RemindStatusFailed, remindMaxAttempts).
+		Order("id").Limit(remindBatchSize).Find(&logs).Error
+	if err != nil {
+		return err
+	}
+	var sent, failed, cancelled int
+	for i := range logs {
+		if ctx.Err() != nil {
+			break
+		}
+		switch r.sendOne(ctx, &logs[i]) {
+		case constant.RemindStatusSent:
+			sent++
+		case constant.RemindStatusFailed:
+			failed++
+		case constant.RemindStatusCancelled:
+			cancelled++
+		}
+	}
+	if len(logs) > 0 {
+		log.Printf("[job] 优惠券过期提醒发送: 成功%d, 失败%d, 取消%d", sent, failed, cancelled)
+	}
+	return nil
+}
+
+// sendOne 发送单条提醒，返回最终状态，未抢到发送权时返回空字符串
+func (r *remindSender) sendOne(ctx context.Context, rl *model.CouponRemindLog) string {
+	claimed := db.MysqlDB.WithContext(ctx).Model(&model.CouponRemindLog{}).
+		Where("id = ? AND send_status = ? AND attempts = ?", rl.ID, rl.SendStatus, rl.Attempts).
+		Updates(map[string]interface{}{
+			"send_status": constant.RemindStatusSending,
+			"attempts":    gorm.Expr("attempts + 1"),
+		})
+	if claimed.Error != nil {
+		log.Printf("锁定提醒记录失败, id=%d: %v", rl.ID, claimed.Error)
+		return ""
+	}
+	if claimed.RowsAffected == 0 {
+		return ""
+	}
+
+	msg, status, err := r.buildMessage(ctx, rl)
+	if err == nil && status == "" {
+		notifier, ok := r.notifiers[rl.Channel]
+		if !ok {
+			status = constant.RemindStatusCancelled
+		} else if err = notifier.Send(ctx, msg); err == nil {
+			status = constant.RemindStatusSent
+		}
+	}
+	if err != nil {
+		status = constant.RemindStatusFailed
+	}
+	r.finish(ctx, rl.ID, status, err)
+	return status
+}
+
+// buildMessage 组装通知内容，优惠券已不是未使用状态时返回 CANCELLED
+func (r *remindSender) buildMessage(ctx context.Context, rl *model.CouponRemindLog) (notify.Message, string, error) {
+	var uc model.UserCoupon
+	err := db.MysqlDB.WithContext(ctx).Preload("User").Where("id = ?", rl.UserCouponID).First(&uc).Error
+	if err != nil {
+		return notify.Message{}, "", err
+	}
+	if uc.UseStatus != constant.UseStatusUnused || uc.User == nil {
+		return notify.Message{}, constant.RemindStatusCancelled, nil
+	}
+	left := "24小时"
+	if rl.RemindType == constant.RemindTypeBefore1H {
+		left = "1小时"
+	}
+	return notify.Message{
+		UserID:  uc.UserID,
+		Phone:   uc.User.Phone,
+		Title:   "优惠券即将过期",
+		Content: fmt.Sprintf("您的优惠券「%s」将在%s内过期（%s），请尽快使用", uc.CouponName, left, uc.ValidEndTime.Format("2006-01-02 15:04")),
+		BizType: remindBizType,
+		BizID:   uc.ID,
+	}, "", nil
+}
+
+// finish 回写发送结果
+func (r *remindSender) finish(ctx context.Context, id uint64, status string, sendErr error) {
+	updates := map[string]interface{}{"send_status": status}
+	switch status {
+	case constant.RemindStatusSent:
+		updates["sent_time"] = time.Now()
+		updates["last_error"] = nil
+	case constant.RemindStatusFailed:
+		msg := []rune(sendErr.Error())
+		if len(msg) > 500 {
+			msg = msg[:500]
+		}
+		updates["last_error"] = string(msg)
+	}
+	err := db.MysqlDB.WithContext(ctx).Model(&model.CouponRemindLog{}).
+		Where("id = ? AND send_status = ?", id, constant.RemindStatusSending).
+		Updates(updates).Error
+	if err != nil {
+		log.Printf("回写提醒发送结果失败, id=%d: %v", id, err)
+	}
+}
@@ -0,0 +1,16 @@
+package coupon
+
+import (
+	"example_shop/common/constant"
+	"example_shop/kitex_gen/coupon"
+)
+
+// success 构造成功响应
+func success(msg string) *coupon.BaseResp {
+	return &coupon.BaseResp{Code: constant.CodeSuccess, Msg: msg}
+}
+
+// fail 构造失败响应
+func fail(code int32, msg string) *coupon.BaseResp {
+	return &coupon.BaseResp{Code: code, Msg: msg}
+}
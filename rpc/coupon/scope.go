@@ -0,0 +1,194 @@
+package coupon
+
+import (
+	"context"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/kitex_gen/coupon"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+// 单张优惠券最多配置的适用范围条数
+const maxCouponScopes = 200
+
+// resolveScopes 校验并规范化适用范围：合并 apply_spot_ids 中的景点、去重、校验景点与门票存在，门票类型范围补齐所属景点
+func resolveScopes(ctx context.Context, infos []*coupon.CouponScopeInfo, applySpotIDs string) ([]model.CouponScope, error) {
+	legacy, err := parseSpotIDs(applySpotIDs)
+	if err != nil {
+		return nil, &errCouponScope{err.Error()}
+	}
+	for _, id := range legacy {
+		infos = append(infos, &coupon.CouponScopeInfo{ScopeType: constant.ScopeTypeSpot, SpotId: int64(id)})
+	}
+
+	seen := make(map[string]struct{}, len(infos))
+	scopes := make([]model.CouponScope, 0, len(infos))
+	var spotIDs, ticketIDs []uint64
+	for _, info := range infos {
+		if info == nil {
+			continue
+		}
+		s := model.CouponScope{ScopeType: strings.ToUpper(strings.TrimSpace(info.ScopeType))}
+		switch s.ScopeType {
+		case constant.ScopeTypeSpot:
+			if info.SpotId <= 0 {
+				return nil, &errCouponScope{"景点范围的景点ID不合法"}
+			}
+			s.SpotID = uint64(info.SpotId)
+		case constant.ScopeTypeTicketType:
+			if info.TicketTypeId <= 0 {
+				return nil, &errCouponScope{"门票类型范围的门票类型ID不合法"}
+			}
+			s.TicketTypeID = uint64(info.TicketTypeId)
+		case constant.ScopeTypeCity:
+			s.Province, s.City = strings.TrimSpace(info.Province), strings.TrimSpace(info.City)
+			if s.Province == "" || s.City == "" {
+				return nil, &errCouponScope{"城市范围须同时指定省份和城市"}
+			}
+		case constant.ScopeTypeProvince:
+			s.Province = strings.TrimSpace(info.Province)
+			if s.Province == "" {
+				return nil, &errCouponScope{"省份范围须指定省份"}
+			}
+		default:
+			return nil, &errCouponScope{"适用范围类型只能是SPOT、TICKET_TYPE、CITY或PROVINCE"}
+		}
+		if utf8.RuneCountInString(s.Province) > 30 || utf8.RuneCountInString(s.City) > 30 {
+			return nil, &errCouponScope{"省份或城市名称不能超过30个字符"}
+		}
+		key := fmt.Sprintf("%s|%d|%d|%s|%s", s.ScopeType, s.SpotID, s.TicketTypeID, s.Province, s.City)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		switch s.ScopeType {
+		case constant.ScopeTypeSpot:
+			spotIDs = append(spotIDs, s.SpotID)
+		case constant.ScopeTypeTicketType:
+			ticketIDs = append(ticketIDs, s.TicketTypeID)
+		}
+		scopes = append(scopes, s)
+	}
+	if len(scopes) > maxCouponScopes {
+		return nil, &errCouponScope{fmt.Sprintf("适用范围不能超过%d条", maxCouponScopes)}
+	}
+
+	if len(spotIDs) > 0 {
+		var found []uint64
+		if err = db.MysqlDB.WithContext(ctx).Model(&model.SpotInfo{}).
+			Where("id IN ?", spotIDs).Pluck("id", &found).Error; err != nil {
+			return nil, err
+		}
+		if id, ok := firstMissing(spotIDs, found); ok {
+			return nil, &errCouponScope{fmt.Sprintf("景点%d不存在", id)}
+		}
+	}
+	if len(ticketIDs) > 0 {
+		var tickets []model.TicketType
+		if err = db.MysqlDB.WithContext(ctx).Select("id", "spot_id").
+			Where("id IN ?", ticketIDs).Find(&tickets).Error; err != nil {
+			return nil, err
+		}
+		ticketSpot := make(map[uint64]uint64, len(tickets))
+		for _, t := range tickets {
+			ticketSpot[t.ID] = t.SpotID
+		}
+		for i := range scopes {
+			if scopes[i].ScopeType != constant.ScopeTypeTicketType {
+				continue
+			}
+			spotID, ok := ticketSpot[scopes[i].TicketTypeID]
+			if !ok {
+				return nil, &errCouponScope{fmt.Sprintf("门票类型%d不存在", scopes[i].TicketTypeID)}
+			}
+			scopes[i].SpotID = spotID
+		}
+	}
+	return scopes, nil
+}
+
+// firstMissing 返回 want 中第一个不在 got 中的ID
+func firstMissing(want, got []uint64) (uint64, bool) {
+	set := make(map[uint64]struct{}, len(got))
+	for _, id := range got {
+		set[id] = struct{}{}
+	}
+	for _, id := range want {
+		if _, ok := set[id]; !ok {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// replaceScopes 在事务中整体替换优惠券的适用范围
+func replaceScopes(tx *gorm.DB, couponID uint64, scopes []model.CouponScope) error {
+	if err := tx.Where("coupon_id = ?", couponID).Delete(&model.CouponScope{}).Error; err != nil {
+		return err
+	}
+	if len(scopes) == 0 {
+		return nil
+	}
+	for i := range scopes {
+		scopes[i].ID = 0
+		scopes[i].CouponID = couponID
+	}
+	return tx.Create(&scopes).Error
+}
+
+// applicableToSpot 限定查询结果为可用于该景点的优惠券：
+// 不限范围的平台券、发券商家为景点所属商家的商家券，以及范围命中该景点、其门票或所在城市/省份的券；
+// 无范围记录时与 pricing 一致兼容未迁移的 apply_spot_ids，为空不限景点，否则需包含该景点
+func applicableToSpot(query *gorm.DB, spot *model.SpotInfo) *gorm.DB {
+	return query.
+		Where("(coupon.issuer_type <> ? OR coupon.merchant_id = ?)", constant.IssuerTypeMerchant, spot.MerchantID).
+		Where(`((NOT EXISTS (SELECT 1 FROM coupon_scope cs WHERE cs.coupon_id = coupon.id)
+				AND (coupon.apply_spot_ids IS NULL OR TRIM(coupon.apply_spot_ids) = ''
+					OR FIND_IN_SET(?, REPLACE(coupon.apply_spot_ids, ' ', '')) > 0))
+			OR EXISTS (SELECT 1 FROM coupon_scope cs WHERE cs.coupon_id = coupon.id AND (
+				(cs.scope_type IN ? AND cs.spot_id = ?)
+				OR (cs.scope_type = ? AND cs.province = ? AND cs.city = ?)
+				OR (cs.scope_type = ? AND cs.province = ?))))`,
+			spot.ID,
+			[]string{constant.ScopeTypeSpot, constant.ScopeTypeTicketType}, spot.ID,
+			constant.ScopeTypeCity, spot.Province, spot.City,
+			constant.ScopeTypeProvince, spot.Province)
+}
+
+// toScopeInfos 转换适用范围
+func toScopeInfos(scopes []model.CouponScope) []*coupon.CouponScopeInfo {
+	infos := make([]*coupon.CouponScopeInfo, 0, len(scopes))
+	for _, s := range scopes {
+		infos = append(infos, &coupon.CouponScopeInfo{
+			ScopeType:    s.ScopeType,
+			SpotId:       int64(s.SpotID),
+			TicketTypeId: int64(s.TicketTypeID),
+			Province:     s.Province,
+			City:         s.City,
+		})
+	}
+	return infos
+}
+
+// scopeSpotIDs 由景点范围拼接兼容旧接口的 apply_spot_ids
+func scopeSpotIDs(scopes []model.CouponScope) string {
+	var ids []uint64
+	for _, s := range scopes {
+		if s.ScopeType == constant.ScopeTypeSpot {
+			ids = append(ids, s.SpotID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.FormatUint(id, 10))
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,127 @@
+package coupon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/pricing"
+	"example_shop/kitex_gen/coupon"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// 景点可用优惠券缓存时长，优惠券变更时通过递增缓存版本立即失效
+const spotCouponsTTL = 5 * time.Minute
+
+// spotCoupons 景点可用优惠券缓存内容
+type spotCoupons struct {
+	Spot    model.SpotInfo `json:"spot"`
+	Coupons []model.Coupon `json:"coupons"`
+}
+
+// ListCouponsForSpot 查询景点当前可用的优惠券，可按门票类型进一步筛选
+func (s *CouponService) ListCouponsForSpot(ctx context.Context, req *coupon.ListCouponsForSpotReq) (*coupon.ListCouponsForSpotResp, error) {
+	resp := &coupon.ListCouponsForSpotResp{}
+	if req.SpotId <= 0 || req.TicketTypeId < 0 {
+		resp.Base = fail(constant.CodeParamError, "参数不合法")
+		return resp, nil
+	}
+	sc, err := loadSpotCoupons(ctx, uint64(req.SpotId))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.Base = fail(constant.CodeNotFound, "景点不存在")
+			return resp, nil
+		}
+		log.Printf("查询景点可用优惠券失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询优惠券失败")
+		return resp, nil
+	}
+
+	now := time.Now()
+	resp.Coupons = make([]*coupon.CouponInfo, 0, len(sc.Coupons))
+	for i := range sc.Coupons {
+		c := &sc.Coupons[i]
+		if c.CouponStatus != constant.CouponStatusValid || !c.ValidEndTime.After(now) {
+			continue
+		}
+		if req.TicketTypeId > 0 && !ticketApplicable(c, &sc.Spot, uint64(req.TicketTypeId)) {
+			continue
+		}
+		resp.Coupons = append(resp.Coupons, toCouponInfo(c))
+	}
+	resp.Base = success("查询成功")
+	return resp, nil
+}
+
+// ticketApplicable 判断优惠券是否适用于景点下的指定门票类型
+func ticketApplicable(c *model.Coupon, spot *model.SpotInfo, ticketTypeID uint64) bool {
+	if len(c.Scopes) == 0 {
+		return true
+	}
+	for i := range c.Scopes {
+		if pricing.ScopeMatches(&c.Scopes[i], spot, spot.ID, ticketTypeID) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadSpotCoupons 优先读取缓存，未命中时查询MySQL并回写
+func loadSpotCoupons(ctx context.Context, spotID uint64) (*spotCoupons, error) {
+	version, err := db.Rdb.Get(ctx, constant.RedisKeySpotCouponsVer).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		log.Printf("读取景点优惠券缓存版本失败: %v", err)
+		return querySpotCoupons(ctx, spotID)
+	}
+	key := fmt.Sprintf(constant.RedisKeySpotCoupons, version, spotID)
+	if data, err := db.Rdb.Get(ctx, key).Bytes(); err == nil {
+		var sc spotCoupons
+		if err = json.Unmarshal(data, &sc); err == nil {
+			return &sc, nil
+		}
+		log.Printf("解析景点优惠券缓存失败, key=%s: %v", key, err)
+	} else if !errors.Is(err, redis.Nil) {
+		log.Printf("读取景点优惠券缓存失败, key=%s: %v", key, err)
+	}
+
+	sc, err := querySpotCoupons(ctx, spotID)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(sc); err == nil {
+		if err = db.Rdb.Set(ctx, key, data, spotCouponsTTL).Err(); err != nil {
+			log.Printf("写入景点优惠券缓存失败, key=%s: %v", key, err)
+		}
+	}
+	return sc, nil
+}
+
+// querySpotCoupons 查询景点当前有效的优惠券及其适用范围
+func querySpotCoupons(ctx context.Context, spotID uint64) (*spotCoupons, error) {
+	sc := &spotCoupons{}
+	if err := db.MysqlDB.WithContext(ctx).Select("id", "merchant_id", "province", "city").
+		Where("id = ?", spotID).First(&sc.Spot).Error; err != nil {
+		return nil, err
+	}
+	query := db.MysqlDB.WithContext(ctx).Model(&model.Coupon{}).Preload("Scopes").
+		Where("coupon.coupon_status = ? AND coupon.valid_end_time > ?", constant.CouponStatusValid, time.Now())
+	err := applicableToSpot(query, &sc.Spot).Order("coupon.priority DESC, coupon.id DESC").Find(&sc.Coupons).Error
+	if err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+// bumpSpotCouponsVersion 优惠券配置变更后递增缓存版本，使所有景点的可用优惠券缓存失效
+func bumpSpotCouponsVersion(ctx context.Context) {
+	if err := db.Rdb.Incr(ctx, constant.RedisKeySpotCouponsVer).Err(); err != nil {
+		log.Printf("递增景点优惠券缓存版本失败: %v", err)
+	}
+}
@@ -0,0 +1,200 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/pricing"
+	"example_shop/kitex_gen/coupon"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	statsDefaultDays = 30  // 未指定日期时默认查询最近天数
+	statsMaxDays     = 366 // 单次查询最大天数
+)
+
+// GetCouponStats 查询优惠券活动统计：累计领用情况与按天、按景点的明细，数据由统计任务定期重算
+func (s *CouponService) GetCouponStats(ctx context.Context, req *coupon.GetCouponStatsReq) (*coupon.GetCouponStatsResp, error) {
+	resp := &coupon.GetCouponStatsResp{}
+	start, end, msg := parseStatRange(req.StartDate, req.EndDate, time.Now())
+	if msg != "" {
+		resp.Base = fail(constant.CodeParamError, msg)
+		return resp, nil
+	}
+	if req.SpotId < 0 {
+		resp.Base = fail(constant.CodeParamError, "景点ID不合法")
+		return resp, nil
+	}
+	c, err := getCoupon(ctx, req.CouponId)
+	if err != nil {
+		resp.Base = couponErrResp(err)
+		return resp, nil
+	}
+	if err = checkOperator(c, req.OperatorMerchantId); err != nil {
+		resp.Base = scopeErrResp(err)
+		return resp, nil
+	}
+
+	stat, err := loadCouponStat(ctx, c.ID)
+	if err != nil {
+		log.Printf("查询优惠券统计失败, coupon_id=%d: %v", c.ID, err)
+		resp.Base = fail(constant.CodeServerError, "查询统计失败")
+		return resp, nil
+	}
+	query := db.MysqlDB.WithContext(ctx).Where("coupon_id = ? AND stat_date BETWEEN ? AND ?", c.ID, start, end)
+	if req.SpotId > 0 {
+		query = query.Where("spot_id = ?", req.SpotId)
+	}
+	var daily []model.CouponStatDaily
+	if err = query.Order("stat_date, spot_id").Find(&daily).Error; err != nil {
+		log.Printf("查询优惠券日统计失败, coupon_id=%d: %v", c.ID, err)
+		resp.Base = fail(constant.CodeServerError, "查询统计失败")
+		return resp, nil
+	}
+	spots, err := spotStats(ctx, daily)
+	if err != nil {
+		log.Printf("查询景点信息失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询统计失败")
+		return resp, nil
+	}
+
+	resp.Summary = toStatsSummary(c, stat)
+	resp.Daily = make([]*coupon.CouponDailyStat, 0, len(daily))
+	for i := range daily {
+		d := &daily[i]
+		resp.Daily = append(resp.Daily, &coupon.CouponDailyStat{
+			StatDate:       d.StatDate.Format(time.DateOnly),
+			SpotId:         int64(d.SpotID),
+			ClaimedCount:   int64(d.ClaimedCount),
+			UsedCount:      int64(d.UsedCount),
+			ExpiredCount:   int64(d.ExpiredCount),
+			DiscountAmount: d.DiscountAmount,
+			GmvAmount:      d.GmvAmount,
+		})
+	}
+	resp.Spots = spots
+	resp.Base = success("查询成功")
+	return resp, nil
+}
+
+// parseStatRange 解析查询日期区间，返回错误提示
+func parseStatRange(startDate, endDate string, now time.Time) (time.Time, time.Time, string) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	end, start := today, today.AddDate(0, 0, 1-statsDefaultDays)
+	var err error
+	if s := strings.TrimSpace(endDate); s != "" {
+		if end, err = time.ParseInLocation(time.DateOnly, s, now.Location()); err != nil {
+			return start, end, "结束日期格式错误，应为YYYY-MM-DD"
+		}
+		start = end.AddDate(0, 0, 1-statsDefaultDays)
+	}
+	if s := strings.TrimSpace(startDate); s != "" {
+		if start, err = time.ParseInLocation(time.DateOnly, s, now.Location()); err != nil {
+			return start, end, "开始日期格式错误，应为YYYY-MM-DD"
+		}
+	}
+	if start.After(end) {
+		return start, end, "开始日期不能晚于结束日期"
+	}
+	if end.Sub(start) >= statsMaxDays*24*time.Hour {
+		return start, end, "查询区间不能超过366天"
+	}
+	return start, end, ""
+}
+
+// loadCouponStat 查询优惠券累计统计，从未统计过时立即重算一次
+func loadCouponStat(ctx context.Context, couponID uint64) (*model.CouponStat, error) {
+	var stat model.CouponStat
+	err := db.MysqlDB.WithContext(ctx).Where("coupon_id = ?", couponID).First(&stat).Error
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return &stat, err
+	}
+	if err = refreshCouponStats(ctx, couponID); err != nil {
+		return nil, err
+	}
+	err = db.MysqlDB.WithContext(ctx).Where("coupon_id = ?", couponID).First(&stat).Error
+	return &stat, err
+}
+
+// spotStats 按景点汇总日统计中的使用数据，按优惠金额降序
+func spotStats(ctx context.Context, daily []model.CouponStatDaily) ([]*coupon.CouponSpotStat, error) {
+	type spotSum struct {
+		used                    int64
+		discountCents, gmvCents int64
+	}
+	sums := make(map[uint64]*spotSum)
+	var ids []uint64
+	for i := range daily {
+		d := &daily[i]
+		if d.SpotID == 0 {
+			continue
+		}
+		sum, ok := sums[d.SpotID]
+		if !ok {
+			sum = &spotSum{}
+			sums[d.SpotID] = sum
+			ids = append(ids, d.SpotID)
+		}
+		sum.used += int64(d.UsedCount)
+		sum.discountCents += pricing.ToCents(d.DiscountAmount)
+		sum.gmvCents += pricing.ToCents(d.GmvAmount)
+	}
+	list := make([]*coupon.CouponSpotStat, 0, len(ids))
+	if len(ids) == 0 {
+		return list, nil
+	}
+	var spots []model.SpotInfo
+	if err := db.MysqlDB.WithContext(ctx).Unscoped().Select("id, spot_name").Where("id IN ?", ids).Find(&spots).Error; err != nil {
+		return nil, err
+	}
+	names := make(map[uint64]string, len(spots))
+	for _, sp := range spots {
+		names[sp.ID] = sp.SpotName
+	}
+	for _, id := range ids {
+		sum := sums[id]
+		list = append(list, &coupon.CouponSpotStat{
+			SpotId:         int64(id),
+			SpotName:       names[id],
+			UsedCount:      sum.used,
+			DiscountAmount: pricing.FromCents(sum.discountCents),
+			GmvAmount:      pricing.FromCents(sum.gmvCents),
+		})
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].DiscountAmount != list[j].DiscountAmount {
+			return list[i].DiscountAmount > list[j].DiscountAmount
+		}
+		return list[i].SpotId < list[j].SpotId
+	})
+	return list, nil
+}
+
+// toStatsSummary 转换累计统计，剩余库存取实时值
+func toStatsSummary(c *model.Coupon, stat *model.CouponStat) *coupon.CouponStatsSummary {
+	summary := &coupon.CouponStatsSummary{
+		CouponId:       int64(c.ID),
+		CouponName:     c.CouponName,
+		RemainingStock: int64(c.Stock),
+		IssuedCount:    int64(stat.IssuedCount),
+		UnusedCount:    int64(stat.UnusedCount),
+		LockedCount:    int64(stat.LockedCount),
+		UsedCount:      int64(stat.UsedCount),
+		ExpiredCount:   int64(stat.ExpiredCount),
+		DiscountAmount: stat.DiscountAmount,
+		GmvAmount:      stat.GmvAmount,
+		RefreshedAt:    stat.RefreshedAt.Unix(),
+	}
+	if stat.IssuedCount > 0 {
+		summary.RedemptionRate = float64(stat.UsedCount) / float64(stat.IssuedCount)
+	}
+	return summary
+}
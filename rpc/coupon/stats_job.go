@@ -0,0 +1,181 @@
+package coupon
+
+import (
+	"context"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/pricing"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	statsInterval    = 10 * time.Minute // 统计刷新执行间隔
+	statsMaxCoupons  = 200              // 单次最多重算的优惠券数，剩余的下次继续
+	statsInsertBatch = 500              // 日统计批量写入行数
+)
+
+// runCouponStats 重算从未统计过或统计后用户优惠券有变动的优惠券
+func runCouponStats(ctx context.Context) error {
+	start := time.Now()
+	ids, err := staleStatCoupons(ctx, statsMaxCoupons)
+	if err != nil {
+		return err
+	}
+	var refreshed, failed int
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		if err = refreshCouponStats(ctx, id); err != nil {
+			log.Printf("[job] 重算优惠券统计失败, coupon_id=%d: %v", id, err)
+			failed++
+			continue
+		}
+		refreshed++
+	}
+	if len(ids) > 0 {
+		log.Printf("[job] 优惠券统计刷新完成: 成功 %d 张, 失败 %d 张, 耗时%s", refreshed, failed, time.Since(start))
+	}
+	return nil
+}
+
+// staleStatCoupons 返回需要重算统计的优惠券ID：先取从未统计过的，再按刷新时间从早到晚取有变动的
+func staleStatCoupons(ctx context.Context, limit int) ([]uint64, error) {
+	var ids []uint64
+	err := db.MysqlDB.WithContext(ctx).Model(&model.Coupon{}).
+		Where("NOT EXISTS (SELECT 1 FROM coupon_stat s WHERE s.coupon_id = coupon.id)").
+		Order("id").Limit(limit).Pluck("id", &ids).Error
+	if err != nil || len(ids) >= limit {
+		return ids, err
+	}
+	// updated_at 精度为秒，使用 >= 保证刷新同一秒内的变动不会漏算
+	var stale []uint64
+	err = db.MysqlDB.WithContext(ctx).Model(&model.CouponStat{}).
+		Where("EXISTS (SELECT 1 FROM user_coupon uc WHERE uc.coupon_id = coupon_stat.coupon_id AND uc.updated_at >= coupon_stat.refreshed_at)").
+		Order("refreshed_at").Limit(limit-len(ids)).Pluck("coupon_id", &stale).Error
+	return append(ids, stale...), err
+}
+
+// statDayKey 日统计聚合键
+type statDayKey struct {
+	date   string
+	spotID uint64
+}
+
+// refreshCouponStats 在同一事务内全量重算单张优惠券的累计与日统计，替换原有统计数据
+func refreshCouponStats(ctx context.Context, couponID uint64) error {
+	refreshedAt := time.Now().Truncate(time.Second)
+	return db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stat := model.CouponStat{CouponID: couponID, RefreshedAt: refreshedAt}
+		var statusRows []struct {
+			UseStatus string
+			Cnt       uint32
+		}
+		err := tx.Model(&model.UserCoupon{}).Select("use_status, COUNT(*) AS cnt").
+			Where("coupon_id = ?", couponID).Group("use_status").Scan(&statusRows).Error
+		if err != nil {
+			return err
+		}
+		// 转赠会生成新记录，已转赠的原记录不计入发放数，保证各状态张数之和等于发放数
+		for _, r := range statusRows {
+			if r.UseStatus != constant.UseStatusTransferred {
+				stat.IssuedCount += r.Cnt
+			}
+			switch r.UseStatus {
+			case constant.UseStatusUnused:
+				stat.UnusedCount = r.Cnt
+			case constant.UseStatusLocked:
+				stat.LockedCount = r.Cnt
+			case constant.UseStatusUsed:
+				stat.UsedCount = r.Cnt
+			case constant.UseStatusExpired:
+				stat.ExpiredCount = r.Cnt
+			}
+		}
+
+		days := make(map[statDayKey]*model.CouponStatDaily)
+		var keys []statDayKey
+		row := func(date time.Time, spotID uint64) *model.CouponStatDaily {
+			key := statDayKey{date.Format(time.DateOnly), spotID}
+			d, ok := days[key]
+			if !ok {
+				d = &model.CouponStatDaily{CouponID: couponID, StatDate: date, SpotID: spotID}
+				days[key] = d
+				keys = append(keys, key)
+			}
+			return d
+		}
+
+		var counts []struct {
+			StatDate time.Time
+			Cnt      uint32
+		}
+		err = tx.Model(&model.UserCoupon{}).Select("DATE(created_at) AS stat_date, COUNT(*) AS cnt").
+			Where("coupon_id = ? AND source_id = 0", couponID).Group("stat_date").Scan(&counts).Error
+		if err != nil {
+			return err
+		}
+		for _, c := range counts {
+			row(c.StatDate, 0).ClaimedCount = c.Cnt
+		}
+		counts = nil
+		err = tx.Model(&model.UserCoupon{}).Select("DATE(valid_end_time) AS stat_date, COUNT(*) AS cnt").
+			Where("coupon_id = ? AND use_status = ?", couponID, constant.UseStatusExpired).
+			Group("stat_date").Scan(&counts).Error
+		if err != nil {
+			return err
+		}
+		for _, c := range counts {
+			row(c.StatDate, 0).ExpiredCount = c.Cnt
+		}
+
+		// 优惠金额取订单优惠券关联中的分摊额，历史单券订单无关联记录时取订单总额与实付之差
+		var used []struct {
+			StatDate time.Time
+			SpotID   uint64
+			Cnt      uint32
+			Discount float64
+			Gmv      float64
+		}
+		err = tx.Table("user_coupon AS uc").
+			Select("DATE(COALESCE(uc.use_time, uc.updated_at)) AS stat_date, om.spot_id, COUNT(*) AS cnt, "+
+				"SUM(COALESCE(oc.discount_amount, om.total_amount - om.pay_amount)) AS discount, SUM(om.total_amount) AS gmv").
+			Joins("JOIN order_main AS om ON om.id = uc.order_id AND om.deleted_at IS NULL").
+			Joins("LEFT JOIN order_coupon AS oc ON oc.order_id = uc.order_id AND oc.user_coupon_id = uc.id").
+			Where("uc.coupon_id = ? AND uc.use_status = ? AND uc.deleted_at IS NULL", couponID, constant.UseStatusUsed).
+			Group("stat_date, om.spot_id").Scan(&used).Error
+		if err != nil {
+			return err
+		}
+		var discountCents, gmvCents int64
+		for _, u := range used {
+			d := row(u.StatDate, u.SpotID)
+			d.UsedCount = u.Cnt
+			d.DiscountAmount = u.Discount
+			d.GmvAmount = u.Gmv
+			discountCents += pricing.ToCents(u.Discount)
+			gmvCents += pricing.ToCents(u.Gmv)
+		}
+		stat.DiscountAmount = pricing.FromCents(discountCents)
+		stat.GmvAmount = pricing.FromCents(gmvCents)
+
+		if err = tx.Where("coupon_id = ?", couponID).Delete(&model.CouponStatDaily{}).Error; err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			list := make([]*model.CouponStatDaily, 0, len(keys))
+			for _, key := range keys {
+				list = append(list, days[key])
+			}
+			if err = tx.CreateInBatches(list, statsInsertBatch).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&stat).Error
+	})
+}
@@ -0,0 +1,337 @@
+package coupon
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/couponrule"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/kitex_gen/coupon"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// transferTTL 转赠链接有效期，不超过优惠券本身的有效期
+const transferTTL = 24 * time.Hour
+
+var (
+	errTransferNotFound    = errors.New("转赠链接不存在")
+	errTransferUnavailable = errors.New("转赠链接已失效")
+	errTransferExpired     = errors.New("转赠链接已过期")
+	errTransferSelf        = errors.New("不能领取自己转赠的优惠券")
+	errTransferSourceGone  = errors.New("优惠券已被使用或转赠")
+)
+
+// CreateCouponTransfer 为未使用的用户优惠券创建转赠链接，之前未领取的链接同时失效
+func (s *CouponService) CreateCouponTransfer(ctx context.Context, req *coupon.CreateCouponTransferReq) (*coupon.CreateCouponTransferResp, error) {
+	resp := &coupon.CreateCouponTransferResp{}
+	if req.UserId <= 0 || req.UserCouponId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID或用户优惠券ID不合法")
+		return resp, nil
+	}
+	var uc model.UserCoupon
+	err := db.MysqlDB.WithContext(ctx).Preload("Coupon").
+		Where("id = ? AND user_id = ?", req.UserCouponId, req.UserId).First(&uc).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.Base = fail(constant.CodeNotFound, "优惠券不存在")
+			return resp, nil
+		}
+		log.Printf("查询用户优惠券失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "创建转赠失败")
+		return resp, nil
+	}
+	now := time.Now()
+	if err = checkTransferable(&uc, now); err != nil {
+		resp.Base = fail(constant.CodeConflict, err.Error())
+		return resp, nil
+	}
+	token, err := newTransferToken()
+	if err != nil {
+		log.Printf("生成转赠凭证失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "创建转赠失败")
+		return resp, nil
+	}
+	t := &model.CouponTransfer{
+		Token:          token,
+		UserCouponID:   uc.ID,
+		CouponID:       uc.CouponID,
+		FromUserID:     uc.UserID,
+		TransferStatus: constant.TransferStatusPending,
+		ExpireAt:       now.Add(transferTTL),
+	}
+	if uc.ValidEndTime.Before(t.ExpireAt) {
+		t.ExpireAt = uc.ValidEndTime
+	}
+	err = db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.CouponTransfer{}).
+			Where("user_coupon_id = ? AND transfer_status = ?", uc.ID, constant.TransferStatusPending).
+			Updates(map[string]interface{}{
+				"transfer_status": constant.TransferStatusCancelled,
+				"cancelled_at":    now,
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(t).Error
+	})
+	if err != nil {
+		log.Printf("创建转赠失败, user_coupon_id=%d: %v", uc.ID, err)
+		resp.Base = fail(constant.CodeServerError, "创建转赠失败")
+		return resp, nil
+	}
+	resp.Token = t.Token
+	resp.ExpireAt = t.ExpireAt.Unix()
+	resp.Base = success("创建成功")
+	return resp, nil
+}
+
+// AcceptCouponTransfer 受赠人领取转赠：原记录置为已转赠，同时为受赠人创建新记录，在同一事务内完成
+func (s *CouponService) AcceptCouponTransfer(ctx context.Context, req *coupon.AcceptCouponTransferReq) (*coupon.AcceptCouponTransferResp, error) {
+	resp := &coupon.AcceptCouponTransferResp{}
+	token := strings.TrimSpace(req.Token)
+	if req.UserId <= 0 || token == "" {
+		resp.Base = fail(constant.CodeParamError, "用户ID或转赠凭证不合法")
+		return resp, nil
+	}
+	userID := uint64(req.UserId)
+	var received *model.UserCoupon
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var t model.CouponTransfer
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("token = ?", token).First(&t).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errTransferNotFound
+		}
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		switch {
+		case t.TransferStatus != constant.TransferStatusPending:
+			return errTransferUnavailable
+		case !now.Before(t.ExpireAt):
+			return errTransferExpired
+		case t.FromUserID == userID:
+			return errTransferSelf
+		}
+
+		var uc model.UserCoupon
+		if err = tx.Preload("Coupon").Where("id = ?", t.UserCouponID).First(&uc).Error; err != nil {
+			return err
+		}
+		if uc.UserID != t.FromUserID {
+			return errTransferSourceGone
+		}
+		if err = checkTransferable(&uc, now); err != nil {
+			return err
+		}
+		if err = checkClaimRules(tx, uc.Coupon, userID); err != nil {
+			return err
+		}
+		ext, _ := uc.Coupon.Ext()
+		var held int64
+		err = tx.Model(&model.UserCoupon{}).Where("coupon_id = ? AND user_id = ?", uc.CouponID, userID).Count(&held).Error
+		if err != nil {
+			return err
+		}
+		if held >= int64(perUserLimit(ext)) {
+			return &errNotClaimable{"已达到该优惠券每人限领数量"}
+		}
+
+		result := tx.Model(&model.UserCoupon{}).
+			Where("id = ? AND user_id = ? AND use_status = ?", uc.ID, t.FromUserID, constant.UseStatusUnused).
+			Update("use_status", constant.UseStatusTransferred)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errTransferSourceGone
+		}
+		received = &model.UserCoupon{
+			UserID:         userID,
+			CouponID:       uc.CouponID,
+			CouponName:     uc.CouponName,
+			Denomination:   uc.Denomination,
+			MinUseAmount:   uc.MinUseAmount,
+			ValidStartTime: uc.ValidStartTime,
+			ValidEndTime:   uc.ValidEndTime,
+			UseStatus:      constant.UseStatusUnused,
+			TransferCount:  uc.TransferCount + 1,
+			SourceID:       uc.ID,
+		}
+		if err = tx.Create(received).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.CouponTransfer{}).
+			Where("id = ? AND transfer_status = ?", t.ID, constant.TransferStatusPending).
+			Updates(map[string]interface{}{
+				"transfer_status":    constant.TransferStatusClaimed,
+				"to_user_id":         userID,
+				"new_user_coupon_id": received.ID,
+				"claimed_at":         now,
+			}).Error
+	})
+	if err != nil {
+		resp.Base = transferErrResp(err)
+		return resp, nil
+	}
+	clearUserClaimCache(ctx, received.CouponID, []uint64{userID})
+	resp.UserCouponId = int64(received.ID)
+	resp.Base = success("领取成功")
+	return resp, nil
+}
+
+// CancelCouponTransfer 转赠人取消未被领取的转赠链接
+func (s *CouponService) CancelCouponTransfer(ctx context.Context, req *coupon.CancelCouponTransferReq) (*coupon.CancelCouponTransferResp, error) {
+	resp := &coupon.CancelCouponTransferResp{}
+	token := strings.TrimSpace(req.Token)
+	if req.UserId <= 0 || token == "" {
+		resp.Base = fail(constant.CodeParamError, "用户ID或转赠凭证不合法")
+		return resp, nil
+	}
+	result := db.MysqlDB.WithContext(ctx).Model(&model.CouponTransfer{}).
+		Where("token = ? AND from_user_id = ? AND transfer_status = ?", token, req.UserId, constant.TransferStatusPending).
+		Updates(map[string]interface{}{
+			"transfer_status": constant.TransferStatusCancelled,
+			"cancelled_at":    time.Now(),
+		})
+	if result.Error != nil {
+		log.Printf("取消转赠失败: %v", result.Error)
+		resp.Base = fail(constant.CodeServerError, "取消转赠失败")
+		return resp, nil
+	}
+	if result.RowsAffected == 0 {
+		var count int64
+		err := db.MysqlDB.WithContext(ctx).Model(&model.CouponTransfer{}).
+			Where("token = ? AND from_user_id = ?", token, req.UserId).Count(&count).Error
+		switch {
+		case err != nil:
+			log.Printf("查询转赠记录失败: %v", err)
+			resp.Base = fail(constant.CodeServerError, "取消转赠失败")
+		case count == 0:
+			resp.Base = fail(constant.CodeNotFound, errTransferNotFound.Error())
+		default:
+			resp.Base = fail(constant.CodeConflict, "转赠已被领取或已取消")
+		}
+		return resp, nil
+	}
+	resp.Base = success("取消成功")
+	return resp, nil
+}
+
+// ListCouponTransfers 查询用户转出与收到的转赠记录
+func (s *CouponService) ListCouponTransfers(ctx context.Context, req *coupon.ListCouponTransfersReq) (*coupon.ListCouponTransfersResp, error) {
+	resp := &coupon.ListCouponTransfersResp{}
+	if req.UserId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID不合法")
+		return resp, nil
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	query := db.MysqlDB.WithContext(ctx).Model(&model.CouponTransfer{}).
+		Where("from_user_id = ? OR to_user_id = ?", req.UserId, req.UserId)
+	if req.UserCouponId > 0 {
+		query = query.Where("user_coupon_id = ? OR new_user_coupon_id = ?", req.UserCouponId, req.UserCouponId)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Printf("查询转赠记录总数失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询转赠记录失败")
+		return resp, nil
+	}
+	var list []model.CouponTransfer
+	if err := query.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&list).Error; err != nil {
+		log.Printf("查询转赠记录失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询转赠记录失败")
+		return resp, nil
+	}
+	now := time.Now()
+	resp.Transfers = make([]*coupon.CouponTransferInfo, 0, len(list))
+	for i := range list {
+		resp.Transfers = append(resp.Transfers, toTransferInfo(&list[i], now))
+	}
+	resp.Total = total
+	resp.Base = success("查询成功")
+	return resp, nil
+}
+
+// checkTransferable 校验用户优惠券当前能否转赠：未使用、未过期且规则允许
+func checkTransferable(uc *model.UserCoupon, now time.Time) error {
+	if uc.Coupon == nil {
+		return errTransferSourceGone
+	}
+	if uc.UseStatus != constant.UseStatusUnused {
+		return &errNotClaimable{"仅未使用的优惠券可转赠"}
+	}
+	if !now.Before(uc.ValidEndTime) {
+		return &errNotClaimable{"优惠券已过期"}
+	}
+	ext, err := uc.Coupon.Ext()
+	if err != nil {
+		return &errNotClaimable{"优惠券配置错误"}
+	}
+	if err = couponrule.CheckTransfer(ext, int(uc.TransferCount)); err != nil {
+		return &errNotClaimable{err.Error()}
+	}
+	return nil
+}
+
+// newTransferToken 生成32位十六进制随机转赠凭证
+func newTransferToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// transferErrResp 将转赠领取的错误转换为响应
+func transferErrResp(err error) *coupon.BaseResp {
+	var nc *errNotClaimable
+	switch {
+	case errors.As(err, &nc):
+		return fail(constant.CodeConflict, nc.msg)
+	case errors.Is(err, errTransferNotFound):
+		return fail(constant.CodeNotFound, err.Error())
+	case errors.Is(err, errTransferUnavailable), errors.Is(err, errTransferExpired),
+		errors.Is(err, errTransferSelf), errors.Is(err, errTransferSourceGone):
+		return fail(constant.CodeConflict, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fail(constant.CodeNotFound, "优惠券不存在")
+	default:
+		log.Printf("领取转赠失败: %v", err)
+		return fail(constant.CodeServerError, "领取失败，请稍后重试")
+	}
+}
+
+// toTransferInfo 转换转赠记录，已过期未领取的记录展示为 EXPIRED
+func toTransferInfo(t *model.CouponTransfer, now time.Time) *coupon.CouponTransferInfo {
+	info := &coupon.CouponTransferInfo{
+		Id:                   int64(t.ID),
+		UserCouponId:         int64(t.UserCouponID),
+		CouponId:             int64(t.CouponID),
+		FromUserId:           int64(t.FromUserID),
+		ToUserId:             int64(t.ToUserID),
+		ReceivedUserCouponId: int64(t.NewUserCouponID),
+		TransferStatus:       t.TransferStatus,
+		ExpireAt:             t.ExpireAt.Unix(),
+		CreatedAt:            t.CreatedAt.Unix(),
+	}
+	if t.TransferStatus == constant.TransferStatusPending && !now.Before(t.ExpireAt) {
+		info.TransferStatus = constant.TransferStatusExpired
+	}
+	if t.ClaimedAt != nil {
+		info.ClaimedAt = t.ClaimedAt.Unix()
+	}
+	if t.CancelledAt != nil {
+		info.CancelledAt = t.CancelledAt.Unix()
+	}
+	return info
+}
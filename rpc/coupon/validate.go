@@ -0,0 +1,93 @@
+package coupon
+
+import (
+	"encoding/json"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/couponrule"
+	"example_shop/common/model"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// validateCoupon 校验优惠券配置是否合法
+func validateCoupon(c *model.Coupon) error {
+	name := strings.TrimSpace(c.CouponName)
+	if name == "" {
+		return errors.New("优惠券名称不能为空")
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return errors.New("优惠券名称不能超过100个字符")
+	}
+	switch c.CouponType {
+	case constant.CouponTypeFixed:
+		if c.Denomination <= 0 {
+			return errors.New("满减券面额必须大于0")
+		}
+		if c.MinUseAmount > 0 && c.Denomination > c.MinUseAmount {
+			return errors.New("满减券面额不能大于最低使用金额")
+		}
+	case constant.CouponTypeDiscount:
+		if c.Denomination <= 0 || c.Denomination >= 1 {
+			return errors.New("折扣券折扣率必须在0到1之间")
+		}
+	default:
+		return errors.New("优惠券类型只能是FIXED或DISCOUNT")
+	}
+	if c.MinUseAmount < 0 {
+		return errors.New("最低使用金额不能小于0")
+	}
+	if c.ValidStartTime.IsZero() || c.ValidEndTime.IsZero() {
+		return errors.New("有效期不能为空")
+	}
+	if !c.ValidEndTime.After(c.ValidStartTime) {
+		return errors.New("有效期结束时间必须晚于开始时间")
+	}
+	switch c.IssuerType {
+	case constant.IssuerTypePlatform:
+		if c.MerchantID != 0 {
+			return errors.New("平台券不能指定发券商家")
+		}
+	case constant.IssuerTypeMerchant:
+		if c.MerchantID == 0 {
+			return errors.New("商家券必须指定发券商家")
+		}
+	default:
+		return errors.New("发券方只能是PLATFORM或MERCHANT")
+	}
+	if utf8.RuneCountInString(c.StackGroup) > 50 {
+		return errors.New("互斥组名称不能超过50个字符")
+	}
+	if c.ExtFields != nil {
+		if !json.Valid(*c.ExtFields) {
+			return errors.New("扩展字段不是合法的JSON")
+		}
+		if _, err := couponrule.Parse(*c.ExtFields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseSpotIDs 解析逗号分隔的景点ID并去重
+func parseSpotIDs(raw string) ([]uint64, error) {
+	seen := make(map[uint64]struct{})
+	var ids []uint64
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil || id == 0 {
+			return nil, errors.New("适用景点ID格式错误: " + part)
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
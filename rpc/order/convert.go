@@ -0,0 +1,76 @@
+package order
+
+import (
+	"example_shop/common/model"
+	"example_shop/kitex_gen/order"
+	"time"
+)
+
+// toOrderInfo 模型转换为IDL结构
+func toOrderInfo(o *model.OrderMain) *order.OrderInfo {
+	info := &order.OrderInfo{
+		Id:          int64(o.ID),
+		OrderNo:     o.OrderNo,
+		UserId:      int64(o.UserID),
+		MerchantId:  int64(o.MerchantID),
+		SpotId:      int64(o.SpotID),
+		TotalAmount: o.TotalAmount,
+		PayAmount:   o.PayAmount,
+		CouponId:    int64(o.CouponID),
+		OrderStatus: o.OrderStatus,
+		CreatedAt:   o.CreatedAt.Unix(),
+		Items:       make([]*order.OrderItemInfo, 0, len(o.OrderItems)),
+	}
+	if o.PayType != nil {
+		info.PayType = *o.PayType
+	}
+	info.PayTime = unixOrZero(o.PayTime)
+	info.CancelTime = unixOrZero(o.CancelTime)
+	info.VerifyTime = unixOrZero(o.VerifyTime)
+	info.RefundTime = unixOrZero(o.RefundTime)
+	if o.VisitDate != nil {
+		info.VisitDate = o.VisitDate.Format(time.DateOnly)
+	}
+	info.RescheduleCount = int32(o.RescheduleCount)
+	for i := range o.OrderItems {
+		it := &o.OrderItems[i]
+		info.Items = append(info.Items, &order.OrderItemInfo{
+			Id:           int64(it.ID),
+			TicketTypeId: int64(it.TicketTypeID),
+			TravelerId:   int64(it.TravelerID),
+			TicketName:   it.TicketName,
+			SinglePrice:  it.SinglePrice,
+			TicketNum:    int32(it.TicketNum),
+			PayAmount:    it.PayAmount,
+			ItemStatus:   it.ItemStatus,
+			VerifyTime:   unixOrZero(it.VerifyTime),
+			RefundAmount: it.RefundAmount,
+			RefundTime:   unixOrZero(it.RefundTime),
+		})
+	}
+	return info
+}
+
+// toStatusLogInfos 转换订单状态流转记录
+func toStatusLogInfos(list []model.OrderStatusLog) []*order.OrderStatusLogInfo {
+	infos := make([]*order.OrderStatusLogInfo, 0, len(list))
+	for i := range list {
+		l := &list[i]
+		infos = append(infos, &order.OrderStatusLogInfo{
+			FromStatus: l.FromStatus,
+			ToStatus:   l.ToStatus,
+			Operator:   l.Operator,
+			Reason:     l.Reason,
+			CreatedAt:  l.CreatedAt.Unix(),
+		})
+	}
+	return infos
+}
+
+// unixOrZero 可空时间转秒级时间戳，nil返回0
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,356 @@
+package order
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/idgen"
+	"example_shop/common/model"
+	"example_shop/common/orderstate"
+	"example_shop/common/pricing"
+	"example_shop/common/redeem"
+	"example_shop/common/stock"
+	"example_shop/kitex_gen/order"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxOrderTravelers = 20                    // 单个订单最多出行人次
+	maxStockAttempts  = 3                     // 乐观锁扣库存冲突时整单重试的最大次数
+	stockRetryBackoff = 20 * time.Millisecond // 重试间隔基数，按次数递增并加随机抖动
+)
+
+// errOrderParam 下单参数错误，消息可直接返回给调用方
+type errOrderParam struct{ msg string }
+
+func (e *errOrderParam) Error() string { return e.msg }
+
+// orderLine 一种门票及其出行人
+type orderLine struct {
+	ticketTypeID uint64
+	travelerIDs  []uint64
+}
+
+// CreateOrder 创建订单：按门票售价计价，乐观锁扣减库存，主订单与每位出行人的明细在同一事务内写入
+func (s *OrderService) CreateOrder(ctx context.Context, req *order.CreateOrderReq) (*order.CreateOrderResp, error) {
+	resp := &order.CreateOrderResp{}
+	if req.UserId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID不合法")
+		return resp, nil
+	}
+	userID := uint64(req.UserId)
+	lines, travelerIDs, err := parseOrderTickets(req.Tickets)
+	if err != nil {
+		resp.Base = createErrResp(err)
+		return resp, nil
+	}
+	payType := strings.TrimSpace(req.PayType)
+	if payType != "" && payType != constant.PayTypeWechat && payType != constant.PayTypeAlipay {
+		resp.Base = fail(constant.CodeParamError, "支付方式不合法")
+		return resp, nil
+	}
+	visitDate, err := parseVisitDate(req.VisitDate, time.Now())
+	if err != nil {
+		resp.Base = createErrResp(err)
+		return resp, nil
+	}
+	couponIDs, ok := dedupeIDs(req.UserCouponIds)
+	if !ok {
+		resp.Base = fail(constant.CodeParamError, "用户优惠券ID不合法")
+		return resp, nil
+	}
+	if err = checkTravelers(ctx, userID, travelerIDs); err != nil {
+		resp.Base = createErrResp(err)
+		return resp, nil
+	}
+
+	var o *model.OrderMain
+	for attempt := 1; ; attempt++ {
+		o, err = placeOrder(ctx, userID, lines, payType, visitDate, couponIDs)
+		if !errors.Is(err, stock.ErrVersionConflict) || attempt >= maxStockAttempts {
+			break
+		}
+		time.Sleep(retryDelay(attempt))
+	}
+	if err != nil {
+		resp.Base = createErrResp(err)
+		return resp, nil
+	}
+	schedulePayTimeout(ctx, o)
+	resp.Order = toOrderInfo(o)
+	resp.Base = success("下单成功")
+	return resp, nil
+}
+
+// parseOrderTickets 校验下单门票，同一门票合并为一行，返回各行与全部出行人ID
+func parseOrderTickets(tickets []*order.OrderTicketReq) ([]*orderLine, []uint64, error) {
+	if len(tickets) == 0 {
+		return nil, nil, &errOrderParam{"购票明细不能为空"}
+	}
+	var lines []*orderLine
+	byTicket := make(map[uint64]*orderLine)
+	seen := make(map[[2]uint64]struct{})
+	travelerSet := make(map[uint64]struct{})
+	var travelerIDs []uint64
+	total := 0
+	for _, t := range tickets {
+		if t == nil || t.TicketTypeId <= 0 {
+			return nil, nil, &errOrderParam{"门票类型ID不合法"}
+		}
+		if len(t.TravelerIds) == 0 {
+			return nil, nil, &errOrderParam{"每种门票至少选择一位出行人"}
+		}
+		ticketTypeID := uint64(t.TicketTypeId)
+		line, ok := byTicket[ticketTypeID]
+		if !ok {
+			line = &orderLine{ticketTypeID: ticketTypeID}
+			byTicket[ticketTypeID] = line
+			lines = append(lines, line)
+		}
+		for _, id := range t.TravelerIds {
+			if id <= 0 {
+				return nil, nil, &errOrderParam{"出行人ID不合法"}
+			}
+			key := [2]uint64{ticketTypeID, uint64(id)}
+			if _, dup := seen[key]; dup {
+				return nil, nil, &errOrderParam{"同一出行人不能重复购买同一门票"}
+			}
+			seen[key] = struct{}{}
+			line.travelerIDs = append(line.travelerIDs, uint64(id))
+			if _, ok := travelerSet[uint64(id)]; !ok {
+				travelerSet[uint64(id)] = struct{}{}
+				travelerIDs = append(travelerIDs, uint64(id))
+			}
+			total++
+		}
+	}
+	if total > maxOrderTravelers {
+		return nil, nil, &errOrderParam{fmt.Sprintf("单个订单最多购买%d张门票", maxOrderTravelers)}
+	}
+	return lines, travelerIDs, nil
+}
+
+// checkTravelers 校验出行人均属于下单用户且实名信息完整
+func checkTravelers(ctx context.Context, userID uint64, ids []uint64) error {
+	var travelers []model.Traveler
+	err := db.MysqlDB.WithContext(ctx).Select("id", "real_name", "id_card").
+		Where("id IN ? AND user_id = ?", ids, userID).Find(&travelers).Error
+	if err != nil {
+		return err
+	}
+	found := make(map[uint64]*model.Traveler, len(travelers))
+	for i := range travelers {
+		found[travelers[i].ID] = &travelers[i]
+	}
+	for _, id := range ids {
+		t, ok := found[id]
+		if !ok {
+			return &errOrderParam{fmt.Sprintf("出行人%d不存在", id)}
+		}
+		if strings.TrimSpace(t.RealName) == "" || strings.TrimSpace(t.IDCard) == "" {
+			return &errOrderParam{fmt.Sprintf("出行人%d实名信息不完整", id)}
+		}
+	}
+	return nil
+}
+
+// parseVisitDate 解析游玩日期，为空返回nil，不能早于今天
+func parseVisitDate(s string, now time.Time) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	d, err := time.ParseInLocation(time.DateOnly, s, now.Location())
+	if err != nil {
+		return nil, &errOrderParam{"游玩日期格式错误，应为YYYY-MM-DD"}
+	}
+	if d.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())) {
+		return nil, &errOrderParam{"游玩日期不能早于今天"}
+	}
+	return &d, nil
+}
+
+// checkTicketDate 门票需在游玩日期当天有效，未指定游玩日期时需未过有效期
+func checkTicketDate(t *model.TicketType, visitDate *time.Time, today time.Time) error {
+	if visitDate == nil {
+		if t.ValidEndTime.Valid && t.ValidEndTime.Time.Before(today) {
+			return &errOrderParam{fmt.Sprintf("门票%s已过有效期", t.TicketName)}
+		}
+		return nil
+	}
+	day := dayOf(*visitDate)
+	if t.ValidStartTime.Valid && day.Before(dayOf(t.ValidStartTime.Time)) ||
+		t.ValidEndTime.Valid && day.After(dayOf(t.ValidEndTime.Time)) {
+		return &errOrderParam{fmt.Sprintf("门票%s在%s不可用", t.TicketName, visitDate.Format(time.DateOnly))}
+	}
+	return nil
+}
+
+// dayOf 取本地时区的日期部分，DATE 列读出的时间可能不在本地时区
+func dayOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// placeOrder 在一个事务内读取门票、扣减库存并写入订单与明细，指定优惠券时同时锁券
+// 库存版本冲突时返回 stock.ErrVersionConflict，由调用方重试整个事务以读取最新版本
+func placeOrder(ctx context.Context, userID uint64, lines []*orderLine, payType string, visitDate *time.Time, couponIDs []uint64) (*model.OrderMain, error) {
+	orderNo, err := idgen.OrderNo(userID)
+	if err != nil {
+		return nil, err
+	}
+	o := &model.OrderMain{OrderNo: orderNo, UserID: userID, OrderStatus: string(orderstate.PendingPay), VisitDate: visitDate}
+	if payType != "" {
+		o.PayType = &payType
+	}
+	err = db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ids := make([]uint64, 0, len(lines))
+		for _, line := range lines {
+			ids = append(ids, line.ticketTypeID)
+		}
+		var tickets []model.TicketType
+		if err := tx.Where("id IN ?", ids).Find(&tickets).Error; err != nil {
+			return err
+		}
+		ticketMap := make(map[uint64]*model.TicketType, len(tickets))
+		for i := range tickets {
+			ticketMap[tickets[i].ID] = &tickets[i]
+		}
+
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		var totalCents int64
+		for _, line := range lines {
+			t, ok := ticketMap[line.ticketTypeID]
+			if !ok {
+				return &errOrderParam{fmt.Sprintf("门票类型%d不存在", line.ticketTypeID)}
+			}
+			if t.TicketStatus != constant.TicketStatusOnSale {
+				return &errOrderParam{fmt.Sprintf("门票%s未在售", t.TicketName)}
+			}
+			if err := checkTicketDate(t, visitDate, today); err != nil {
+				return err
+			}
+			if o.SpotID == 0 {
+				o.SpotID = t.SpotID
+			} else if o.SpotID != t.SpotID {
+				return &errOrderParam{"一个订单只能购买同一景点的门票"}
+			}
+			for _, travelerID := range line.travelerIDs {
+				o.OrderItems = append(o.OrderItems, model.OrderItem{
+					TicketTypeID: t.ID,
+					TravelerID:   travelerID,
+					TicketName:   t.TicketName,
+					SinglePrice:  t.Price,
+					TicketNum:    1,
+					PayAmount:    t.Price,
+					ItemStatus:   constant.ItemStatusUnused,
+				})
+				totalCents += pricing.ToCents(t.Price)
+			}
+		}
+		var spot model.SpotInfo
+		if err := tx.Select("id", "merchant_id").Where("id = ?", o.SpotID).First(&spot).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &errOrderParam{"景点不存在"}
+			}
+			return err
+		}
+		o.MerchantID = spot.MerchantID
+		o.TotalAmount = pricing.FromCents(totalCents)
+		o.PayAmount = o.TotalAmount
+
+		// 按门票ID顺序扣减，避免并发下单相互等待行锁形成死锁
+		sort.Slice(lines, func(i, j int) bool { return lines[i].ticketTypeID < lines[j].ticketTypeID })
+		for _, line := range lines {
+			if err := stock.Deduct(tx, ticketMap[line.ticketTypeID], uint32(len(line.travelerIDs))); err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(o).Error; err != nil {
+			return err
+		}
+		if err := orderstate.Created(tx, o.ID, orderstate.PendingPay, orderstate.UserOperator(userID), now); err != nil {
+			return err
+		}
+		if len(couponIDs) == 0 {
+			return nil
+		}
+		res, err := redeem.Lock(tx, userID, o.ID, couponIDs, now)
+		if err != nil {
+			return err
+		}
+		o.PayAmount = res.PayAmount
+		if len(res.Coupons) > 0 {
+			o.CouponID = res.Coupons[0].CouponID
+		}
+		// 记录各明细分摊优惠后的实付小计，供按出行人部分退款
+		for _, ip := range res.Items {
+			if ip.PayAmount == ip.Amount {
+				continue
+			}
+			err := tx.Model(&model.OrderItem{}).Where("id = ?", ip.OrderItemID).Update("pay_amount", ip.PayAmount).Error
+			if err != nil {
+				return err
+			}
+			o.OrderItems[ip.Index].PayAmount = ip.PayAmount
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// createErrResp 将下单错误转换为响应
+func createErrResp(err error) *order.BaseResp {
+	var pe *errOrderParam
+	switch {
+	case errors.As(err, &pe):
+		return fail(constant.CodeParamError, pe.msg)
+	case errors.Is(err, stock.ErrNotEnough):
+		return fail(constant.CodeConflict, err.Error())
+	case errors.Is(err, stock.ErrVersionConflict):
+		return fail(constant.CodeConflict, "当前购票人数较多，请稍后重试")
+	case pricing.IsUnusable(err), errors.Is(err, redeem.ErrNotLockable):
+		return fail(constant.CodeConflict, err.Error())
+	default:
+		log.Printf("创建订单失败: %v", err)
+		return fail(constant.CodeServerError, "下单失败，请稍后重试")
+	}
+}
+
+// dedupeIDs 去重并保持顺序，存在非法ID时返回false
+func dedupeIDs(list []int64) ([]uint64, bool) {
+	seen := make(map[int64]struct{}, len(list))
+	ids := make([]uint64, 0, len(list))
+	for _, id := range list {
+		if id <= 0 {
+			return nil, false
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, uint64(id))
+	}
+	return ids, true
+}
+
+// retryDelay 第 attempt 次冲突后的等待时间，随机抖动避免重试再次同时冲突
+func retryDelay(attempt int) time.Duration {
+	jitter, err := rand.Int(rand.Reader, big.NewInt(int64(stockRetryBackoff)))
+	if err != nil {
+		return time.Duration(attempt) * stockRetryBackoff
+	}
+	return time.Duration(attempt)*stockRetryBackoff + time.Duration(jitter.Int64())
+}
@@ -0,0 +1,47 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/kitex_gen/order"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// OrderService 订单服务实现
+type OrderService struct{}
+
+// GetOrder 查询用户订单详情及状态流转记录
+func (s *OrderService) GetOrder(ctx context.Context, req *order.GetOrderReq) (*order.GetOrderResp, error) {
+	resp := &order.GetOrderResp{}
+	if req.UserId <= 0 || req.OrderId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID或订单ID不合法")
+		return resp, nil
+	}
+	var o model.OrderMain
+	err := db.MysqlDB.WithContext(ctx).Preload("OrderItems").
+		Where("id = ? AND user_id = ?", req.OrderId, req.UserId).First(&o).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.Base = fail(constant.CodeNotFound, "订单不存在")
+			return resp, nil
+		}
+		log.Printf("查询订单失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询订单失败")
+		return resp, nil
+	}
+	var logs []model.OrderStatusLog
+	if err = db.MysqlDB.WithContext(ctx).Where("order_id = ?", o.ID).Order("id").Find(&logs).Error; err != nil {
+		log.Printf("查询订单状态记录失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询订单失败")
+		return resp, nil
+	}
+	resp.Order = toOrderInfo(&o)
+	resp.StatusLogs = toStatusLogInfos(logs)
+	resp.Base = success("查询成功")
+	return resp, nil
+}
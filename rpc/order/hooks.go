@@ -0,0 +1,84 @@
+package order
+
+import (
+	"example_shop/common/constant"
+	"example_shop/common/idgen"
+	"example_shop/common/model"
+	"example_shop/common/orderstate"
+	"example_shop/common/redeem"
+	"example_shop/common/stock"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RegisterHooks 注册订单状态变更的副作用，服务启动时调用一次
+func RegisterHooks() {
+	orderstate.OnEnter(orderstate.Paid, consumeCoupons)
+	orderstate.OnEnter(orderstate.Paid, issueVerifyCodes)
+	orderstate.OnEnter(orderstate.Cancelled, restoreStock)
+	orderstate.OnEnter(orderstate.Cancelled, releaseCoupons)
+	orderstate.OnEnter(orderstate.Refunded, releaseCoupons)
+}
+
+// consumeCoupons 支付成功后核销订单锁定的优惠券
+func consumeCoupons(tx *gorm.DB, t *orderstate.Transition, now time.Time) error {
+	_, err := redeem.Consume(tx, t.OrderID, now)
+	return err
+}
+
+// issueVerifyCodes 支付成功后生成订单核销码（核销全部出行人）与每位出行人的核销码
+func issueVerifyCodes(tx *gorm.DB, t *orderstate.Transition, _ time.Time) error {
+	return assignVerifyCodes(tx, t.OrderID)
+}
+
+// assignVerifyCodes 重新生成订单核销码与待核销出行人的核销码，旧码随之失效
+func assignVerifyCodes(tx *gorm.DB, orderID uint64) error {
+	code, err := idgen.VerifyCode()
+	if err != nil {
+		return err
+	}
+	if err = tx.Model(&model.OrderMain{}).Where("id = ?", orderID).Update("verify_code", code).Error; err != nil {
+		return err
+	}
+	var itemIDs []uint64
+	err = tx.Model(&model.OrderItem{}).Where("order_id = ? AND item_status = ?", orderID, constant.ItemStatusUnused).
+		Order("id").Pluck("id", &itemIDs).Error
+	if err != nil {
+		return err
+	}
+	for _, id := range itemIDs {
+		if code, err = idgen.VerifyCode(); err != nil {
+			return err
+		}
+		if err = tx.Model(&model.OrderItem{}).Where("id = ?", id).Update("verify_code", code).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseCoupons 订单取消或全额退款后释放优惠券
+func releaseCoupons(tx *gorm.DB, t *orderstate.Transition, now time.Time) error {
+	_, err := redeem.Release(tx, t.OrderID, now)
+	return err
+}
+
+// restoreStock 订单取消后按明细回补门票库存，按门票ID顺序更新避免死锁
+func restoreStock(tx *gorm.DB, t *orderstate.Transition, _ time.Time) error {
+	var rows []struct {
+		TicketTypeID uint64
+		Cnt          uint32
+	}
+	err := tx.Model(&model.OrderItem{}).Select("ticket_type_id, SUM(ticket_num) AS cnt").
+		Where("order_id = ?", t.OrderID).Group("ticket_type_id").Order("ticket_type_id").Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if err = stock.Restore(tx, r.TicketTypeID, r.Cnt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
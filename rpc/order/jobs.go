@@ -0,0 +1,9 @@
+package order
+
+import "example_shop/common/job"
+
+// RegisterJobs 注册订单服务的后台任务
+func RegisterJobs(s *job.Scheduler) {
+	s.Register(job.Job{Name: "order_pay_timeout", Interval: payTimeoutInterval, Run: runPayTimeout})
+	s.Register(job.Job{Name: "order_late_refund", Interval: lateRefundInterval, Run: runLateRefund})
+}
@@ -0,0 +1,88 @@
+package order
+
+import (
+	"context"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/idgen"
+	"example_shop/common/model"
+	"log"
+	"time"
+)
+
+const (
+	lateRefundInterval = time.Minute // 退款中支付记录的处理间隔
+	lateRefundBatch    = 100         // 每轮最多处理的退款中记录数
+)
+
+// Refunder 支付渠道退款，按支付方式实现
+type Refunder interface {
+	// Refund 按原支付流水原路退款，refundNo 为本系统退款单号，同一退款单号重复调用需幂等
+	Refund(ctx context.Context, tradeNo, refundNo string, amount float64) error
+}
+
+// refunders 按支付方式注册的渠道退款实现；尚未接入支付渠道退款，没有实现的支付方式的退款中记录留待人工处理
+var refunders = map[string]Refunder{}
+
+// runLateRefund 处理无法入账的支付（订单取消后到账、改期申请失效后到账）：已接入渠道退款的支付方式调用渠道原路退款，
+// 渠道受理后置为已退款；未接入的保持退款中，由人工退款后处理
+func runLateRefund(ctx context.Context) error {
+	var records []model.PayRecord
+	err := db.MysqlDB.WithContext(ctx).Select("id", "pay_type", "pay_amount", "platform_trade_no", "refund_no").
+		Where("pay_status = ?", constant.PayStatusRefunding).
+		Order("id").Limit(lateRefundBatch).Find(&records).Error
+	if err != nil {
+		return err
+	}
+	manual := 0
+	for i := range records {
+		if ctx.Err() != nil {
+			break
+		}
+		r, ok := refunders[records[i].PayType]
+		if !ok {
+			manual++
+			continue
+		}
+		if err = refundLatePay(ctx, r, &records[i]); err != nil {
+			log.Printf("支付记录%d原路退款失败: %v", records[i].ID, err)
+		}
+	}
+	if manual > 0 {
+		log.Printf("[job] %d条退款中的支付记录未接入渠道退款，需人工原路退款", manual)
+	}
+	return nil
+}
+
+// refundLatePay 调用渠道退款后将退款中记录置为已退款；退款单号先落库，渠道调用失败重试时复用同一单号
+func refundLatePay(ctx context.Context, r Refunder, p *model.PayRecord) error {
+	if p.PlatformTradeNo == nil {
+		return nil
+	}
+	if p.RefundNo == nil {
+		refundNo, err := idgen.RefundNo()
+		if err != nil {
+			return err
+		}
+		result := db.MysqlDB.WithContext(ctx).Model(&model.PayRecord{}).
+			Where("id = ? AND pay_status = ? AND refund_no IS NULL", p.ID, constant.PayStatusRefunding).
+			Update("refund_no", refundNo)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		p.RefundNo = &refundNo
+	}
+	if err := r.Refund(ctx, *p.PlatformTradeNo, *p.RefundNo, p.PayAmount); err != nil {
+		return err
+	}
+	result := db.MysqlDB.WithContext(ctx).Model(&model.PayRecord{}).
+		Where("id = ? AND pay_status = ?", p.ID, constant.PayStatusRefunding).
+		Update("pay_status", constant.PayStatusRefund)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		log.Printf("[job] 无法入账的支付已原路退回, pay_record_id=%d, 退款单号=%s", p.ID, *p.RefundNo)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"example_shop/common/config"
+	"example_shop/common/db"
+	"example_shop/common/idgen"
+	_ "example_shop/common/init"
+	"example_shop/common/job"
+	"example_shop/kitex_gen/order/orderservice"
+	"example_shop/rpc/order"
+	"log"
+	"net"
+
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/server"
+)
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	order.RegisterHooks()
+	if err := idgen.Init(ctx, db.Rdb); err != nil {
+		log.Fatalf("ID生成器初始化失败: %v", err)
+	}
+
+	// 后台定时任务，多副本仅主节点执行
+	scheduler := job.NewScheduler(db.Rdb, "order_service")
+	order.RegisterJobs(scheduler)
+	scheduler.Start(ctx)
+
+	addr, err := net.ResolveTCPAddr("tcp", config.Cfg.Order.Addr)
+	if err != nil {
+		log.Fatalf("订单服务监听地址配置错误: %v", err)
+	}
+	svr := orderservice.NewServer(
+		new(order.OrderService),
+		server.WithServiceAddr(addr),
+		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
+			ServiceName: "order_service",
+		}),
+	)
+
+	log.Println("✅ 订单服务启动成功！")
+	if err := svr.Run(); err != nil {
+		log.Println("启动失败：", err)
+	}
+}
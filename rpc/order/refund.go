@@ -0,0 +1,331 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/idgen"
+	"example_shop/common/model"
+	"example_shop/common/orderstate"
+	"example_shop/common/pricing"
+	"example_shop/common/refundrule"
+	"example_shop/common/stock"
+	"example_shop/kitex_gen/order"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// errItemRefund 出行人明细不可退款，消息可直接返回给调用方
+type errItemRefund struct{ msg string }
+
+func (e *errItemRefund) Error() string { return e.msg }
+
+// refundQuote 单个出行人的退款计算
+type refundQuote struct {
+	item       *model.OrderItem
+	payCents   int64
+	feePercent int
+	feeCents   int64
+}
+
+// ApplyRefund 申请退款：按门票退改规则的档位计算手续费，退还所选待核销出行人并回补库存，写入退款支付记录。
+// 订单内出行人全部退款时订单经 REFUNDING 变为 REFUNDED 并释放优惠券；仍有待核销出行人时保持已支付，
+// 其余均已核销时变为已核销
+func (s *OrderService) ApplyRefund(ctx context.Context, req *order.ApplyRefundReq) (*order.ApplyRefundResp, error) {
+	resp := &order.ApplyRefundResp{}
+	if req.UserId <= 0 || req.OrderId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID或订单ID不合法")
+		return resp, nil
+	}
+	itemIDs, ok := dedupeIDs(req.ItemIds)
+	if !ok {
+		resp.Base = fail(constant.CodeParamError, "退款明细ID不合法")
+		return resp, nil
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		reason = "用户申请退款"
+	}
+	if utf8.RuneCountInString(reason) > 100 {
+		resp.Base = fail(constant.CodeParamError, "退款原因不能超过100个字符")
+		return resp, nil
+	}
+
+	var quotes []refundQuote
+	var refundNo, status string
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var o model.OrderMain
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "order_no", "order_status", "pay_type", "pay_amount", "visit_date").
+			Where("id = ? AND user_id = ?", req.OrderId, req.UserId).First(&o).Error
+		if err != nil {
+			return err
+		}
+		if orderstate.Status(o.OrderStatus) != orderstate.Paid {
+			return &errItemRefund{"仅已支付且未全部核销的订单可申请退款"}
+		}
+		var items []model.OrderItem
+		err = tx.Preload("TicketType", func(q *gorm.DB) *gorm.DB {
+			return q.Unscoped().Select("id", "ticket_name", "valid_end_time", "refund_rule", "refund_policy")
+		}).Where("order_id = ?", o.ID).Order("id").Find(&items).Error
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if quotes, err = quoteRefund(&o, items, itemIDs, now); err != nil {
+			return err
+		}
+
+		// 退掉全部未核销出行人且无已核销出行人时为整单退款
+		full := true
+		for i := range items {
+			if items[i].ItemStatus == constant.ItemStatusVerified {
+				full = false
+			}
+		}
+		full = full && len(quotes) == countStatus(items, constant.ItemStatusUnused)
+		operator := orderstate.UserOperator(uint64(req.UserId))
+		if full {
+			err = orderstate.Apply(tx, &orderstate.Transition{
+				OrderID: o.ID, From: orderstate.Paid, To: orderstate.Refunding, Operator: operator, Reason: reason,
+			}, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		var refundCents, feeCents int64
+		restock := make(map[uint64]uint32)
+		refundIDs := make([]uint64, 0, len(quotes))
+		for _, q := range quotes {
+			result := tx.Model(&model.OrderItem{}).
+				Where("id = ? AND item_status = ?", q.item.ID, constant.ItemStatusUnused).
+				Updates(map[string]interface{}{
+					"item_status":   constant.ItemStatusRefunded,
+					"refund_amount": pricing.FromCents(q.payCents - q.feeCents),
+					"refund_time":   now,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return orderstate.ErrStatusChanged
+			}
+			restock[q.item.TicketTypeID] += uint32(q.item.TicketNum)
+			refundIDs = append(refundIDs, q.item.ID)
+			refundCents += q.payCents - q.feeCents
+			feeCents += q.feeCents
+		}
+		if err = restoreItemsStock(tx, restock); err != nil {
+			return err
+		}
+		err = tx.Model(&model.OrderMain{}).Where("id = ?", o.ID).
+			Update("refund_amount", gorm.Expr("refund_amount + ?", pricing.FromCents(refundCents))).Error
+		if err != nil {
+			return err
+		}
+		if refundNo, err = idgen.RefundNo(); err != nil {
+			return err
+		}
+		ext, err := json.Marshal(map[string]interface{}{
+			"item_ids":   refundIDs,
+			"fee_amount": pricing.FromCents(feeCents),
+			"reason":     reason,
+		})
+		if err != nil {
+			return err
+		}
+		extJSON := model.JSON(ext)
+		payType := ""
+		if o.PayType != nil {
+			payType = *o.PayType
+		}
+		err = tx.Create(&model.PayRecord{
+			OrderID:    o.ID,
+			OrderNo:    o.OrderNo,
+			PayType:    payType,
+			PayAmount:  pricing.FromCents(refundCents),
+			PayStatus:  constant.PayStatusRefund,
+			RefundNo:   &refundNo,
+			NotifyTime: &now,
+			ExtFields:  &extJSON,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if full {
+			status = string(orderstate.Refunded)
+			return orderstate.Apply(tx, &orderstate.Transition{
+				OrderID: o.ID, From: orderstate.Refunding, To: orderstate.Refunded, Operator: orderstate.OperatorSystem, Reason: "退款完成",
+			}, now)
+		}
+		status = string(orderstate.Paid)
+		remaining, err := orderstate.SettleItems(tx, o.ID, operator, now)
+		if err == nil && remaining == 0 {
+			status = string(orderstate.Verified)
+		}
+		return err
+	})
+	if err != nil {
+		var re *errItemRefund
+		switch {
+		case errors.As(err, &re):
+			resp.Base = fail(constant.CodeConflict, re.msg)
+		case refundrule.IsViolation(err):
+			resp.Base = fail(constant.CodeConflict, err.Error())
+		default:
+			resp.Base = statusErrResp(err, "退款失败")
+		}
+		return resp, nil
+	}
+	var refundCents, feeCents int64
+	resp.Items = make([]*order.RefundItemInfo, 0, len(quotes))
+	for _, q := range quotes {
+		refundCents += q.payCents - q.feeCents
+		feeCents += q.feeCents
+		resp.Items = append(resp.Items, &order.RefundItemInfo{
+			ItemId:       int64(q.item.ID),
+			PayAmount:    pricing.FromCents(q.payCents),
+			FeePercent:   int32(q.feePercent),
+			FeeAmount:    pricing.FromCents(q.feeCents),
+			RefundAmount: pricing.FromCents(q.payCents - q.feeCents),
+		})
+	}
+	resp.RefundAmount = pricing.FromCents(refundCents)
+	resp.FeeAmount = pricing.FromCents(feeCents)
+	resp.RefundNo = refundNo
+	resp.OrderStatus = status
+	resp.Base = success("退款成功")
+	return resp, nil
+}
+
+// quoteRefund 确定退款出行人并按各自门票的退改规则计算手续费，itemIDs 为空时为全部待核销出行人
+func quoteRefund(o *model.OrderMain, items []model.OrderItem, itemIDs []uint64, now time.Time) ([]refundQuote, error) {
+	shares := itemPayCents(o, items)
+	var picked []int
+	if len(itemIDs) == 0 {
+		for i := range items {
+			if items[i].ItemStatus == constant.ItemStatusUnused {
+				picked = append(picked, i)
+			}
+		}
+		if len(picked) == 0 {
+			return nil, &errItemRefund{"没有可退款的出行人"}
+		}
+	} else {
+		byID := make(map[uint64]int, len(items))
+		for i := range items {
+			byID[items[i].ID] = i
+		}
+		for _, id := range itemIDs {
+			i, ok := byID[id]
+			if !ok {
+				return nil, &errItemRefund{fmt.Sprintf("订单明细%d不存在", id)}
+			}
+			switch items[i].ItemStatus {
+			case constant.ItemStatusVerified:
+				return nil, &errItemRefund{fmt.Sprintf("%s（明细%d）已核销，不能退款", items[i].TicketName, id)}
+			case constant.ItemStatusRefunded:
+				return nil, &errItemRefund{fmt.Sprintf("%s（明细%d）已退款", items[i].TicketName, id)}
+			}
+			picked = append(picked, i)
+		}
+	}
+
+	policies := make(map[uint64]*model.RefundPolicy)
+	quotes := make([]refundQuote, 0, len(picked))
+	for _, i := range picked {
+		it := &items[i]
+		t := it.TicketType
+		if t == nil {
+			return nil, fmt.Errorf("订单明细%d的门票不存在", it.ID)
+		}
+		p, ok := policies[t.ID]
+		if !ok {
+			var err error
+			if p, err = refundrule.Resolve(t); err != nil {
+				return nil, err
+			}
+			policies[t.ID] = p
+		}
+		pct, err := refundrule.FeePercent(p, visitStart(o, t), now)
+		if err != nil {
+			return nil, &errItemRefund{fmt.Sprintf("%s：%s", it.TicketName, err.Error())}
+		}
+		quotes = append(quotes, refundQuote{
+			item:       it,
+			payCents:   shares[i],
+			feePercent: pct,
+			feeCents:   refundrule.Fee(shares[i], pct),
+		})
+	}
+	return quotes, nil
+}
+
+// visitStart 退改期限的参照时间：订单指定了游玩日期按当天零点计，否则按门票有效期最后一天结束计
+func visitStart(o *model.OrderMain, t *model.TicketType) time.Time {
+	if o.VisitDate != nil {
+		return dayOf(*o.VisitDate)
+	}
+	return dayOf(t.ValidEndTime.Time).AddDate(0, 0, 1)
+}
+
+// countStatus 指定状态的明细数
+func countStatus(items []model.OrderItem, status string) int {
+	n := 0
+	for i := range items {
+		if items[i].ItemStatus == status {
+			n++
+		}
+	}
+	return n
+}
+
+// itemPayCents 各明细的实付小计（分），下标与 items 一致
+// 明细实付之和与订单实付不一致（如历史订单未记录明细实付）时按原价比例分摊订单实付，尾差计入最后一条
+func itemPayCents(o *model.OrderMain, items []model.OrderItem) []int64 {
+	shares := make([]int64, len(items))
+	payCents := pricing.ToCents(o.PayAmount)
+	var sum, base int64
+	for i := range items {
+		shares[i] = pricing.ToCents(items[i].PayAmount)
+		sum += shares[i]
+		base += pricing.ToCents(items[i].SinglePrice) * int64(items[i].TicketNum)
+	}
+	if sum == payCents || base == 0 {
+		return shares
+	}
+	var allocated int64
+	for i := range items {
+		if i == len(items)-1 {
+			shares[i] = payCents - allocated
+			break
+		}
+		shares[i] = pricing.ToCents(items[i].SinglePrice) * int64(items[i].TicketNum) * payCents / base
+		allocated += shares[i]
+	}
+	return shares
+}
+
+// restoreItemsStock 按门票ID顺序回补库存，避免死锁
+func restoreItemsStock(tx *gorm.DB, restock map[uint64]uint32) error {
+	ids := make([]uint64, 0, len(restock))
+	for id := range restock {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		if err := stock.Restore(tx, id, restock[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,657 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/idgen"
+	"example_shop/common/model"
+	"example_shop/common/orderstate"
+	"example_shop/common/pricing"
+	"example_shop/common/refundrule"
+	"example_shop/common/stock"
+	"example_shop/kitex_gen/order"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// errReschedule 订单不可改期，消息可直接返回给调用方
+type errReschedule struct{ msg string }
+
+func (e *errReschedule) Error() string { return e.msg }
+
+var errRescheduleClosed = errors.New("改期申请已失效，款项将原路退回")
+
+// rescheduleOrderFields 改期需读取的订单字段
+var rescheduleOrderFields = []string{"id", "order_no", "spot_id", "order_status", "pay_type", "total_amount", "pay_amount", "visit_date", "reschedule_count"}
+
+// ticketChange 一种门票的变更，记录于改期记录
+type ticketChange struct {
+	From  uint64 `json:"from"`
+	To    uint64 `json:"to"`
+	Count uint32 `json:"count"`
+}
+
+// itemChange 单个出行人明细的门票变更
+type itemChange struct {
+	item     *model.OrderItem
+	to       *model.TicketType
+	payCents int64 // 变更后的实付小计
+}
+
+// reschedulePlan 改期计算结果
+type reschedulePlan struct {
+	visitDate  *time.Time // 改期后的游玩日期
+	items      []itemChange
+	changes    []ticketChange
+	tickets    map[uint64]*model.TicketType // 新门票，扣库存需读取时的版本号
+	totalDelta int64                        // 订单原价变化（分）
+	diffCents  int64                        // 实付差价（分），正数补款，负数退款
+	left       int                          // 改期后剩余可改期次数
+}
+
+// rescheduleOutcome 改期事务的结果
+type rescheduleOutcome struct {
+	diffCents    int64
+	refundNo     string
+	left         int
+	rescheduleID uint64 // 待补差价的改期记录ID
+}
+
+// RescheduleOrder 改期：将已支付未核销订单的待核销出行人改到新的游玩日期和/或同景点的其他门票。
+// 次数与期限按原门票的退改规则校验；门票差价按明细实付调整，需补款时只生成待补差价的改期记录，
+// 由 PayReschedule 支付回调完成改期；少付部分写入退款记录原路退回；新旧门票的库存在同一事务内按门票ID顺序扣减与回补
+func (s *OrderService) RescheduleOrder(ctx context.Context, req *order.RescheduleOrderReq) (*order.RescheduleOrderResp, error) {
+	resp := &order.RescheduleOrderResp{}
+	if req.UserId <= 0 || req.OrderId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID或订单ID不合法")
+		return resp, nil
+	}
+	visitDate, err := parseVisitDate(req.VisitDate, time.Now())
+	if err != nil {
+		resp.Base = createErrResp(err)
+		return resp, nil
+	}
+	swaps, err := parseRescheduleTickets(req.Tickets)
+	if err != nil {
+		resp.Base = createErrResp(err)
+		return resp, nil
+	}
+	if visitDate == nil && len(swaps) == 0 {
+		resp.Base = fail(constant.CodeParamError, "请指定新的游玩日期或门票")
+		return resp, nil
+	}
+
+	var out *rescheduleOutcome
+	for attempt := 1; ; attempt++ {
+		out, err = rescheduleOrder(ctx, req, visitDate, swaps)
+		if !errors.Is(err, stock.ErrVersionConflict) || attempt >= maxStockAttempts {
+			break
+		}
+		time.Sleep(retryDelay(attempt))
+	}
+	if err != nil {
+		resp.Base = rescheduleErrResp(err)
+		return resp, nil
+	}
+
+	var o model.OrderMain
+	err = db.MysqlDB.WithContext(ctx).Preload("OrderItems").Where("id = ?", req.OrderId).First(&o).Error
+	if err != nil {
+		log.Printf("查询订单失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询订单失败")
+		return resp, nil
+	}
+	resp.Order = toOrderInfo(&o)
+	resp.PriceDiff = pricing.FromCents(out.diffCents)
+	resp.RefundNo = out.refundNo
+	resp.RescheduleLeft = int32(out.left)
+	switch {
+	case req.Preview:
+		resp.Base = success("计算成功")
+	case out.rescheduleID > 0:
+		resp.RescheduleId = int64(out.rescheduleID)
+		resp.PendingPay = true
+		resp.Base = success(fmt.Sprintf("请补差价%.2f元，支付成功后完成改期", pricing.FromCents(out.diffCents)))
+	default:
+		resp.Base = success("改期成功")
+	}
+	return resp, nil
+}
+
+// parseRescheduleTickets 校验门票变更，返回原门票ID到新门票ID的映射
+func parseRescheduleTickets(list []*order.RescheduleTicket) (map[uint64]uint64, error) {
+	swaps := make(map[uint64]uint64, len(list))
+	for _, t := range list {
+		if t == nil || t.FromTicketTypeId <= 0 || t.ToTicketTypeId <= 0 {
+			return nil, &errOrderParam{"门票类型ID不合法"}
+		}
+		from, to := uint64(t.FromTicketTypeId), uint64(t.ToTicketTypeId)
+		if from == to {
+			return nil, &errOrderParam{"新门票不能与原门票相同"}
+		}
+		if _, dup := swaps[from]; dup {
+			return nil, &errOrderParam{"同一门票只能指定一次变更"}
+		}
+		swaps[from] = to
+	}
+	return swaps, nil
+}
+
+// rescheduleOrder 在一个事务内校验并执行改期，预览时只计算差价不写入；需补差价时只写入待补差价的改期记录
+// 库存版本冲突时返回 stock.ErrVersionConflict，由调用方重试整个事务
+func rescheduleOrder(ctx context.Context, req *order.RescheduleOrderReq, visitDate *time.Time, swaps map[uint64]uint64) (*rescheduleOutcome, error) {
+	out := &rescheduleOutcome{}
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var o model.OrderMain
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select(rescheduleOrderFields).
+			Where("id = ? AND user_id = ?", req.OrderId, req.UserId).First(&o).Error
+		if err != nil {
+			return err
+		}
+		if orderstate.Status(o.OrderStatus) != orderstate.Paid {
+			return &errReschedule{"仅已支付且未核销的订单可改期"}
+		}
+		now := time.Now()
+		plan, err := loadReschedulePlan(tx, &o, visitDate, swaps, now)
+		if err != nil {
+			return err
+		}
+		out.diffCents, out.left = plan.diffCents, plan.left
+		if req.Preview {
+			return nil
+		}
+		// 新的改期申请取代尚未支付的旧申请，旧申请之后到账的补款原路退回
+		err = tx.Model(&model.OrderReschedule{}).
+			Where("order_id = ? AND status = ?", o.ID, constant.RescheduleStatusPendingPay).
+			Update("status", constant.RescheduleStatusClosed).Error
+		if err != nil {
+			return err
+		}
+		operator := orderstate.UserOperator(uint64(req.UserId))
+		if plan.diffCents > 0 {
+			r, err := rescheduleRecord(&o, plan, constant.RescheduleStatusPendingPay, operator)
+			if err != nil {
+				return err
+			}
+			if err = tx.Create(r).Error; err != nil {
+				return err
+			}
+			out.rescheduleID = r.ID
+			return nil
+		}
+
+		record, err := applyReschedule(tx, &o, plan, "", "", now)
+		if err != nil {
+			return err
+		}
+		r, err := rescheduleRecord(&o, plan, constant.RescheduleStatusDone, operator)
+		if err != nil {
+			return err
+		}
+		if record != nil {
+			r.PayRecordID = record.ID
+			if record.RefundNo != nil {
+				out.refundNo = *record.RefundNo
+			}
+		}
+		return tx.Create(r).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayReschedule 改期补差价支付回调：按改期记录重新校验并执行改期，写入补款支付记录，同一流水重复回调幂等
+// 与其他改期、退款互斥于订单行锁；改期申请已被取代或条件不再满足（如库存不足、价格变化）时，补款记为退款中原路退回
+func (s *OrderService) PayReschedule(ctx context.Context, req *order.PayRescheduleReq) (*order.PayRescheduleResp, error) {
+	resp := &order.PayRescheduleResp{}
+	tradeNo := strings.TrimSpace(req.PlatformTradeNo)
+	switch {
+	case req.RescheduleId <= 0:
+		resp.Base = fail(constant.CodeParamError, "改期记录ID不合法")
+		return resp, nil
+	case req.PayType != constant.PayTypeWechat && req.PayType != constant.PayTypeAlipay:
+		resp.Base = fail(constant.CodeParamError, "支付方式不合法")
+		return resp, nil
+	case tradeNo == "" || len(tradeNo) > 64:
+		resp.Base = fail(constant.CodeParamError, "支付流水号不合法")
+		return resp, nil
+	}
+
+	var (
+		orderID          uint64
+		repeated, closed bool
+		err              error
+	)
+	for attempt := 1; ; attempt++ {
+		orderID, repeated, closed, err = payReschedule(ctx, req, tradeNo)
+		if !errors.Is(err, stock.ErrVersionConflict) || attempt >= maxStockAttempts {
+			break
+		}
+		time.Sleep(retryDelay(attempt))
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		resp.Base = fail(constant.CodeNotFound, "改期记录不存在")
+		return resp, nil
+	}
+	if err != nil {
+		resp.Base = rescheduleErrResp(err)
+		return resp, nil
+	}
+	if closed {
+		resp.Base = fail(constant.CodeConflict, errRescheduleClosed.Error())
+		return resp, nil
+	}
+
+	var o model.OrderMain
+	err = db.MysqlDB.WithContext(ctx).Preload("OrderItems").Where("id = ?", orderID).First(&o).Error
+	if err != nil {
+		log.Printf("查询订单失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询订单失败")
+		return resp, nil
+	}
+	resp.Order = toOrderInfo(&o)
+	if repeated {
+		resp.Base = success("订单已改期")
+		return resp, nil
+	}
+	resp.Base = success("改期成功")
+	return resp, nil
+}
+
+// payReschedule 在一个事务内完成待补差价的改期，返回订单ID
+// 库存版本冲突时返回 stock.ErrVersionConflict，由调用方重试整个事务
+func payReschedule(ctx context.Context, req *order.PayRescheduleReq, tradeNo string) (orderID uint64, repeated, closed bool, err error) {
+	err = db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var r model.OrderReschedule
+		if err := tx.Select("id", "order_id").Where("id = ?", req.RescheduleId).First(&r).Error; err != nil {
+			return err
+		}
+		orderID = r.OrderID
+		var o model.OrderMain
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select(rescheduleOrderFields).
+			Where("id = ?", r.OrderID).First(&o).Error
+		if err != nil {
+			return err
+		}
+		// 改期记录的状态变更均在订单行锁内进行，加锁后重新读取
+		if err = tx.Where("id = ?", r.ID).First(&r).Error; err != nil {
+			return err
+		}
+		switch r.Status {
+		case constant.RescheduleStatusDone:
+			var paid model.PayRecord
+			err = tx.Select("id", "platform_trade_no").Where("id = ?", r.PayRecordID).First(&paid).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			if paid.PlatformTradeNo == nil || *paid.PlatformTradeNo != tradeNo {
+				return errTradeMismatch
+			}
+			repeated = true
+			return nil
+		case constant.RescheduleStatusPendingPay:
+		default:
+			closed = true
+			return recordLatePay(tx, &o, req.PayType, req.PayAmount, tradeNo, "改期申请已失效")
+		}
+		if pricing.ToCents(req.PayAmount) != pricing.ToCents(r.PriceDiff) {
+			return errAmountMismatch
+		}
+
+		now := time.Now()
+		plan, err := pendingReschedulePlan(tx, &o, &r, now)
+		if err == nil {
+			var record *model.PayRecord
+			// 库存不足时回滚到保存点，仅关闭改期记录并退回补款
+			err = tx.Transaction(func(tx *gorm.DB) error {
+				record, err = applyReschedule(tx, &o, plan, req.PayType, tradeNo, now)
+				return err
+			})
+			if err == nil {
+				return tx.Model(&model.OrderReschedule{}).
+					Where("id = ? AND status = ?", r.ID, constant.RescheduleStatusPendingPay).
+					Updates(map[string]interface{}{
+						"status":        constant.RescheduleStatusDone,
+						"pay_record_id": record.ID,
+					}).Error
+			}
+		}
+		if !rescheduleInvalid(err) {
+			return err
+		}
+		log.Printf("改期记录%d已失效: %v", r.ID, err)
+		closed = true
+		err = tx.Model(&model.OrderReschedule{}).Where("id = ?", r.ID).
+			Update("status", constant.RescheduleStatusClosed).Error
+		if err != nil {
+			return err
+		}
+		return recordLatePay(tx, &o, req.PayType, req.PayAmount, tradeNo, "改期申请已失效")
+	})
+	return orderID, repeated, closed, err
+}
+
+// pendingReschedulePlan 按待补差价改期记录中的变更重新计算改期，差价与申请时不一致视为失效
+func pendingReschedulePlan(tx *gorm.DB, o *model.OrderMain, r *model.OrderReschedule, now time.Time) (*reschedulePlan, error) {
+	if orderstate.Status(o.OrderStatus) != orderstate.Paid {
+		return nil, &errReschedule{"订单状态已变更，不能改期"}
+	}
+	var changes []ticketChange
+	if r.TicketChanges != nil {
+		if err := json.Unmarshal(*r.TicketChanges, &changes); err != nil {
+			return nil, fmt.Errorf("改期记录%d门票变更格式错误: %w", r.ID, err)
+		}
+	}
+	swaps := make(map[uint64]uint64, len(changes))
+	for _, c := range changes {
+		swaps[c.From] = c.To
+	}
+	plan, err := loadReschedulePlan(tx, o, r.ToVisitDate, swaps, now)
+	if err != nil {
+		return nil, err
+	}
+	if plan.diffCents != pricing.ToCents(r.PriceDiff) {
+		return nil, &errReschedule{"门票价格已变化，请重新申请改期"}
+	}
+	return plan, nil
+}
+
+// rescheduleInvalid 判断错误是否表示改期条件已不满足，而非系统错误
+func rescheduleInvalid(err error) bool {
+	var re *errReschedule
+	var pe *errOrderParam
+	return errors.As(err, &re) || errors.As(err, &pe) || refundrule.IsViolation(err) || errors.Is(err, stock.ErrNotEnough)
+}
+
+// loadReschedulePlan 读取订单明细及原门票后计算改期
+func loadReschedulePlan(tx *gorm.DB, o *model.OrderMain, visitDate *time.Time, swaps map[uint64]uint64, now time.Time) (*reschedulePlan, error) {
+	var items []model.OrderItem
+	err := tx.Preload("TicketType", func(q *gorm.DB) *gorm.DB {
+		return q.Unscoped().Select("id", "ticket_name", "valid_start_time", "valid_end_time", "refund_rule", "refund_policy")
+	}).Where("order_id = ?", o.ID).Order("id").Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	return planReschedule(tx, o, items, visitDate, swaps, now)
+}
+
+// applyReschedule 执行改期：调整库存、出行人门票与实付、订单金额与游玩日期，写入差价支付记录并重新生成核销码
+// 补款时 payType、tradeNo 为补款支付回调的支付方式与流水号
+func applyReschedule(tx *gorm.DB, o *model.OrderMain, plan *reschedulePlan, payType, tradeNo string, now time.Time) (*model.PayRecord, error) {
+	if err := swapStock(tx, plan); err != nil {
+		return nil, err
+	}
+	for _, c := range plan.items {
+		result := tx.Model(&model.OrderItem{}).
+			Where("id = ? AND item_status = ?", c.item.ID, constant.ItemStatusUnused).
+			Updates(map[string]interface{}{
+				"ticket_type_id": c.to.ID,
+				"ticket_name":    c.to.TicketName,
+				"single_price":   c.to.Price,
+				"pay_amount":     pricing.FromCents(c.payCents),
+			})
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil, orderstate.ErrStatusChanged
+		}
+	}
+	fields := map[string]interface{}{
+		"reschedule_count": gorm.Expr("reschedule_count + 1"),
+		"total_amount":     gorm.Expr("total_amount + ?", pricing.FromCents(plan.totalDelta)),
+		"pay_amount":       gorm.Expr("pay_amount + ?", pricing.FromCents(plan.diffCents)),
+	}
+	if plan.visitDate != nil {
+		fields["visit_date"] = *plan.visitDate
+	}
+	if err := tx.Model(&model.OrderMain{}).Where("id = ?", o.ID).Updates(fields).Error; err != nil {
+		return nil, err
+	}
+	record, err := diffPayRecord(tx, o, plan.diffCents, payType, tradeNo, now)
+	if err != nil {
+		return nil, err
+	}
+	// 改期后旧核销码（含已下发的离线白名单）失效
+	if err = assignVerifyCodes(tx, o.ID); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// rescheduleRecord 按改期计算结果生成改期记录
+func rescheduleRecord(o *model.OrderMain, plan *reschedulePlan, status, operator string) (*model.OrderReschedule, error) {
+	r := &model.OrderReschedule{
+		OrderID:       o.ID,
+		FromVisitDate: o.VisitDate,
+		ToVisitDate:   plan.visitDate,
+		PriceDiff:     pricing.FromCents(plan.diffCents),
+		Status:        status,
+		Operator:      operator,
+	}
+	if len(plan.changes) > 0 {
+		b, err := json.Marshal(plan.changes)
+		if err != nil {
+			return nil, err
+		}
+		changes := model.JSON(b)
+		r.TicketChanges = &changes
+	}
+	return r, nil
+}
+
+// planReschedule 校验改期条件并计算各出行人的门票变更与差价
+// 新门票的实付小计 = 原实付小计 + 售价差，保留原优惠金额，不低于0
+func planReschedule(tx *gorm.DB, o *model.OrderMain, items []model.OrderItem, visitDate *time.Time, swaps map[uint64]uint64, now time.Time) (*reschedulePlan, error) {
+	plan := &reschedulePlan{visitDate: o.VisitDate, left: -1}
+	if visitDate != nil {
+		if o.VisitDate != nil && dayOf(*o.VisitDate).Equal(*visitDate) && len(swaps) == 0 {
+			return nil, &errOrderParam{"新游玩日期与原日期相同"}
+		}
+		plan.visitDate = visitDate
+	}
+	if countStatus(items, constant.ItemStatusVerified) > 0 {
+		return nil, &errReschedule{"订单已有出行人核销，不能改期"}
+	}
+	if countStatus(items, constant.ItemStatusUnused) == 0 {
+		return nil, &errReschedule{"没有可改期的出行人"}
+	}
+
+	// 按原门票的退改规则校验次数与期限
+	checked := make(map[uint64]struct{})
+	for i := range items {
+		t := items[i].TicketType
+		if items[i].ItemStatus != constant.ItemStatusUnused {
+			continue
+		}
+		if t == nil {
+			return nil, fmt.Errorf("订单明细%d的门票不存在", items[i].ID)
+		}
+		if _, ok := checked[t.ID]; ok {
+			continue
+		}
+		checked[t.ID] = struct{}{}
+		p, err := refundrule.Resolve(t)
+		if err != nil {
+			return nil, err
+		}
+		if err = refundrule.CheckReschedule(p, int(o.RescheduleCount)); err != nil {
+			return nil, err
+		}
+		if !now.Before(visitStart(o, t)) {
+			return nil, &errReschedule{fmt.Sprintf("门票%s已过游玩日期，不能改期", t.TicketName)}
+		}
+		if left := p.RescheduleLimit - int(o.RescheduleCount) - 1; plan.left < 0 || left < plan.left {
+			plan.left = left
+		}
+	}
+
+	plan.tickets = make(map[uint64]*model.TicketType, len(swaps))
+	if len(swaps) > 0 {
+		ids := make([]uint64, 0, len(swaps))
+		for _, to := range swaps {
+			ids = append(ids, to)
+		}
+		var tickets []model.TicketType
+		if err := tx.Where("id IN ?", ids).Find(&tickets).Error; err != nil {
+			return nil, err
+		}
+		for i := range tickets {
+			plan.tickets[tickets[i].ID] = &tickets[i]
+		}
+		for _, to := range ids {
+			t, ok := plan.tickets[to]
+			if !ok {
+				return nil, &errOrderParam{fmt.Sprintf("门票类型%d不存在", to)}
+			}
+			if t.SpotID != o.SpotID {
+				return nil, &errOrderParam{"只能改为同一景点的门票"}
+			}
+			if t.TicketStatus != constant.TicketStatusOnSale {
+				return nil, &errOrderParam{fmt.Sprintf("门票%s未在售", t.TicketName)}
+			}
+		}
+	}
+
+	today := dayOf(now)
+	shares := itemPayCents(o, items)
+	counts := make(map[uint64]uint32)
+	seen := make(map[[2]uint64]struct{})
+	for i := range items {
+		it := &items[i]
+		if it.ItemStatus == constant.ItemStatusRefunded {
+			continue
+		}
+		target := it.TicketType
+		to, swapped := swaps[it.TicketTypeID]
+		if swapped {
+			target = plan.tickets[to]
+		}
+		key := [2]uint64{it.TravelerID, target.ID}
+		if _, dup := seen[key]; dup {
+			return nil, &errOrderParam{"同一出行人不能重复购买同一门票"}
+		}
+		seen[key] = struct{}{}
+		if err := checkTicketDate(target, plan.visitDate, today); err != nil {
+			return nil, err
+		}
+		if !swapped {
+			continue
+		}
+		delta := (pricing.ToCents(target.Price) - pricing.ToCents(it.SinglePrice)) * int64(it.TicketNum)
+		pay := shares[i] + delta
+		if pay < 0 {
+			pay = 0
+		}
+		plan.items = append(plan.items, itemChange{item: it, to: target, payCents: pay})
+		plan.totalDelta += delta
+		plan.diffCents += pay - shares[i]
+		counts[it.TicketTypeID] += uint32(it.TicketNum)
+	}
+
+	froms := make([]uint64, 0, len(swaps))
+	for from := range swaps {
+		froms = append(froms, from)
+	}
+	sort.Slice(froms, func(i, j int) bool { return froms[i] < froms[j] })
+	for _, from := range froms {
+		if counts[from] == 0 {
+			return nil, &errOrderParam{fmt.Sprintf("订单内没有可改期的门票%d", from)}
+		}
+		plan.changes = append(plan.changes, ticketChange{From: from, To: swaps[from], Count: counts[from]})
+	}
+	return plan, nil
+}
+
+// swapStock 扣减新门票并回补原门票库存，按门票ID顺序更新避免死锁
+func swapStock(tx *gorm.DB, plan *reschedulePlan) error {
+	net := make(map[uint64]int64)
+	for _, c := range plan.changes {
+		net[c.To] += int64(c.Count)
+		net[c.From] -= int64(c.Count)
+	}
+	ids := make([]uint64, 0, len(net))
+	for id := range net {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		var err error
+		switch n := net[id]; {
+		case n > 0:
+			err = stock.Deduct(tx, plan.tickets[id], uint32(n))
+		case n < 0:
+			err = stock.Restore(tx, id, uint32(-n))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffPayRecord 写入差价的补款或退款支付记录，无差价时返回nil；退款按下单时的支付方式原路退回
+func diffPayRecord(tx *gorm.DB, o *model.OrderMain, diffCents int64, payType, tradeNo string, now time.Time) (*model.PayRecord, error) {
+	if diffCents == 0 {
+		return nil, nil
+	}
+	record := &model.PayRecord{
+		OrderID:    o.ID,
+		OrderNo:    o.OrderNo,
+		NotifyTime: &now,
+	}
+	if o.PayType != nil {
+		record.PayType = *o.PayType
+	}
+	if diffCents > 0 {
+		record.PayType = payType
+		record.PayAmount = pricing.FromCents(diffCents)
+		record.PayStatus = constant.PayStatusSuccess
+		record.PlatformTradeNo = &tradeNo
+	} else {
+		refundNo, err := idgen.RefundNo()
+		if err != nil {
+			return nil, err
+		}
+		record.PayAmount = pricing.FromCents(-diffCents)
+		record.PayStatus = constant.PayStatusRefund
+		record.RefundNo = &refundNo
+	}
+	ext, err := json.Marshal(map[string]interface{}{"reason": "改期差价"})
+	if err != nil {
+		return nil, err
+	}
+	extJSON := model.JSON(ext)
+	record.ExtFields = &extJSON
+	if err = tx.Create(record).Error; err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// rescheduleErrResp 将改期错误转换为响应
+func rescheduleErrResp(err error) *order.BaseResp {
+	var re *errReschedule
+	var pe *errOrderParam
+	switch {
+	case errors.As(err, &re):
+		return fail(constant.CodeConflict, re.msg)
+	case refundrule.IsViolation(err):
+		return fail(constant.CodeConflict, err.Error())
+	case errors.As(err, &pe), errors.Is(err, stock.ErrNotEnough), errors.Is(err, stock.ErrVersionConflict):
+		return createErrResp(err)
+	default:
+		return statusErrResp(err, "改期失败")
+	}
+}
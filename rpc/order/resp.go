@@ -0,0 +1,16 @@
+package order
+
+import (
+	"example_shop/common/constant"
+	"example_shop/kitex_gen/order"
+)
+
+// success 构造成功响应
+func success(msg string) *order.BaseResp {
+	return &order.BaseResp{Code: constant.CodeSuccess, Msg: msg}
+}
+
+// fail 构造失败响应
+func fail(code int32, msg string) *order.BaseResp {
+	return &order.BaseResp{Code: code, Msg: msg}
+}
@@ -0,0 +1,228 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/orderstate"
+	"example_shop/common/pricing"
+	"example_shop/common/redeem"
+	"example_shop/common/settlement"
+	"example_shop/kitex_gen/order"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	errAmountMismatch = errors.New("支付金额与订单实付金额不一致")
+	errTradeMismatch  = errors.New("订单已由其他支付流水支付")
+	errPaidCancelled  = errors.New("订单已超时取消，款项将原路退回")
+	errPayTypeChanged = errors.New("支付方式与下单时选择的不一致")
+)
+
+// PayOrder 支付回调：校验金额后将待支付订单置为已支付并写入支付记录，同一流水重复回调幂等
+// 与超时取消互斥于订单行锁，订单已取消后到达的支付记为退款中，由退款流程原路退回
+func (s *OrderService) PayOrder(ctx context.Context, req *order.PayOrderReq) (*order.PayOrderResp, error) {
+	resp := &order.PayOrderResp{}
+	tradeNo := strings.TrimSpace(req.PlatformTradeNo)
+	switch {
+	case req.OrderId <= 0:
+		resp.Base = fail(constant.CodeParamError, "订单ID不合法")
+		return resp, nil
+	case req.PayType != constant.PayTypeWechat && req.PayType != constant.PayTypeAlipay:
+		resp.Base = fail(constant.CodeParamError, "支付方式不合法")
+		return resp, nil
+	case tradeNo == "" || len(tradeNo) > 64:
+		resp.Base = fail(constant.CodeParamError, "支付流水号不合法")
+		return resp, nil
+	}
+
+	repeated, cancelled := false, false
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var o model.OrderMain
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "order_no", "order_status", "pay_type", "pay_amount").
+			Where("id = ?", req.OrderId).First(&o).Error
+		if err != nil {
+			return err
+		}
+		if orderstate.Status(o.OrderStatus) != orderstate.PendingPay {
+			var paid model.PayRecord
+			err = tx.Where("order_id = ? AND pay_status = ?", o.ID, constant.PayStatusSuccess).First(&paid).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				if orderstate.Status(o.OrderStatus) != orderstate.Cancelled {
+					return orderstate.ErrIllegalTransition
+				}
+				cancelled = true
+				return recordLatePay(tx, &o, req.PayType, req.PayAmount, tradeNo, "订单已取消")
+			}
+			if err != nil {
+				return err
+			}
+			if paid.PlatformTradeNo == nil || *paid.PlatformTradeNo != tradeNo {
+				return errTradeMismatch
+			}
+			repeated = true
+			return nil
+		}
+		if pricing.ToCents(req.PayAmount) != pricing.ToCents(o.PayAmount) {
+			return errAmountMismatch
+		}
+		// 限定支付方式的优惠券按下单时选择的支付方式锁定，支付时不能更换
+		if o.PayType != nil && *o.PayType != "" {
+			if *o.PayType != req.PayType {
+				return errPayTypeChanged
+			}
+		} else if err = redeem.CheckPayType(tx, o.ID, req.PayType); err != nil {
+			return err
+		}
+		now := time.Now()
+		err = orderstate.Apply(tx, &orderstate.Transition{
+			OrderID:  o.ID,
+			From:     orderstate.PendingPay,
+			To:       orderstate.Paid,
+			Operator: orderstate.OperatorSystem,
+			Reason:   "支付成功",
+			Fields:   map[string]interface{}{"pay_type": req.PayType},
+		}, now)
+		if err != nil {
+			return err
+		}
+		extJSON, err := settlementExt(tx, o.ID)
+		if err != nil {
+			return err
+		}
+		return tx.Create(&model.PayRecord{
+			OrderID:         o.ID,
+			OrderNo:         o.OrderNo,
+			PayType:         req.PayType,
+			PayAmount:       o.PayAmount,
+			PayStatus:       constant.PayStatusSuccess,
+			PlatformTradeNo: &tradeNo,
+			NotifyTime:      &now,
+			ExtFields:       extJSON,
+		}).Error
+	})
+	if err != nil {
+		resp.Base = statusErrResp(err, "支付处理失败")
+		return resp, nil
+	}
+	if cancelled {
+		resp.Base = fail(constant.CodeConflict, errPaidCancelled.Error())
+		return resp, nil
+	}
+	if repeated {
+		resp.Base = success("订单已支付")
+		return resp, nil
+	}
+	resp.Base = success("支付成功")
+	return resp, nil
+}
+
+// settlementExt 支付记录的扩展字段：按发券方拆分的结算结果，对账时区分平台补贴与商家承担的优惠
+// 优惠明细与订单金额不一致时不阻断支付，记录日志由人工核对
+func settlementExt(tx *gorm.DB, orderID uint64) (*model.JSON, error) {
+	split, err := settlement.ForOrder(tx, orderID)
+	if errors.Is(err, settlement.ErrAmountMismatch) {
+		log.Printf("订单%d结算拆分失败，需人工核对: %v", orderID, err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(map[string]interface{}{"settlement": split})
+	if err != nil {
+		return nil, err
+	}
+	ext := model.JSON(b)
+	return &ext, nil
+}
+
+// recordLatePay 无法入账的支付（如订单已取消、改期申请已失效）写入退款中记录，同一流水只记录一次，由 runLateRefund 经支付渠道原路退回，未接入渠道时人工处理
+func recordLatePay(tx *gorm.DB, o *model.OrderMain, payType string, amount float64, tradeNo, reason string) error {
+	var n int64
+	err := tx.Model(&model.PayRecord{}).
+		Where("order_id = ? AND platform_trade_no = ?", o.ID, tradeNo).Count(&n).Error
+	if err != nil || n > 0 {
+		return err
+	}
+	ext, err := json.Marshal(map[string]interface{}{"reason": reason})
+	if err != nil {
+		return err
+	}
+	extJSON := model.JSON(ext)
+	now := time.Now()
+	log.Printf("订单%d收到无法入账的支付（%s），流水号%s，金额%.2f，待退款", o.ID, reason, tradeNo, amount)
+	return tx.Create(&model.PayRecord{
+		OrderID:         o.ID,
+		OrderNo:         o.OrderNo,
+		PayType:         payType,
+		PayAmount:       amount,
+		PayStatus:       constant.PayStatusRefunding,
+		PlatformTradeNo: &tradeNo,
+		NotifyTime:      &now,
+		ExtFields:       &extJSON,
+	}).Error
+}
+
+// CancelOrder 用户取消待支付订单，回补库存与释放优惠券由状态机钩子完成
+func (s *OrderService) CancelOrder(ctx context.Context, req *order.CancelOrderReq) (*order.CancelOrderResp, error) {
+	resp := &order.CancelOrderResp{}
+	if req.UserId <= 0 || req.OrderId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "用户ID或订单ID不合法")
+		return resp, nil
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		reason = "用户取消"
+	}
+	if utf8.RuneCountInString(reason) > 100 {
+		resp.Base = fail(constant.CodeParamError, "取消原因不能超过100个字符")
+		return resp, nil
+	}
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var o model.OrderMain
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "order_status").
+			Where("id = ? AND user_id = ?", req.OrderId, req.UserId).First(&o).Error
+		if err != nil {
+			return err
+		}
+		return orderstate.Apply(tx, &orderstate.Transition{
+			OrderID:  o.ID,
+			From:     orderstate.Status(o.OrderStatus),
+			To:       orderstate.Cancelled,
+			Operator: orderstate.UserOperator(uint64(req.UserId)),
+			Reason:   reason,
+		}, time.Now())
+	})
+	if err != nil {
+		resp.Base = statusErrResp(err, "取消订单失败")
+		return resp, nil
+	}
+	resp.Base = success("取消成功")
+	return resp, nil
+}
+
+// statusErrResp 将订单状态变更的错误转换为响应
+func statusErrResp(err error, msg string) *order.BaseResp {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fail(constant.CodeNotFound, "订单不存在")
+	case errors.Is(err, orderstate.ErrIllegalTransition):
+		return fail(constant.CodeConflict, orderstate.ErrIllegalTransition.Error())
+	case errors.Is(err, orderstate.ErrStatusChanged), errors.Is(err, errAmountMismatch), errors.Is(err, errTradeMismatch), errors.Is(err, redeem.ErrNotLocked),
+		errors.Is(err, errPayTypeChanged), pricing.IsUnusable(err):
+		return fail(constant.CodeConflict, err.Error())
+	default:
+		log.Printf("%s: %v", msg, err)
+		return fail(constant.CodeServerError, msg)
+	}
+}
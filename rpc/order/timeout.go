@@ -0,0 +1,122 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/config"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/delayqueue"
+	"example_shop/common/model"
+	"example_shop/common/orderstate"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultPayTimeout  = 15 * time.Minute
+	payTimeoutInterval = 5 * time.Second // 延时队列轮询间隔
+	payTimeoutBatch    = 100             // 每轮最多处理的到期订单数
+	payTimeoutReason   = "超时未支付自动取消"
+)
+
+// payTimeoutQueue 待支付订单超时取消的延时队列
+func payTimeoutQueue() *delayqueue.Queue {
+	return delayqueue.New(db.Rdb, constant.RedisKeyOrderPayTimeout)
+}
+
+// payTimeout 待支付订单的支付时限
+func payTimeout() time.Duration {
+	if m := config.Cfg.Order.PayTimeoutMinutes; m > 0 {
+		return time.Duration(m) * time.Minute
+	}
+	return defaultPayTimeout
+}
+
+// schedulePayTimeout 订单创建后加入超时取消队列，失败仅记录日志，由兜底扫描补偿
+func schedulePayTimeout(ctx context.Context, o *model.OrderMain) {
+	if err := payTimeoutQueue().Add(ctx, o.ID, o.CreatedAt.Add(payTimeout())); err != nil {
+		log.Printf("订单%d加入超时取消队列失败: %v", o.ID, err)
+	}
+}
+
+// runPayTimeout 取消已到期的待支付订单：先处理延时队列，再扫描数据库兜底未入队的订单
+func runPayTimeout(ctx context.Context) error {
+	now := time.Now()
+	queue := payTimeoutQueue()
+	ids, err := queue.Due(ctx, now, payTimeoutBatch)
+	if err != nil {
+		return err
+	}
+	var done []uint64
+	for _, id := range ids {
+		deadline, err := cancelUnpaid(ctx, id, now)
+		if err != nil {
+			log.Printf("订单%d超时取消失败: %v", id, err)
+			continue
+		}
+		if deadline != nil {
+			// 未到期的订单更新分值，保留在队列中
+			if err := queue.Add(ctx, id, *deadline); err != nil {
+				log.Printf("订单%d重新加入超时取消队列失败: %v", id, err)
+			}
+			continue
+		}
+		done = append(done, id)
+	}
+	if err = queue.Remove(ctx, done...); err != nil {
+		return err
+	}
+
+	var missed []uint64
+	err = db.MysqlDB.WithContext(ctx).Model(&model.OrderMain{}).
+		Where("order_status = ? AND created_at <= ?", constant.OrderStatusPendingPay, now.Add(-payTimeout())).
+		Order("id").Limit(payTimeoutBatch).Pluck("id", &missed).Error
+	if err != nil {
+		return err
+	}
+	for _, id := range missed {
+		if _, err := cancelUnpaid(ctx, id, now); err != nil {
+			log.Printf("订单%d超时取消失败: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// cancelUnpaid 锁定订单后将超时的待支付订单置为已取消，回补库存与释放优惠券由状态机钩子完成
+// 与支付回调互斥于订单行锁，订单已支付或已取消时直接返回；未到期（如时限配置调大）时返回实际到期时间
+func cancelUnpaid(ctx context.Context, orderID uint64, now time.Time) (*time.Time, error) {
+	var deadline *time.Time
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var o model.OrderMain
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "order_status", "created_at").
+			Where("id = ?", orderID).First(&o).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if orderstate.Status(o.OrderStatus) != orderstate.PendingPay {
+			return nil
+		}
+		if at := o.CreatedAt.Add(payTimeout()); at.After(now) {
+			deadline = &at
+			return nil
+		}
+		return orderstate.Apply(tx, &orderstate.Transition{
+			OrderID:  o.ID,
+			From:     orderstate.PendingPay,
+			To:       orderstate.Cancelled,
+			Operator: orderstate.OperatorSystem,
+			Reason:   payTimeoutReason,
+		}, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deadline, nil
+}
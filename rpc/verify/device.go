@@ -0,0 +1,176 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/config"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/gatesign"
+	"example_shop/common/model"
+	"example_shop/kitex_gen/verify"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+// deviceNoPattern 设备编号：字母、数字、短横线与下划线，最长40位
+var deviceNoPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,40}$`)
+
+var errNoDeviceSecret = errors.New("闸机未下发设备密钥，请商家重置设备密钥")
+
+// RegisterGateDevice 商家为自己的景点登记检票闸机，返回离线白名单验签公钥与设备密钥供闸机预置
+func (s *VerifyService) RegisterGateDevice(ctx context.Context, req *verify.RegisterGateDeviceReq) (*verify.RegisterGateDeviceResp, error) {
+	resp := &verify.RegisterGateDeviceResp{}
+	deviceNo := strings.TrimSpace(req.DeviceNo)
+	name := strings.TrimSpace(req.DeviceName)
+	switch {
+	case req.MerchantId <= 0 || req.SpotId <= 0:
+		resp.Base = fail(constant.CodeParamError, "商家ID或景点ID不合法")
+		return resp, nil
+	case !deviceNoPattern.MatchString(deviceNo):
+		resp.Base = fail(constant.CodeParamError, "设备编号只能包含字母、数字、短横线和下划线，且不超过40位")
+		return resp, nil
+	case name == "" || utf8.RuneCountInString(name) > 100:
+		resp.Base = fail(constant.CodeParamError, "设备名称不能为空且不超过100个字符")
+		return resp, nil
+	}
+
+	var spot model.SpotInfo
+	err := db.MysqlDB.WithContext(ctx).Select("id", "merchant_id").Where("id = ?", req.SpotId).First(&spot).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.Base = fail(constant.CodeNotFound, "景点不存在")
+			return resp, nil
+		}
+		log.Printf("查询景点失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "登记闸机失败")
+		return resp, nil
+	}
+	if spot.MerchantID != uint64(req.MerchantId) {
+		resp.Base = fail(constant.CodeForbidden, "只能为自己的景点登记闸机")
+		return resp, nil
+	}
+	var exists int64
+	err = db.MysqlDB.WithContext(ctx).Unscoped().Model(&model.GateDevice{}).Where("device_no = ?", deviceNo).Count(&exists).Error
+	if err != nil {
+		log.Printf("查询闸机失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "登记闸机失败")
+		return resp, nil
+	}
+	if exists > 0 {
+		resp.Base = fail(constant.CodeConflict, "设备编号已登记")
+		return resp, nil
+	}
+	salt, err := gatesign.NewSalt()
+	if err != nil {
+		log.Printf("生成设备密钥失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "登记闸机失败")
+		return resp, nil
+	}
+	d := &model.GateDevice{
+		DeviceNo:     deviceNo,
+		DeviceName:   name,
+		MerchantID:   spot.MerchantID,
+		SpotID:       spot.ID,
+		SecretSalt:   salt,
+		DeviceStatus: constant.GateDeviceEnabled,
+	}
+	if err = db.MysqlDB.WithContext(ctx).Create(d).Error; err != nil {
+		log.Printf("登记闸机失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "登记闸机失败")
+		return resp, nil
+	}
+	resp.DeviceId = int64(d.ID)
+	resp.WhitelistPublicKey = whitelistPublicKey()
+	resp.DeviceSecret = gatesign.EncodeSecret(deviceSecret(d))
+	resp.Base = success("登记成功")
+	return resp, nil
+}
+
+// SetGateDeviceStatus 商家启用或停用闸机，停用后该设备的核销请求将被拒绝
+func (s *VerifyService) SetGateDeviceStatus(ctx context.Context, req *verify.SetGateDeviceStatusReq) (*verify.SetGateDeviceStatusResp, error) {
+	resp := &verify.SetGateDeviceStatusResp{}
+	deviceNo := strings.TrimSpace(req.DeviceNo)
+	if req.MerchantId <= 0 || deviceNo == "" {
+		resp.Base = fail(constant.CodeParamError, "商家ID或设备编号不合法")
+		return resp, nil
+	}
+	status := constant.GateDeviceDisabled
+	if req.Enabled {
+		status = constant.GateDeviceEnabled
+	}
+	result := db.MysqlDB.WithContext(ctx).Model(&model.GateDevice{}).
+		Where("device_no = ? AND merchant_id = ?", deviceNo, req.MerchantId).
+		Update("device_status", status)
+	if result.Error != nil {
+		log.Printf("更新闸机状态失败: %v", result.Error)
+		resp.Base = fail(constant.CodeServerError, "更新闸机状态失败")
+		return resp, nil
+	}
+	if result.RowsAffected == 0 {
+		// 状态未变化时影响行数也为0，需再确认设备是否存在
+		var n int64
+		err := db.MysqlDB.WithContext(ctx).Model(&model.GateDevice{}).
+			Where("device_no = ? AND merchant_id = ?", deviceNo, req.MerchantId).Count(&n).Error
+		if err != nil {
+			log.Printf("查询闸机失败: %v", err)
+			resp.Base = fail(constant.CodeServerError, "更新闸机状态失败")
+			return resp, nil
+		}
+		if n == 0 {
+			resp.Base = fail(constant.CodeNotFound, "闸机不存在")
+			return resp, nil
+		}
+	}
+	resp.Base = success("操作成功")
+	return resp, nil
+}
+
+// ResetGateDeviceSecret 商家重置闸机设备密钥，返回新密钥，旧密钥签名的请求立即被拒绝
+func (s *VerifyService) ResetGateDeviceSecret(ctx context.Context, req *verify.ResetGateDeviceSecretReq) (*verify.ResetGateDeviceSecretResp, error) {
+	resp := &verify.ResetGateDeviceSecretResp{}
+	deviceNo := strings.TrimSpace(req.DeviceNo)
+	if req.MerchantId <= 0 || deviceNo == "" {
+		resp.Base = fail(constant.CodeParamError, "商家ID或设备编号不合法")
+		return resp, nil
+	}
+	salt, err := gatesign.NewSalt()
+	if err != nil {
+		log.Printf("生成设备密钥失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "重置设备密钥失败")
+		return resp, nil
+	}
+	result := db.MysqlDB.WithContext(ctx).Model(&model.GateDevice{}).
+		Where("device_no = ? AND merchant_id = ?", deviceNo, req.MerchantId).
+		Update("secret_salt", salt)
+	if result.Error != nil {
+		log.Printf("重置设备密钥失败: %v", result.Error)
+		resp.Base = fail(constant.CodeServerError, "重置设备密钥失败")
+		return resp, nil
+	}
+	if result.RowsAffected == 0 {
+		resp.Base = fail(constant.CodeNotFound, "闸机不存在")
+		return resp, nil
+	}
+	resp.DeviceSecret = gatesign.EncodeSecret(deviceSecret(&model.GateDevice{DeviceNo: deviceNo, SecretSalt: salt}))
+	resp.Base = success("重置成功")
+	return resp, nil
+}
+
+// deviceSecret 闸机的设备密钥
+func deviceSecret(d *model.GateDevice) []byte {
+	return gatesign.DeviceSecret([]byte(config.Cfg.Verify.DeviceKey), d.DeviceNo, d.SecretSalt)
+}
+
+// checkDeviceSign 校验闸机请求签名，payload 为各接口约定的签名内容
+func checkDeviceSign(d *model.GateDevice, ts int64, payload, sig string, now time.Time) error {
+	if d.SecretSalt == "" {
+		return errNoDeviceSecret
+	}
+	return gatesign.Verify(deviceSecret(d), d.DeviceNo, ts, payload, sig, now)
+}
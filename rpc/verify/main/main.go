@@ -0,0 +1,36 @@
+package main
+
+import (
+	"example_shop/common/config"
+	_ "example_shop/common/init"
+	"example_shop/kitex_gen/verify/verifyservice"
+	"example_shop/rpc/order"
+	"example_shop/rpc/verify"
+	"log"
+	"net"
+
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/cloudwego/kitex/server"
+)
+
+func main() {
+	// 核销同样经由订单状态机流转，注册与订单服务一致的副作用
+	order.RegisterHooks()
+
+	addr, err := net.ResolveTCPAddr("tcp", config.Cfg.Verify.Addr)
+	if err != nil {
+		log.Fatalf("核销服务监听地址配置错误: %v", err)
+	}
+	svr := verifyservice.NewServer(
+		new(verify.VerifyService),
+		server.WithServiceAddr(addr),
+		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{
+			ServiceName: "verify_service",
+		}),
+	)
+
+	log.Println("✅ 核销服务启动成功！")
+	if err := svr.Run(); err != nil {
+		log.Println("启动失败：", err)
+	}
+}
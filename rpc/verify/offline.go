@@ -0,0 +1,449 @@
+package verify
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"example_shop/common/config"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/model"
+	"example_shop/common/orderstate"
+	"example_shop/common/whitelist"
+	"example_shop/kitex_gen/verify"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	whitelistAheadDays = 2               // 最多提前下载几天后的白名单
+	maxOfflineRecords  = 500             // 单次上传的离线核销记录上限
+	offlineClockSkew   = 5 * time.Minute // 闸机时钟允许超前服务器的时间
+	maxReportDays      = 31              // 对账报告查询区间上限
+	maxReportItems     = 500             // 对账报告返回的异常记录上限
+)
+
+// GetOfflineWhitelist 导出闸机所在景点指定日期的离线白名单：已支付订单中当天有效的待核销核销码，
+// 订单核销码可入园人数为当天有效的待核销人数，出行人核销码为1；核销码摘要以该闸机的设备密钥计算
+func (s *VerifyService) GetOfflineWhitelist(ctx context.Context, req *verify.GetOfflineWhitelistReq) (*verify.GetOfflineWhitelistResp, error) {
+	resp := &verify.GetOfflineWhitelistResp{}
+	deviceNo := strings.TrimSpace(req.DeviceNo)
+	if deviceNo == "" {
+		resp.Base = fail(constant.CodeParamError, "设备编号不能为空")
+		return resp, nil
+	}
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	day := today
+	if d := strings.TrimSpace(req.Date); d != "" {
+		var err error
+		if day, err = time.ParseInLocation(time.DateOnly, d, now.Location()); err != nil {
+			resp.Base = fail(constant.CodeParamError, "日期格式错误，应为YYYY-MM-DD")
+			return resp, nil
+		}
+	}
+	if day.Before(today) || day.After(today.AddDate(0, 0, whitelistAheadDays)) {
+		resp.Base = fail(constant.CodeParamError, fmt.Sprintf("只能下载当天起%d天内的白名单", whitelistAheadDays+1))
+		return resp, nil
+	}
+	device, err := loadDevice(ctx, deviceNo)
+	if err == nil {
+		err = checkDeviceSign(device, req.Timestamp, req.Date, req.Signature, now)
+	}
+	if err != nil {
+		resp.Base = verifyErrResp(err, "下载白名单失败")
+		return resp, nil
+	}
+
+	var rows []struct {
+		OrderID   uint64
+		OrderCode *string
+		ItemCode  *string
+	}
+	err = db.MysqlDB.WithContext(ctx).Table("order_item AS i").
+		Select("i.order_id, o.verify_code AS order_code, i.verify_code AS item_code").
+		Joins("JOIN order_main o ON o.id = i.order_id AND o.deleted_at IS NULL").
+		Joins("JOIN ticket_type t ON t.id = i.ticket_type_id").
+		Where("o.spot_id = ? AND o.order_status = ? AND i.item_status = ? AND i.deleted_at IS NULL",
+			device.SpotID, constant.OrderStatusPaid, constant.ItemStatusUnused).
+		Where("t.valid_start_time <= ? AND t.valid_end_time >= ?", day.Format(time.DateOnly), day.Format(time.DateOnly)).
+		Where("o.visit_date IS NULL OR o.visit_date = ?", day.Format(time.DateOnly)).
+		Order("i.order_id, i.id").Scan(&rows).Error
+	if err != nil {
+		log.Printf("查询离线白名单失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "下载白名单失败")
+		return resp, nil
+	}
+	var entries []whitelist.Entry
+	orderIdx := make(map[uint64]int)
+	for _, r := range rows {
+		if r.ItemCode != nil {
+			entries = append(entries, whitelist.Entry{Code: *r.ItemCode, Count: 1})
+		}
+		if r.OrderCode == nil {
+			continue
+		}
+		if i, ok := orderIdx[r.OrderID]; ok {
+			entries[i].Count++
+			continue
+		}
+		orderIdx[r.OrderID] = len(entries)
+		entries = append(entries, whitelist.Entry{Code: *r.OrderCode, Count: 1})
+	}
+	bundle, err := whitelist.Build(whitelistKey(), deviceSecret(device), device.SpotID, day, now, entries)
+	if err != nil {
+		log.Printf("生成离线白名单失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "下载白名单失败")
+		return resp, nil
+	}
+	resp.Bundle = bundle
+	resp.EntryCount = int32(len(entries))
+	resp.GeneratedAt = now.Unix()
+	resp.Base = success("下载成功")
+	return resp, nil
+}
+
+// UploadOfflineVerifications 批量上传离线核销记录，按核销时间逐条对账：
+// 有效的补录为出行人核销（核销时间取闸机记录时间），同一核销码已在其他闸机核销或已退款记为冲突，
+// 无效核销码、非本景点或不在有效期记为无效；每条记录独立事务，重复上传返回已有结果
+func (s *VerifyService) UploadOfflineVerifications(ctx context.Context, req *verify.UploadOfflineVerificationsReq) (*verify.UploadOfflineVerificationsResp, error) {
+	resp := &verify.UploadOfflineVerificationsResp{}
+	deviceNo := strings.TrimSpace(req.DeviceNo)
+	switch {
+	case deviceNo == "":
+		resp.Base = fail(constant.CodeParamError, "设备编号不能为空")
+		return resp, nil
+	case len(req.Records) == 0 || len(req.Records) > maxOfflineRecords:
+		resp.Base = fail(constant.CodeParamError, fmt.Sprintf("离线核销记录数量需在1到%d之间", maxOfflineRecords))
+		return resp, nil
+	}
+	now := time.Now()
+	records := make([]*verify.OfflineVerifyRecord, 0, len(req.Records))
+	for _, r := range req.Records {
+		if r == nil || strings.TrimSpace(r.VerifyCode) == "" || len(strings.TrimSpace(r.VerifyCode)) > 64 {
+			resp.Base = fail(constant.CodeParamError, "核销码不能为空且不超过64位")
+			return resp, nil
+		}
+		if r.VerifiedAt <= 0 || time.Unix(r.VerifiedAt, 0).After(now.Add(offlineClockSkew)) {
+			resp.Base = fail(constant.CodeParamError, "核销时间不合法")
+			return resp, nil
+		}
+		records = append(records, r)
+	}
+	sort.SliceStable(records, func(i, j int) bool { return records[i].VerifiedAt < records[j].VerifiedAt })
+
+	// 设备停用前的离线记录仍需上传对账，这里不校验设备状态
+	var device model.GateDevice
+	if err := db.MysqlDB.WithContext(ctx).Where("device_no = ?", deviceNo).First(&device).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.Base = fail(constant.CodeForbidden, "闸机未登记")
+			return resp, nil
+		}
+		log.Printf("查询闸机失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "上传离线核销记录失败")
+		return resp, nil
+	}
+	if err := checkDeviceSign(&device, req.Timestamp, offlinePayload(req.Records), req.Signature, now); err != nil {
+		resp.Base = verifyErrResp(err, "上传离线核销记录失败")
+		return resp, nil
+	}
+	for _, r := range records {
+		rec, err := reconcileOffline(ctx, &device, strings.TrimSpace(r.VerifyCode), time.Unix(r.VerifiedAt, 0))
+		if err != nil {
+			log.Printf("离线核销对账失败: %v", err)
+			resp.Base = fail(constant.CodeServerError, "上传离线核销记录失败，请重试")
+			return resp, nil
+		}
+		switch rec.Result {
+		case constant.OfflineResultApplied:
+			resp.Applied++
+		case constant.OfflineResultDuplicate:
+			resp.Duplicated++
+		default:
+			resp.Exceptions = append(resp.Exceptions, toOfflineException(rec))
+		}
+	}
+	resp.Base = success("上传成功")
+	return resp, nil
+}
+
+// offlinePayload 上传离线核销记录的签名内容：按上传顺序以逗号连接的“核销码:核销时间戳”
+func offlinePayload(records []*verify.OfflineVerifyRecord) string {
+	parts := make([]string, 0, len(records))
+	for _, r := range records {
+		parts = append(parts, fmt.Sprintf("%s:%d", r.VerifyCode, r.VerifiedAt))
+	}
+	return strings.Join(parts, ",")
+}
+
+// reconcileOffline 对账一条离线核销记录并写入对账结果，同一记录已上传过时直接返回已有结果
+func reconcileOffline(ctx context.Context, device *model.GateDevice, code string, verifiedAt time.Time) (*model.OfflineVerifyRecord, error) {
+	rec := &model.OfflineVerifyRecord{DeviceNo: device.DeviceNo, SpotID: device.SpotID, VerifyCode: code, VerifiedAt: verifiedAt}
+	err := db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing model.OfflineVerifyRecord
+		err := tx.Where("device_no = ? AND verify_code = ? AND verified_at = ?", device.DeviceNo, code, verifiedAt).
+			First(&existing).Error
+		if err == nil {
+			*rec = existing
+			rec.Result = constant.OfflineResultDuplicate
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err = applyOffline(tx, device, rec); err != nil {
+			return err
+		}
+		return tx.Create(rec).Error
+	})
+	return rec, err
+}
+
+// applyOffline 判定离线核销的对账结果，有效时补录出行人核销并汇总订单状态
+func applyOffline(tx *gorm.DB, device *model.GateDevice, rec *model.OfflineVerifyRecord) error {
+	orderID, itemID, err := resolveCode(tx, rec.VerifyCode)
+	if err != nil {
+		return err
+	}
+	if orderID == 0 {
+		rec.Result, rec.Reason = constant.OfflineResultRejected, "核销码不存在"
+		return nil
+	}
+	rec.OrderID, rec.OrderItemID = orderID, itemID
+
+	var o model.OrderMain
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "spot_id", "order_status", "visit_date").Where("id = ?", orderID).First(&o).Error
+	if err != nil {
+		return err
+	}
+	if o.SpotID != device.SpotID {
+		rec.Result, rec.Reason = constant.OfflineResultRejected, "非本景点核销码"
+		return nil
+	}
+	switch orderstate.Status(o.OrderStatus) {
+	case orderstate.Paid, orderstate.Verified:
+	default:
+		rec.Result, rec.Reason = constant.OfflineResultConflict, fmt.Sprintf("订单状态为%s，离线放行需人工处理", o.OrderStatus)
+		return nil
+	}
+	if err = checkVisitDate(&o, rec.VerifiedAt); err != nil {
+		rec.Result, rec.Reason = constant.OfflineResultRejected, err.Error()
+		return nil
+	}
+	items, err := loadItems(tx, o.ID)
+	if err != nil {
+		return err
+	}
+
+	var apply []model.OrderItem
+	var conflicts, rejects []string
+	duplicate := false
+	for i := range items {
+		it := &items[i]
+		if itemID != 0 && it.ID != itemID {
+			continue
+		}
+		switch it.ItemStatus {
+		case constant.ItemStatusUnused:
+			if err := checkValidPeriod(items[i:i+1], rec.VerifiedAt); err != nil {
+				rejects = append(rejects, err.Error())
+				continue
+			}
+			apply = append(apply, *it)
+		case constant.ItemStatusVerified:
+			if it.VerifyDevice == device.DeviceNo {
+				duplicate = true
+				continue
+			}
+			at := ""
+			if it.VerifyTime != nil {
+				at = it.VerifyTime.Format("2006-01-02 15:04:05")
+			}
+			gate := it.VerifyDevice
+			if gate == "" {
+				gate = "（线上订单核销）"
+			}
+			conflicts = append(conflicts, fmt.Sprintf("%s已于%s在闸机%s核销", it.TicketName, at, gate))
+		case constant.ItemStatusRefunded:
+			if itemID != 0 {
+				conflicts = append(conflicts, fmt.Sprintf("%s已退款", it.TicketName))
+			}
+		}
+	}
+
+	switch {
+	case len(apply) > 0:
+		if err = redeemItems(tx, apply, device.DeviceNo, rec.VerifiedAt); err != nil {
+			return err
+		}
+		if _, err = orderstate.SettleItems(tx, o.ID, orderstate.GateOperator(device.DeviceNo), rec.VerifiedAt); err != nil {
+			return err
+		}
+		rec.Result = constant.OfflineResultApplied
+		rec.Reason = strings.Join(append(conflicts, rejects...), "；")
+	case len(conflicts) > 0:
+		rec.Result, rec.Reason = constant.OfflineResultConflict, strings.Join(conflicts, "；")
+	case duplicate:
+		rec.Result, rec.Reason = constant.OfflineResultDuplicate, "该闸机已核销过此核销码"
+	case len(rejects) > 0:
+		rec.Result, rec.Reason = constant.OfflineResultRejected, strings.Join(rejects, "；")
+	default:
+		rec.Result, rec.Reason = constant.OfflineResultRejected, "订单已全部退款"
+	}
+	rec.Reason = truncateRunes(rec.Reason, 250)
+	return nil
+}
+
+// resolveCode 查找核销码所属订单，出行人核销码同时返回明细ID，不存在时订单ID为0
+func resolveCode(tx *gorm.DB, code string) (uint64, uint64, error) {
+	var o model.OrderMain
+	err := tx.Select("id").Where("verify_code = ?", code).First(&o).Error
+	if err == nil {
+		return o.ID, 0, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, 0, err
+	}
+	var it model.OrderItem
+	err = tx.Select("id", "order_id").Where("verify_code = ?", code).First(&it).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	return it.OrderID, it.ID, nil
+}
+
+// GetOfflineVerifyReport 商家查询景点在日期区间内的离线核销对账结果与异常明细
+func (s *VerifyService) GetOfflineVerifyReport(ctx context.Context, req *verify.GetOfflineVerifyReportReq) (*verify.GetOfflineVerifyReportResp, error) {
+	resp := &verify.GetOfflineVerifyReportResp{}
+	if req.MerchantId <= 0 || req.SpotId <= 0 {
+		resp.Base = fail(constant.CodeParamError, "商家ID或景点ID不合法")
+		return resp, nil
+	}
+	now := time.Now()
+	start, end, msg := parseReportRange(req.StartDate, req.EndDate, now)
+	if msg != "" {
+		resp.Base = fail(constant.CodeParamError, msg)
+		return resp, nil
+	}
+	var spot model.SpotInfo
+	err := db.MysqlDB.WithContext(ctx).Select("id", "merchant_id").Where("id = ?", req.SpotId).First(&spot).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			resp.Base = fail(constant.CodeNotFound, "景点不存在")
+			return resp, nil
+		}
+		log.Printf("查询景点失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询对账报告失败")
+		return resp, nil
+	}
+	if spot.MerchantID != uint64(req.MerchantId) {
+		resp.Base = fail(constant.CodeForbidden, "只能查询自己景点的对账报告")
+		return resp, nil
+	}
+
+	q := db.MysqlDB.WithContext(ctx).Model(&model.OfflineVerifyRecord{}).
+		Where("spot_id = ? AND verified_at >= ? AND verified_at < ?", spot.ID, start, end.AddDate(0, 0, 1))
+	var counts []struct {
+		Result string
+		Cnt    int32
+	}
+	if err = q.Session(&gorm.Session{}).Select("result, COUNT(*) AS cnt").Group("result").Scan(&counts).Error; err != nil {
+		log.Printf("统计离线核销记录失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询对账报告失败")
+		return resp, nil
+	}
+	for _, c := range counts {
+		switch c.Result {
+		case constant.OfflineResultApplied:
+			resp.Applied = c.Cnt
+		case constant.OfflineResultDuplicate:
+			resp.Duplicated = c.Cnt
+		case constant.OfflineResultConflict:
+			resp.Conflicts = c.Cnt
+		case constant.OfflineResultRejected:
+			resp.Rejected = c.Cnt
+		}
+	}
+	var list []model.OfflineVerifyRecord
+	err = q.Session(&gorm.Session{}).
+		Where("result IN ?", []string{constant.OfflineResultConflict, constant.OfflineResultRejected}).
+		Order("verified_at, id").Limit(maxReportItems).Find(&list).Error
+	if err != nil {
+		log.Printf("查询离线核销异常失败: %v", err)
+		resp.Base = fail(constant.CodeServerError, "查询对账报告失败")
+		return resp, nil
+	}
+	resp.Exceptions = make([]*verify.OfflineVerifyException, 0, len(list))
+	for i := range list {
+		resp.Exceptions = append(resp.Exceptions, toOfflineException(&list[i]))
+	}
+	resp.Base = success("查询成功")
+	return resp, nil
+}
+
+// parseReportRange 解析对账报告日期区间，默认当天，返回错误提示
+func parseReportRange(startDate, endDate string, now time.Time) (time.Time, time.Time, string) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	start, end := today, today
+	var err error
+	if s := strings.TrimSpace(endDate); s != "" {
+		if end, err = time.ParseInLocation(time.DateOnly, s, now.Location()); err != nil {
+			return start, end, "结束日期格式错误，应为YYYY-MM-DD"
+		}
+		start = end
+	}
+	if s := strings.TrimSpace(startDate); s != "" {
+		if start, err = time.ParseInLocation(time.DateOnly, s, now.Location()); err != nil {
+			return start, end, "开始日期格式错误，应为YYYY-MM-DD"
+		}
+	}
+	if start.After(end) {
+		return start, end, "开始日期不能晚于结束日期"
+	}
+	if end.Sub(start) >= maxReportDays*24*time.Hour {
+		return start, end, fmt.Sprintf("查询区间不能超过%d天", maxReportDays)
+	}
+	return start, end, ""
+}
+
+// toOfflineException 转换对账异常
+func toOfflineException(r *model.OfflineVerifyRecord) *verify.OfflineVerifyException {
+	return &verify.OfflineVerifyException{
+		VerifyCode: r.VerifyCode,
+		VerifiedAt: r.VerifiedAt.Unix(),
+		DeviceNo:   r.DeviceNo,
+		OrderId:    int64(r.OrderID),
+		Outcome:    r.Result,
+		Reason:     r.Reason,
+	}
+}
+
+// whitelistKey 离线白名单签名私钥
+func whitelistKey() ed25519.PrivateKey {
+	return whitelist.KeyFromSeed(config.Cfg.Verify.WhitelistKey)
+}
+
+// whitelistPublicKey 离线白名单验签公钥，base64 编码
+func whitelistPublicKey() string {
+	return base64.StdEncoding.EncodeToString(whitelistKey().Public().(ed25519.PublicKey))
+}
+
+// truncateRunes 按字符截断
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "…"
+}
@@ -0,0 +1,16 @@
+package verify
+
+import (
+	"example_shop/common/constant"
+	"example_shop/kitex_gen/verify"
+)
+
+// success 构造成功响应
+func success(msg string) *verify.BaseResp {
+	return &verify.BaseResp{Code: constant.CodeSuccess, Msg: msg}
+}
+
+// fail 构造失败响应
+func fail(code int32, msg string) *verify.BaseResp {
+	return &verify.BaseResp{Code: code, Msg: msg}
+}
@@ -0,0 +1,318 @@
+package verify
+
+import (
+	"context"
+	"errors"
+	"example_shop/common/constant"
+	"example_shop/common/db"
+	"example_shop/common/encrypt"
+	"example_shop/common/gatesign"
+	"example_shop/common/model"
+	"example_shop/common/orderstate"
+	"example_shop/common/verifyqr"
+	"example_shop/kitex_gen/verify"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	errDeviceUnavailable = errors.New("闸机未登记或已停用")
+	errOtherSpot         = errors.New("非本景点门票，不能核销")
+	errNotVerifiable     = errors.New("订单未支付或已退款，不能核销")
+	errItemRefunded      = errors.New("该出行人门票已退款，不能核销")
+	errItemNotFound      = errors.New("订单明细不存在")
+)
+
+// errAlreadyVerified 订单已核销，重复扫码
+type errAlreadyVerified struct{ at time.Time }
+
+func (e *errAlreadyVerified) Error() string {
+	if e.at.IsZero() {
+		return "门票已核销，请勿重复使用"
+	}
+	return fmt.Sprintf("门票已于%s核销，请勿重复使用", e.at.Format("2006-01-02 15:04:05"))
+}
+
+// errOutOfPeriod 不在门票有效期内
+type errOutOfPeriod struct{ msg string }
+
+func (e *errOutOfPeriod) Error() string { return e.msg }
+
+// VerifyTicket 闸机扫码核销：校验闸机请求签名、二维码签名与时效、闸机归属与门票有效期后，锁定订单核销二维码对应的出行人，
+// 每位出行人只能核销一次，并发或重复扫码只有一次成功；全部出行人核销后订单 PAID -> VERIFIED
+func (s *VerifyService) VerifyTicket(ctx context.Context, req *verify.VerifyTicketReq) (*verify.VerifyTicketResp, error) {
+	resp := &verify.VerifyTicketResp{}
+	deviceNo := strings.TrimSpace(req.DeviceNo)
+	if deviceNo == "" || strings.TrimSpace(req.QrContent) == "" {
+		resp.Base = fail(constant.CodeParamError, "设备编号或二维码内容不能为空")
+		return resp, nil
+	}
+	now := time.Now()
+	device, err := loadDevice(ctx, deviceNo)
+	if err == nil {
+		err = checkDeviceSign(device, req.Timestamp, req.QrContent, req.Signature, now)
+	}
+	if err != nil {
+		resp.Base = verifyErrResp(err, "核销失败")
+		return resp, nil
+	}
+	payload, err := verifyqr.Parse(qrSecret(), req.QrContent, now)
+	if err != nil {
+		resp.Base = verifyErrResp(err, "核销失败")
+		return resp, nil
+	}
+
+	var o model.OrderMain
+	var targets []model.OrderItem
+	var remaining int64
+	err = db.MysqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "order_no", "merchant_id", "spot_id", "order_status", "verify_code", "verify_time", "visit_date").
+			Where("id = ?", payload.OrderID).First(&o).Error
+		if err != nil {
+			return err
+		}
+		if o.SpotID != device.SpotID || o.MerchantID != device.MerchantID {
+			return errOtherSpot
+		}
+		items, err := loadItems(tx, o.ID)
+		if err != nil {
+			return err
+		}
+		// 核销码不属于该订单（含重新生成后的旧码）时二维码无效
+		if !ownsCode(&o, items, payload.VerifyCode) {
+			return verifyqr.ErrInvalid
+		}
+		if err = checkVerifiable(&o); err != nil {
+			return err
+		}
+		if _, targets, err = pickTargets(&o, items, codeItemID(items, payload.VerifyCode)); err != nil {
+			return err
+		}
+		if err = checkValidPeriod(targets, now); err != nil {
+			return err
+		}
+		if err = checkVisitDate(&o, now); err != nil {
+			return err
+		}
+		operator := orderstate.GateOperator(device.DeviceNo)
+		if err = redeemItems(tx, targets, device.DeviceNo, now); err != nil {
+			return err
+		}
+		if remaining, err = orderstate.SettleItems(tx, o.ID, operator, now); err != nil {
+			return err
+		}
+		return tx.Model(&model.GateDevice{}).Where("id = ?", device.ID).Update("last_active_at", now).Error
+	})
+	if err != nil {
+		resp.Base = verifyErrResp(err, "核销失败")
+		return resp, nil
+	}
+	resp.OrderId = int64(o.ID)
+	resp.OrderNo = o.OrderNo
+	resp.VerifyTime = now.Unix()
+	resp.Items = toVerifiedItems(targets)
+	resp.Remaining = int32(remaining)
+	resp.Base = success("核销成功")
+	return resp, nil
+}
+
+// redeemItems 以待核销为前置条件将明细置为已核销，任一明细状态已变化则整体失败
+func redeemItems(tx *gorm.DB, items []model.OrderItem, deviceNo string, now time.Time) error {
+	ids := make([]uint64, 0, len(items))
+	for i := range items {
+		ids = append(ids, items[i].ID)
+	}
+	result := tx.Model(&model.OrderItem{}).
+		Where("id IN ? AND item_status = ?", ids, constant.ItemStatusUnused).
+		Updates(map[string]interface{}{
+			"item_status":   constant.ItemStatusVerified,
+			"verify_time":   now,
+			"verify_device": deviceNo,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != int64(len(ids)) {
+		return orderstate.ErrStatusChanged
+	}
+	return nil
+}
+
+// ownsCode 核销码是否为订单核销码或其某位出行人的核销码
+func ownsCode(o *model.OrderMain, items []model.OrderItem, code string) bool {
+	if o.VerifyCode != nil && *o.VerifyCode == code {
+		return true
+	}
+	return codeItemID(items, code) > 0
+}
+
+// codeItemID 出行人核销码对应的明细ID，不是出行人核销码时返回0
+func codeItemID(items []model.OrderItem, code string) int64 {
+	for i := range items {
+		if items[i].VerifyCode != nil && *items[i].VerifyCode == code {
+			return int64(items[i].ID)
+		}
+	}
+	return 0
+}
+
+// pickTargets 选择核销对象：itemID 为0时为全部待核销明细并使用订单核销码，否则为该出行人并使用其核销码
+func pickTargets(o *model.OrderMain, items []model.OrderItem, itemID int64) (string, []model.OrderItem, error) {
+	if itemID <= 0 {
+		var pending []model.OrderItem
+		for i := range items {
+			if items[i].ItemStatus == constant.ItemStatusUnused {
+				pending = append(pending, items[i])
+			}
+		}
+		if len(pending) == 0 {
+			return "", nil, &errAlreadyVerified{}
+		}
+		return *o.VerifyCode, pending, nil
+	}
+	for i := range items {
+		it := &items[i]
+		if int64(it.ID) != itemID {
+			continue
+		}
+		switch it.ItemStatus {
+		case constant.ItemStatusUnused:
+			if it.VerifyCode == nil {
+				return "", nil, errNotVerifiable
+			}
+			return *it.VerifyCode, []model.OrderItem{*it}, nil
+		case constant.ItemStatusVerified:
+			if it.VerifyTime != nil {
+				return "", nil, &errAlreadyVerified{at: *it.VerifyTime}
+			}
+			return "", nil, &errAlreadyVerified{}
+		default:
+			return "", nil, errItemRefunded
+		}
+	}
+	return "", nil, errItemNotFound
+}
+
+// loadDevice 查询启用中的闸机
+func loadDevice(ctx context.Context, deviceNo string) (*model.GateDevice, error) {
+	var d model.GateDevice
+	err := db.MysqlDB.WithContext(ctx).Where("device_no = ? AND device_status = ?", deviceNo, constant.GateDeviceEnabled).First(&d).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errDeviceUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// checkVerifiable 订单需为已支付且已生成核销码
+func checkVerifiable(o *model.OrderMain) error {
+	switch orderstate.Status(o.OrderStatus) {
+	case orderstate.Paid:
+		if o.VerifyCode == nil {
+			return errNotVerifiable
+		}
+		return nil
+	case orderstate.Verified:
+		if o.VerifyTime != nil {
+			return &errAlreadyVerified{at: *o.VerifyTime}
+		}
+		return &errAlreadyVerified{}
+	default:
+		return errNotVerifiable
+	}
+}
+
+// loadItems 查询订单明细及门票、出行人信息
+func loadItems(tx *gorm.DB, orderID uint64) ([]model.OrderItem, error) {
+	var items []model.OrderItem
+	err := tx.Preload("TicketType", func(q *gorm.DB) *gorm.DB {
+		return q.Unscoped().Select("id", "ticket_name", "valid_start_time", "valid_end_time")
+	}).Preload("Traveler", func(q *gorm.DB) *gorm.DB {
+		return q.Unscoped().Select("id", "real_name")
+	}).Where("order_id = ?", orderID).Order("id").Find(&items).Error
+	return items, err
+}
+
+// checkValidPeriod 当天需在订单内每种门票的有效期内（按日期，含首尾两天）
+func checkValidPeriod(items []model.OrderItem, now time.Time) error {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	for i := range items {
+		t := items[i].TicketType
+		if t == nil {
+			continue
+		}
+		if t.ValidStartTime.Valid && today.Before(dateOf(t.ValidStartTime.Time, now.Location())) {
+			return &errOutOfPeriod{fmt.Sprintf("门票%s自%s起可用", t.TicketName, t.ValidStartTime.Time.Format("2006-01-02"))}
+		}
+		if t.ValidEndTime.Valid && today.After(dateOf(t.ValidEndTime.Time, now.Location())) {
+			return &errOutOfPeriod{fmt.Sprintf("门票%s已于%s过期", t.TicketName, t.ValidEndTime.Time.Format("2006-01-02"))}
+		}
+	}
+	return nil
+}
+
+// checkVisitDate 指定了游玩日期的订单只能在当天核销
+func checkVisitDate(o *model.OrderMain, now time.Time) error {
+	if o.VisitDate == nil {
+		return nil
+	}
+	visit := dateOf(*o.VisitDate, now.Location())
+	if !dateOf(now, now.Location()).Equal(visit) {
+		return &errOutOfPeriod{fmt.Sprintf("订单游玩日期为%s，当天不能核销", visit.Format(time.DateOnly))}
+	}
+	return nil
+}
+
+// dateOf 取日期部分，DATE 列读出的时间可能不在本地时区
+func dateOf(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// toVerifiedItems 转换核销明细，出行人姓名脱敏
+func toVerifiedItems(items []model.OrderItem) []*verify.VerifiedItem {
+	list := make([]*verify.VerifiedItem, 0, len(items))
+	for i := range items {
+		it := &items[i]
+		v := &verify.VerifiedItem{
+			ItemId:     int64(it.ID),
+			TicketName: it.TicketName,
+			TravelerId: int64(it.TravelerID),
+		}
+		if it.Traveler != nil {
+			v.TravelerName = encrypt.MaskName(it.Traveler.RealName)
+		}
+		list = append(list, v)
+	}
+	return list
+}
+
+// verifyErrResp 将核销错误转换为响应
+func verifyErrResp(err error, msg string) *verify.BaseResp {
+	var av *errAlreadyVerified
+	var op *errOutOfPeriod
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return fail(constant.CodeNotFound, "订单不存在")
+	case errors.Is(err, errItemNotFound):
+		return fail(constant.CodeNotFound, err.Error())
+	case errors.Is(err, verifyqr.ErrInvalid):
+		return fail(constant.CodeParamError, err.Error())
+	case errors.Is(err, errDeviceUnavailable), errors.Is(err, errOtherSpot), errors.Is(err, errNoDeviceSecret), errors.Is(err, gatesign.ErrInvalid),
+		errors.Is(err, gatesign.ErrExpired):
+		return fail(constant.CodeForbidden, err.Error())
+	case errors.As(err, &av), errors.As(err, &op), errors.Is(err, verifyqr.ErrExpired), errors.Is(err, errNotVerifiable), errors.Is(err, errItemRefunded),
+		errors.Is(err, orderstate.ErrStatusChanged):
+		return fail(constant.CodeConflict, err.Error())
+	default:
+		log.Printf("%s: %v", msg, err)
+		return fail(constant.CodeServerError, msg)
+	}
+}
@@ -18,4 +18,5 @@ if [ ! -d "$KITEX_LOG_DIR/rpc" ]; then
     mkdir -p "$KITEX_LOG_DIR/rpc"
 fi
 
-exec "$CURDIR/bin/coupon_service"
+# RUN_NAME 指定启动的服务，默认优惠券服务
+exec "$CURDIR/bin/${RUN_NAME:-coupon_service}"